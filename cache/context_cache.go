@@ -0,0 +1,121 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"sync"
+	"time"
+)
+
+var (
+	// ErrNotFound key 不存在或已过期
+	ErrNotFound = errors.New("cache: key not found")
+	// ErrNotSupported 底层缓存不支持该操作
+	ErrNotSupported = errors.New("cache: operation not supported")
+	// ErrInvalidTTL SetNX 的 ttl 既不为正数也不为 NoExpiration
+	ErrInvalidTTL = errors.New("cache: invalid ttl")
+)
+
+// NoExpiration 写入时传入该值表示永不过期，TTL 返回该值表示 key 永不过期
+const NoExpiration time.Duration = -1
+
+// ContextCache 支持 context 的缓存接口，读写字符串或字节，提供原子的 SetNX 和 TTL 查询
+//
+// 过期时间 ttl 为 NoExpiration 时永不过期，其余 ttl <= 0 时不写入（已有的 key 被删除）；key 不存在时 Get 及 TTL 返回 ErrNotFound
+type ContextCache interface {
+	GetString(ctx context.Context, key string) (string, error)
+	SetString(ctx context.Context, key, val string, ttl time.Duration) error
+	GetBytes(ctx context.Context, key string) ([]byte, error)
+	SetBytes(ctx context.Context, key string, val []byte, ttl time.Duration) error
+	// SetNX key 不存在时写入，返回是否写入成功
+	SetNX(ctx context.Context, key, val string, ttl time.Duration) (bool, error)
+	// TTL 返回剩余有效期，永不过期时返回 NoExpiration
+	TTL(ctx context.Context, key string) (time.Duration, error)
+	DeleteContext(ctx context.Context, key string) error
+}
+
+// FromCache 将 Cache 转换为 ContextCache，c 已实现 ContextCache 时直接返回
+//
+// 适配的缓存忽略 ctx，TTL 返回 ErrNotSupported；
+// SetNX 通过进程内共享的锁保证原子，多次调用 FromCache 得到的适配器之间同样互斥，但跨进程不保证；
+// NoExpiration 原样传给 c.Set，由 c 决定其含义（Memory 视为永不过期），其他 ttl <= 0 时删除 key
+func FromCache(c Cache) ContextCache {
+	if cc, ok := c.(ContextCache); ok {
+		return cc
+	}
+	return &cacheAdapter{c: c}
+}
+
+// adapterLocks 按 key 分片，由所有 cacheAdapter 共享，
+// 保证每次请求各自调用 FromCache 时 SetNX 仍然互斥
+var adapterLocks [64]sync.Mutex
+
+func adapterLock(key string) *sync.Mutex {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(key))
+	return &adapterLocks[h.Sum32()%uint32(len(adapterLocks))]
+}
+
+type cacheAdapter struct {
+	c Cache
+}
+
+func (a *cacheAdapter) set(key string, val interface{}, ttl time.Duration) error {
+	if ttl <= 0 && ttl != NoExpiration {
+		return a.c.Delete(key)
+	}
+	return a.c.Set(key, val, ttl)
+}
+
+func (a *cacheAdapter) GetString(_ context.Context, key string) (string, error) {
+	switch v := a.c.Get(key).(type) {
+	case nil:
+		return "", ErrNotFound
+	case string:
+		return v, nil
+	case []byte:
+		return string(v), nil
+	default:
+		return "", fmt.Errorf("cache: value of %s is %T, not string", key, v)
+	}
+}
+
+func (a *cacheAdapter) SetString(_ context.Context, key, val string, ttl time.Duration) error {
+	return a.set(key, val, ttl)
+}
+
+func (a *cacheAdapter) GetBytes(ctx context.Context, key string) ([]byte, error) {
+	val, err := a.GetString(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(val), nil
+}
+
+func (a *cacheAdapter) SetBytes(_ context.Context, key string, val []byte, ttl time.Duration) error {
+	// 以字符串存储，兼容 Redis、Memcache 等按字符串或 json 序列化的实现
+	return a.set(key, string(val), ttl)
+}
+
+func (a *cacheAdapter) SetNX(_ context.Context, key, val string, ttl time.Duration) (bool, error) {
+	if ttl <= 0 && ttl != NoExpiration {
+		return false, ErrInvalidTTL
+	}
+	mu := adapterLock(key)
+	mu.Lock()
+	defer mu.Unlock()
+	if a.c.IsExist(key) {
+		return false, nil
+	}
+	return true, a.c.Set(key, val, ttl)
+}
+
+func (a *cacheAdapter) TTL(context.Context, string) (time.Duration, error) {
+	return 0, ErrNotSupported
+}
+
+func (a *cacheAdapter) DeleteContext(_ context.Context, key string) error {
+	return a.c.Delete(key)
+}
@@ -0,0 +1,123 @@
+package cache
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// plainCache 仅实现 Cache，用于测试适配器
+type plainCache struct {
+	m *Memory
+}
+
+func (p plainCache) Get(key string) interface{} { return p.m.Get(key) }
+func (p plainCache) Set(key string, val interface{}, timeout time.Duration) error {
+	return p.m.Set(key, val, timeout)
+}
+func (p plainCache) IsExist(key string) bool { return p.m.IsExist(key) }
+func (p plainCache) Delete(key string) error { return p.m.Delete(key) }
+
+func TestFromCache(t *testing.T) {
+	mem := NewMemory(WithCleanupInterval(0))
+	assert.Equal(t, ContextCache(mem), FromCache(mem))
+
+	ctx := context.Background()
+	c := FromCache(plainCache{m: mem})
+	_, ok := c.(*cacheAdapter)
+	assert.True(t, ok)
+
+	_, err := c.GetString(ctx, "k")
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	assert.NoError(t, c.SetString(ctx, "k", "v", time.Minute))
+	val, err := c.GetString(ctx, "k")
+	assert.NoError(t, err)
+	assert.Equal(t, "v", val)
+
+	ok, err = c.SetNX(ctx, "k", "v2", time.Minute)
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	_, err = c.TTL(ctx, "k")
+	assert.ErrorIs(t, err, ErrNotSupported)
+
+	assert.NoError(t, c.SetBytes(ctx, "b", []byte("bytes"), NoExpiration))
+	b, err := c.GetBytes(ctx, "b")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("bytes"), b)
+
+	assert.NoError(t, c.DeleteContext(ctx, "k"))
+	ok, err = c.SetNX(ctx, "k", "v3", time.Minute)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+
+	// NoExpiration 原样交给 Memory，视为永不过期
+	ttl, err := mem.TTL(ctx, "b")
+	assert.NoError(t, err)
+	assert.Equal(t, NoExpiration, ttl)
+
+	// 其他 ttl <= 0 时删除 key
+	assert.NoError(t, c.SetString(ctx, "k", "v", 0))
+	assert.False(t, mem.IsExist("k"))
+	assert.NoError(t, c.SetBytes(ctx, "b", []byte("bytes"), -time.Second))
+	assert.False(t, mem.IsExist("b"))
+}
+
+func TestFromCacheSetNXConcurrent(t *testing.T) {
+	mem := NewMemory(WithCleanupInterval(0))
+	ctx := context.Background()
+
+	// 每个 goroutine 各自调用 FromCache，模拟按请求创建适配器
+	var wg sync.WaitGroup
+	var won int32
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ok, err := FromCache(plainCache{m: mem}).SetNX(ctx, "nx", "v", time.Minute)
+			assert.NoError(t, err)
+			if ok {
+				atomic.AddInt32(&won, 1)
+			}
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, int32(1), won)
+}
+
+func TestMemoryContextCache(t *testing.T) {
+	mem := NewMemory(WithCleanupInterval(0))
+	ctx := context.Background()
+
+	_, err := mem.TTL(ctx, "k")
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	ok, err := mem.SetNX(ctx, "k", "v", time.Minute)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	ok, err = mem.SetNX(ctx, "k", "v2", time.Minute)
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	ttl, err := mem.TTL(ctx, "k")
+	assert.NoError(t, err)
+	assert.True(t, ttl > 59*time.Second && ttl <= time.Minute)
+
+	ok, err = mem.SetNX(ctx, "zero", "v", 0)
+	assert.ErrorIs(t, err, ErrInvalidTTL)
+	assert.False(t, ok)
+
+	assert.NoError(t, mem.SetString(ctx, "forever", "v", NoExpiration))
+	ttl, err = mem.TTL(ctx, "forever")
+	assert.NoError(t, err)
+	assert.Equal(t, NoExpiration, ttl)
+
+	assert.NoError(t, mem.Set("n", 1, NoExpiration))
+	_, err = mem.GetString(ctx, "n")
+	assert.Error(t, err)
+}
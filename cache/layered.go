@@ -0,0 +1,180 @@
+package cache
+
+import (
+	"context"
+	"time"
+)
+
+// DefaultLocalTTL 本地副本默认有效期
+const DefaultLocalTTL = 10 * time.Second
+
+// Layered 二级缓存，在 Redis、Memcache 等远端缓存前保留短期的本地副本，减少网络往返
+//
+// 写入及删除同时作用于本地和远端；其他实例删除或更新 key 后，本实例的本地副本最多在 localTTL 后失效，
+// 可调用 DeleteLocal 立即丢弃本地副本（如 access_token 返回 40001 时）
+type Layered struct {
+	local    *Memory
+	cache    Cache // 远端
+	remote   ContextCache
+	locker   Locker
+	localTTL time.Duration
+}
+
+// LayeredOption Layered 配置项
+type LayeredOption func(*Layered)
+
+// WithLocalTTL 设置本地副本的有效期，不超过写入时的过期时间
+func WithLocalTTL(ttl time.Duration) LayeredOption {
+	return func(l *Layered) {
+		l.localTTL = ttl
+	}
+}
+
+// WithLocal 设置本地缓存，默认为最多 10000 条的 Memory
+func WithLocal(local *Memory) LayeredOption {
+	return func(l *Layered) {
+		l.local = local
+	}
+}
+
+// NewLayered create new Layered cache
+func NewLayered(remote Cache, opts ...LayeredOption) *Layered {
+	l := &Layered{
+		cache:    remote,
+		remote:   FromCache(remote),
+		localTTL: DefaultLocalTTL,
+	}
+	for _, opt := range opts {
+		opt(l)
+	}
+	if l.local == nil {
+		l.local = NewMemory(WithMaxEntries(10000))
+	}
+	if locker, ok := remote.(Locker); ok {
+		l.locker = locker
+	} else {
+		l.locker = NewMemoryLocker()
+	}
+	return l
+}
+
+// localTTLOf 本地副本的有效期，取 localTTL 与 ttl 中较小的值，ttl 为 NoExpiration 时为 localTTL
+func (l *Layered) localTTLOf(ttl time.Duration) time.Duration {
+	if ttl != NoExpiration && ttl < l.localTTL {
+		return ttl
+	}
+	return l.localTTL
+}
+
+// Get 获取一个值，本地不存在时从远端读取并保存副本
+func (l *Layered) Get(key string) interface{} {
+	if val := l.local.Get(key); val != nil {
+		return val
+	}
+	val := l.cache.Get(key)
+	if s, ok := val.(string); ok {
+		_ = l.local.Set(key, s, l.localTTL)
+	}
+	return val
+}
+
+// Set 设置一个值
+func (l *Layered) Set(key string, val interface{}, timeout time.Duration) error {
+	if s, ok := val.(string); ok {
+		return l.SetString(context.Background(), key, s, timeout)
+	}
+	if b, ok := val.([]byte); ok {
+		return l.SetBytes(context.Background(), key, b, timeout)
+	}
+	if err := l.local.Delete(key); err != nil {
+		return err
+	}
+	// 非字符串的值无法保证与远端读出的类型一致，不保存本地副本
+	return l.cache.Set(key, val, timeout)
+}
+
+// IsExist 判断key是否存在
+func (l *Layered) IsExist(key string) bool {
+	return l.local.IsExist(key) || l.cache.IsExist(key)
+}
+
+// Delete 删除本地副本及远端
+func (l *Layered) Delete(key string) error {
+	return l.DeleteContext(context.Background(), key)
+}
+
+// DeleteLocal 仅删除本地副本，下次读取时从远端获取
+func (l *Layered) DeleteLocal(key string) {
+	_ = l.local.Delete(key)
+}
+
+// GetString 获取字符串
+func (l *Layered) GetString(ctx context.Context, key string) (string, error) {
+	if val, err := l.local.GetString(ctx, key); err == nil {
+		return val, nil
+	}
+	val, err := l.remote.GetString(ctx, key)
+	if err != nil {
+		return "", err
+	}
+	_ = l.local.SetString(ctx, key, val, l.localTTL)
+	return val, nil
+}
+
+// SetString 写入字符串，ttl 为 NoExpiration 时永不过期，其余 ttl <= 0 时删除 key
+func (l *Layered) SetString(ctx context.Context, key, val string, ttl time.Duration) error {
+	if err := l.remote.SetString(ctx, key, val, ttl); err != nil {
+		_ = l.local.Delete(key)
+		return err
+	}
+	return l.local.SetString(ctx, key, val, l.localTTLOf(ttl))
+}
+
+// GetBytes 获取字节
+func (l *Layered) GetBytes(ctx context.Context, key string) ([]byte, error) {
+	val, err := l.GetString(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(val), nil
+}
+
+// SetBytes 写入字节，ttl 为 NoExpiration 时永不过期，其余 ttl <= 0 时删除 key
+func (l *Layered) SetBytes(ctx context.Context, key string, val []byte, ttl time.Duration) error {
+	return l.SetString(ctx, key, string(val), ttl)
+}
+
+// SetNX key 不存在时写入，以远端结果为准
+func (l *Layered) SetNX(ctx context.Context, key, val string, ttl time.Duration) (bool, error) {
+	ok, err := l.remote.SetNX(ctx, key, val, ttl)
+	if err != nil || !ok {
+		return ok, err
+	}
+	return true, l.local.SetString(ctx, key, val, l.localTTLOf(ttl))
+}
+
+// TTL 返回远端的剩余有效期
+func (l *Layered) TTL(ctx context.Context, key string) (time.Duration, error) {
+	return l.remote.TTL(ctx, key)
+}
+
+// DeleteContext 删除本地副本及远端
+func (l *Layered) DeleteContext(ctx context.Context, key string) error {
+	_ = l.local.Delete(key)
+	return l.remote.DeleteContext(ctx, key)
+}
+
+// Lock 远端实现了 Locker 时使用远端的锁，否则为进程内的锁
+func (l *Layered) Lock(ctx context.Context, key string, ttl time.Duration) (func() error, error) {
+	return l.locker.Lock(ctx, key, ttl)
+}
+
+// Close 停止本地缓存的后台清理
+func (l *Layered) Close() error {
+	return l.local.Close()
+}
+
+// Stats 返回本地副本的命中、未命中等统计
+func (l *Layered) Stats() MemoryStats {
+	return l.local.Stats()
+}
@@ -0,0 +1,78 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLayered(t *testing.T) {
+	ctx := context.Background()
+	remote := NewMemory()
+	defer remote.Close()
+	l := NewLayered(remote, WithLocalTTL(time.Minute))
+	defer l.Close()
+
+	assert.Nil(t, l.Set("token", "v1", time.Hour))
+	assert.Equal(t, "v1", remote.Get("token"))
+
+	// 远端被其他实例更新，本地副本仍有效
+	assert.Nil(t, remote.Set("token", "v2", time.Hour))
+	val, err := l.GetString(ctx, "token")
+	assert.Nil(t, err)
+	assert.Equal(t, "v1", val)
+
+	l.DeleteLocal("token")
+	assert.Equal(t, "v2", l.Get("token"))
+	assert.Equal(t, uint64(1), l.Stats().Hits)
+
+	// 删除同时作用于本地和远端
+	assert.Nil(t, l.Delete("token"))
+	assert.False(t, remote.IsExist("token"))
+	_, err = l.GetString(ctx, "token")
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	ok, err := l.SetNX(ctx, "nx", "a", time.Hour)
+	assert.Nil(t, err)
+	assert.True(t, ok)
+	ok, err = l.SetNX(ctx, "nx", "b", time.Hour)
+	assert.Nil(t, err)
+	assert.False(t, ok)
+}
+
+func TestLayeredLocalTTL(t *testing.T) {
+	remote := NewMemory()
+	defer remote.Close()
+	l := NewLayered(remote, WithLocalTTL(10*time.Millisecond))
+	defer l.Close()
+
+	assert.Nil(t, l.Set("token", "v1", time.Hour))
+	assert.Nil(t, remote.Set("token", "v2", time.Hour))
+	time.Sleep(20 * time.Millisecond)
+	assert.Equal(t, "v2", l.Get("token"))
+
+	// 本地副本不超过写入时的过期时间
+	assert.Nil(t, l.Set("short", "v", 5*time.Millisecond))
+	time.Sleep(10 * time.Millisecond)
+	assert.False(t, l.IsExist("short"))
+}
+
+func TestLayeredInvalidTTL(t *testing.T) {
+	ctx := context.Background()
+	remote := NewMemory()
+	defer remote.Close()
+	l := NewLayered(remote, WithLocalTTL(time.Minute))
+	defer l.Close()
+
+	assert.Nil(t, l.SetString(ctx, "token", "v1", time.Hour))
+	// ttl <= 0 时本地副本与远端均不再保留
+	assert.Nil(t, l.SetString(ctx, "token", "v2", -time.Second))
+	assert.False(t, remote.IsExist("token"))
+	_, err := l.GetString(ctx, "token")
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	_, err = l.SetNX(ctx, "nx", "v", 0)
+	assert.ErrorIs(t, err, ErrInvalidTTL)
+}
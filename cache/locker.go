@@ -0,0 +1,88 @@
+package cache
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"sync"
+	"time"
+)
+
+// lockRetryInterval 获取分布式锁失败后的重试间隔
+const lockRetryInterval = 50 * time.Millisecond
+
+// Locker 锁接口，多实例部署时用于保证同一时刻只有一个实例刷新 access_token 等凭证
+//
+// Redis 与 Memcache 实现了该接口，可直接作为共享的锁使用
+type Locker interface {
+	// Lock 阻塞直到获取 key 对应的锁或 ctx 结束，ttl 为锁的自动过期时间，避免持有者异常退出后无法释放
+	Lock(ctx context.Context, key string, ttl time.Duration) (unlock func() error, err error)
+}
+
+// MemoryLocker 进程内的锁，仅能保证单个进程内的互斥
+type MemoryLocker struct {
+	mu    sync.Mutex
+	locks map[string]chan struct{}
+}
+
+// NewMemoryLocker create new MemoryLocker
+func NewMemoryLocker() *MemoryLocker {
+	return &MemoryLocker{
+		locks: map[string]chan struct{}{},
+	}
+}
+
+// Lock 获取锁
+func (l *MemoryLocker) Lock(ctx context.Context, key string, ttl time.Duration) (func() error, error) {
+	for {
+		l.mu.Lock()
+		ch, ok := l.locks[key]
+		if !ok {
+			ch = make(chan struct{})
+			l.locks[key] = ch
+			l.mu.Unlock()
+
+			var once sync.Once
+			release := func() {
+				once.Do(func() {
+					l.mu.Lock()
+					defer l.mu.Unlock()
+					delete(l.locks, key)
+					close(ch)
+				})
+			}
+			timer := time.AfterFunc(ttl, release)
+			return func() error {
+				timer.Stop()
+				release()
+				return nil
+			}, nil
+		}
+		l.mu.Unlock()
+
+		select {
+		case <-ch:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// waitLock 等待重试间隔，ctx 结束时返回错误
+func waitLock(ctx context.Context) error {
+	timer := time.NewTimer(lockRetryInterval)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// lockToken 生成锁的持有者标识，释放时校验，避免误删其他实例的锁
+func lockToken() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
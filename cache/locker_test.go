@@ -0,0 +1,48 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemoryLocker(t *testing.T) {
+	locker := NewMemoryLocker()
+	unlock, err := locker.Lock(context.Background(), "key", time.Minute)
+	assert.Nil(t, err)
+
+	// 已被持有时等待超时
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err = locker.Lock(ctx, "key", time.Minute)
+	assert.Equal(t, context.DeadlineExceeded, err)
+
+	// 不同 key 互不影响
+	unlockOther, err := locker.Lock(context.Background(), "other", time.Minute)
+	assert.Nil(t, err)
+	assert.Nil(t, unlockOther())
+
+	acquired := make(chan struct{})
+	go func() {
+		unlock, err := locker.Lock(context.Background(), "key", time.Minute)
+		assert.Nil(t, err)
+		close(acquired)
+		_ = unlock()
+	}()
+	assert.Nil(t, unlock())
+	<-acquired
+}
+
+func TestMemoryLockerTTL(t *testing.T) {
+	locker := NewMemoryLocker()
+	_, err := locker.Lock(context.Background(), "key", 10*time.Millisecond)
+	assert.Nil(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	unlock, err := locker.Lock(ctx, "key", time.Minute)
+	assert.Nil(t, err)
+	assert.Nil(t, unlock())
+}
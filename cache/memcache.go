@@ -1,7 +1,9 @@
 package cache
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"time"
 
 	"github.com/bradfitz/gomemcache/memcache"
@@ -55,3 +57,129 @@ func (mem *Memcache) Set(key string, val interface{}, timeout time.Duration) (er
 func (mem *Memcache) Delete(key string) error {
 	return mem.conn.Delete(key)
 }
+
+// Lock 基于 add 的分布式锁，过期时间最小精度为秒；
+// 释放时通过 cas 将仍属于本次加锁的 key 置为立即过期，不会误删其他实例在锁过期后重新获取的锁
+func (mem *Memcache) Lock(ctx context.Context, key string, ttl time.Duration) (func() error, error) {
+	token := lockToken()
+	expiration := int32(ttl / time.Second)
+	if expiration < 1 {
+		expiration = 1
+	}
+	for {
+		err := mem.conn.Add(&memcache.Item{Key: key, Value: []byte(token), Expiration: expiration})
+		if err == nil {
+			return func() error {
+				item, err := mem.conn.Get(key)
+				if err != nil {
+					if errors.Is(err, memcache.ErrCacheMiss) {
+						return nil
+					}
+					return err
+				}
+				if string(item.Value) != token {
+					return nil
+				}
+				// item 携带 Get 返回的 cas id，期间 key 被其他实例改写时返回 ErrCASConflict
+				item.Value = nil
+				item.Expiration = -1
+				err = mem.conn.CompareAndSwap(item)
+				if errors.Is(err, memcache.ErrCASConflict) || errors.Is(err, memcache.ErrNotStored) || errors.Is(err, memcache.ErrCacheMiss) {
+					return nil
+				}
+				return err
+			}, nil
+		}
+		if !errors.Is(err, memcache.ErrNotStored) {
+			return nil, err
+		}
+		if err = waitLock(ctx); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// GetString 获取字符串，值以 json 编码存储，与 Set 写入的字符串兼容
+func (mem *Memcache) GetString(ctx context.Context, key string) (string, error) {
+	item, err := mem.conn.Get(key)
+	if err != nil {
+		if errors.Is(err, memcache.ErrCacheMiss) {
+			return "", ErrNotFound
+		}
+		return "", err
+	}
+	var val string
+	if err = json.Unmarshal(item.Value, &val); err != nil {
+		return "", err
+	}
+	return val, nil
+}
+
+// SetString 写入字符串，ttl 为 NoExpiration 时永不过期，其余 ttl <= 0 时删除 key
+func (mem *Memcache) SetString(ctx context.Context, key, val string, ttl time.Duration) error {
+	expiration, ok := memcacheExpiration(ttl)
+	if !ok {
+		return mem.DeleteContext(ctx, key)
+	}
+	data, err := json.Marshal(val)
+	if err != nil {
+		return err
+	}
+	return mem.conn.Set(&memcache.Item{Key: key, Value: data, Expiration: expiration})
+}
+
+// GetBytes 获取字节
+func (mem *Memcache) GetBytes(ctx context.Context, key string) ([]byte, error) {
+	val, err := mem.GetString(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(val), nil
+}
+
+// SetBytes 写入字节，以字符串存储
+func (mem *Memcache) SetBytes(ctx context.Context, key string, val []byte, ttl time.Duration) error {
+	return mem.SetString(ctx, key, string(val), ttl)
+}
+
+// SetNX key 不存在时写入，ttl 不为正数且不为 NoExpiration 时返回 ErrInvalidTTL
+func (mem *Memcache) SetNX(ctx context.Context, key, val string, ttl time.Duration) (bool, error) {
+	expiration, ok := memcacheExpiration(ttl)
+	if !ok {
+		return false, ErrInvalidTTL
+	}
+	data, err := json.Marshal(val)
+	if err != nil {
+		return false, err
+	}
+	err = mem.conn.Add(&memcache.Item{Key: key, Value: data, Expiration: expiration})
+	if errors.Is(err, memcache.ErrNotStored) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// TTL memcache 不支持查询剩余有效期，返回 ErrNotSupported
+func (mem *Memcache) TTL(ctx context.Context, key string) (time.Duration, error) {
+	return 0, ErrNotSupported
+}
+
+// DeleteContext 删除，key 不存在时不返回错误
+func (mem *Memcache) DeleteContext(ctx context.Context, key string) error {
+	if err := mem.conn.Delete(key); err != nil && !errors.Is(err, memcache.ErrCacheMiss) {
+		return err
+	}
+	return nil
+}
+
+// memcacheExpiration 将 ttl 转换为 memcache 的过期秒数，memcache 中 0 表示永不过期，
+// 因此 NoExpiration 转换为 0，不足 1 秒的 ttl 向上取整；其余 ttl <= 0 时返回 false
+func memcacheExpiration(ttl time.Duration) (int32, bool) {
+	if ttl == NoExpiration {
+		return 0, true
+	}
+	if ttl <= 0 {
+		return 0, false
+	}
+	return int32((ttl + time.Second - 1) / time.Second), true
+}
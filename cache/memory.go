@@ -1,74 +1,322 @@
 package cache
 
 import (
+	"container/list"
+	"context"
+	"fmt"
+	"runtime"
 	"sync"
 	"time"
 )
 
-// Memory struct contains *memcache.Client
+// DefaultCleanupInterval 默认清理过期数据的间隔
+const DefaultCleanupInterval = time.Minute
+
+// Memory 进程内缓存，并发安全，后台定期清理过期数据，可限制最大条数（按 LRU 淘汰）
 type Memory struct {
-	sync.Mutex
+	*memory
+}
+
+type memory struct {
+	mu         sync.Mutex
+	data       map[string]*list.Element
+	lru        *list.List // 队首为最近使用
+	maxEntries int
 
-	data map[string]*data
+	hits      uint64
+	misses    uint64
+	evictions uint64
+
+	stop      chan struct{}
+	closeOnce sync.Once
+
+	legacyMu sync.Mutex // 仅供已废弃的 Lock、Unlock 使用
 }
 
 type data struct {
+	key     string
 	Data    interface{}
-	Expired time.Time
+	Expired time.Time // 为零值时不过期
+}
+
+func (d *data) expired(now time.Time) bool {
+	return !d.Expired.IsZero() && d.Expired.Before(now)
+}
+
+// MemoryOption Memory 配置项
+type MemoryOption func(*memoryOptions)
+
+type memoryOptions struct {
+	maxEntries      int
+	cleanupInterval time.Duration
+}
+
+// WithMaxEntries 设置最大条数，超出时淘汰最久未使用的数据，<=0 时不限制
+func WithMaxEntries(n int) MemoryOption {
+	return func(o *memoryOptions) {
+		o.maxEntries = n
+	}
 }
 
-// NewMemory create new memcache
-func NewMemory() *Memory {
-	return &Memory{
-		data: map[string]*data{},
+// WithCleanupInterval 设置清理过期数据的间隔，<=0 时不启动后台清理，过期数据仅在读取时删除
+func WithCleanupInterval(d time.Duration) MemoryOption {
+	return func(o *memoryOptions) {
+		o.cleanupInterval = d
 	}
 }
 
+// MemoryStats 缓存统计
+type MemoryStats struct {
+	Hits      uint64 // 命中次数
+	Misses    uint64 // 未命中次数，包括已过期
+	Evictions uint64 // 因超出最大条数被淘汰的次数
+	Entries   int    // 当前条数，包括尚未清理的过期数据
+}
+
+// NewMemory create new memory cache
+func NewMemory(opts ...MemoryOption) *Memory {
+	o := memoryOptions{cleanupInterval: DefaultCleanupInterval}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	m := &memory{
+		data:       map[string]*list.Element{},
+		lru:        list.New(),
+		maxEntries: o.maxEntries,
+		stop:       make(chan struct{}),
+	}
+	mem := &Memory{m}
+	if o.cleanupInterval > 0 {
+		go m.janitor(o.cleanupInterval)
+		// 未调用 Close 时，Memory 被回收后停止后台清理
+		runtime.SetFinalizer(mem, func(mem *Memory) { mem.Close() })
+	}
+	return mem
+}
+
 // Get return cached value
 func (mem *Memory) Get(key string) interface{} {
-	if ret, ok := mem.data[key]; ok {
-		if ret.Expired.Before(time.Now()) {
-			mem.deleteKey(key)
-			return nil
-		}
-		return ret.Data
+	mem.mu.Lock()
+	defer mem.mu.Unlock()
+
+	if d, ok := mem.get(key); ok {
+		mem.hits++
+		return d.Data
 	}
+	mem.misses++
 	return nil
 }
 
-// IsExist check value exists in memcache.
+// IsExist check value exists in memory cache.
 func (mem *Memory) IsExist(key string) bool {
-	if ret, ok := mem.data[key]; ok {
-		if ret.Expired.Before(time.Now()) {
-			mem.deleteKey(key)
-			return false
+	mem.mu.Lock()
+	defer mem.mu.Unlock()
+
+	_, ok := mem.get(key)
+	return ok
+}
+
+// Set cached value with key and expire time, timeout 为 NoExpiration 时不过期，其余 timeout <= 0 时立即过期
+func (mem *Memory) Set(key string, val interface{}, timeout time.Duration) (err error) {
+	expired := expiredAt(timeout)
+
+	mem.mu.Lock()
+	defer mem.mu.Unlock()
+
+	mem.set(key, val, expired)
+	return nil
+}
+
+// set 调用方需持有锁
+func (mem *memory) set(key string, val interface{}, expired time.Time) {
+	if e, ok := mem.data[key]; ok {
+		d := e.Value.(*data)
+		d.Data = val
+		d.Expired = expired
+		mem.lru.MoveToFront(e)
+		return
+	}
+	mem.data[key] = mem.lru.PushFront(&data{key: key, Data: val, Expired: expired})
+	if mem.maxEntries > 0 {
+		for mem.lru.Len() > mem.maxEntries {
+			mem.remove(mem.lru.Back())
+			mem.evictions++
 		}
-		return true
 	}
-	return false
 }
 
-// Set cached value with key and expire time.
-func (mem *Memory) Set(key string, val interface{}, timeout time.Duration) (err error) {
-	mem.Lock()
-	defer mem.Unlock()
+// expiredAt timeout 为 NoExpiration 时返回零值，表示永不过期
+func expiredAt(timeout time.Duration) time.Time {
+	if timeout == NoExpiration {
+		return time.Time{}
+	}
+	return time.Now().Add(timeout)
+}
 
-	mem.data[key] = &data{
-		Data:    val,
-		Expired: time.Now().Add(timeout),
+// Delete delete value in memory cache.
+func (mem *Memory) Delete(key string) error {
+	mem.mu.Lock()
+	defer mem.mu.Unlock()
+
+	if e, ok := mem.data[key]; ok {
+		mem.remove(e)
 	}
 	return nil
 }
 
-// Delete delete value in memcache.
-func (mem *Memory) Delete(key string) error {
-	mem.deleteKey(key)
+// GetString 获取字符串
+func (mem *Memory) GetString(ctx context.Context, key string) (string, error) {
+	switch v := mem.Get(key).(type) {
+	case nil:
+		return "", ErrNotFound
+	case string:
+		return v, nil
+	case []byte:
+		return string(v), nil
+	default:
+		return "", fmt.Errorf("cache: value of %s is %T, not string", key, v)
+	}
+}
+
+// SetString 写入字符串，ttl 为 NoExpiration 时永不过期
+func (mem *Memory) SetString(ctx context.Context, key, val string, ttl time.Duration) error {
+	return mem.Set(key, val, ttl)
+}
+
+// GetBytes 获取字节
+func (mem *Memory) GetBytes(ctx context.Context, key string) ([]byte, error) {
+	val, err := mem.GetString(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(val), nil
+}
+
+// SetBytes 写入字节，ttl 为 NoExpiration 时永不过期
+func (mem *Memory) SetBytes(ctx context.Context, key string, val []byte, ttl time.Duration) error {
+	return mem.Set(key, append([]byte(nil), val...), ttl)
+}
+
+// SetNX key 不存在时写入
+func (mem *Memory) SetNX(ctx context.Context, key, val string, ttl time.Duration) (bool, error) {
+	if ttl <= 0 && ttl != NoExpiration {
+		return false, ErrInvalidTTL
+	}
+	expired := expiredAt(ttl)
+
+	mem.mu.Lock()
+	defer mem.mu.Unlock()
+
+	if _, ok := mem.get(key); ok {
+		return false, nil
+	}
+	mem.set(key, val, expired)
+	return true, nil
+}
+
+// TTL 返回剩余有效期
+func (mem *Memory) TTL(ctx context.Context, key string) (time.Duration, error) {
+	mem.mu.Lock()
+	defer mem.mu.Unlock()
+
+	d, ok := mem.get(key)
+	if !ok {
+		return 0, ErrNotFound
+	}
+	if d.Expired.IsZero() {
+		return NoExpiration, nil
+	}
+	return time.Until(d.Expired), nil
+}
+
+// DeleteContext 删除
+func (mem *Memory) DeleteContext(ctx context.Context, key string) error {
+	return mem.Delete(key)
+}
+
+// Lock 兼容旧版本 Memory 内嵌的 sync.Mutex，与缓存读写使用的锁无关
+//
+// Deprecated: Memory 已并发安全，无需加锁
+func (mem *Memory) Lock() {
+	mem.legacyMu.Lock()
+}
+
+// Unlock 见 Lock
+//
+// Deprecated: Memory 已并发安全，无需加锁
+func (mem *Memory) Unlock() {
+	mem.legacyMu.Unlock()
+}
+
+// Stats 返回命中、未命中等统计
+func (mem *Memory) Stats() MemoryStats {
+	mem.mu.Lock()
+	defer mem.mu.Unlock()
+
+	return MemoryStats{
+		Hits:      mem.hits,
+		Misses:    mem.misses,
+		Evictions: mem.evictions,
+		Entries:   mem.lru.Len(),
+	}
+}
+
+// DeleteExpired 删除所有过期数据
+func (mem *Memory) DeleteExpired() {
+	mem.deleteExpired()
+}
+
+func (mem *memory) deleteExpired() {
+	now := time.Now()
+
+	mem.mu.Lock()
+	defer mem.mu.Unlock()
+
+	for _, e := range mem.data {
+		if e.Value.(*data).expired(now) {
+			mem.remove(e)
+		}
+	}
+}
+
+// Close 停止后台清理，可重复调用，关闭后仍可读写
+func (mem *Memory) Close() error {
+	mem.closeOnce.Do(func() {
+		close(mem.stop)
+	})
 	return nil
 }
 
-// deleteKey
-func (mem *Memory) deleteKey(key string) {
-	mem.Lock()
-	defer mem.Unlock()
-	delete(mem.data, key)
+// get 获取未过期的数据并标记为最近使用，调用方需持有锁
+func (mem *memory) get(key string) (*data, bool) {
+	e, ok := mem.data[key]
+	if !ok {
+		return nil, false
+	}
+	d := e.Value.(*data)
+	if d.expired(time.Now()) {
+		mem.remove(e)
+		return nil, false
+	}
+	mem.lru.MoveToFront(e)
+	return d, true
+}
+
+// remove 调用方需持有锁
+func (mem *memory) remove(e *list.Element) {
+	mem.lru.Remove(e)
+	delete(mem.data, e.Value.(*data).key)
+}
+
+func (mem *memory) janitor(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			mem.deleteExpired()
+		case <-mem.stop:
+			return
+		}
+	}
 }
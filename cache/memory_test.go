@@ -0,0 +1,103 @@
+package cache
+
+import (
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemory(t *testing.T) {
+	mem := NewMemory()
+	defer mem.Close()
+
+	assert.Nil(t, mem.Set("username", "silenceper", time.Minute))
+	assert.True(t, mem.IsExist("username"))
+	assert.Equal(t, "silenceper", mem.Get("username"))
+	assert.Nil(t, mem.Get("unknown"))
+
+	assert.Nil(t, mem.Delete("username"))
+	assert.False(t, mem.IsExist("username"))
+
+	// NoExpiration 不过期
+	assert.Nil(t, mem.Set("forever", 1, NoExpiration))
+	assert.Equal(t, 1, mem.Get("forever"))
+
+	// 其余 timeout <= 0 立即过期
+	assert.Nil(t, mem.Set("zero", 1, 0))
+	assert.Nil(t, mem.Get("zero"))
+	assert.Nil(t, mem.Set("negative", 1, -time.Minute))
+	assert.False(t, mem.IsExist("negative"))
+
+	stats := mem.Stats()
+	assert.Equal(t, uint64(2), stats.Hits)
+	assert.Equal(t, uint64(2), stats.Misses)
+	assert.Equal(t, 1, stats.Entries)
+}
+
+func TestMemoryLegacyLock(t *testing.T) {
+	mem := NewMemory(WithCleanupInterval(0))
+	var l sync.Locker = mem
+
+	// 兼容旧代码加锁后读写缓存
+	l.Lock()
+	assert.Nil(t, mem.Set("k", "v", time.Minute))
+	assert.Equal(t, "v", mem.Get("k"))
+	l.Unlock()
+}
+
+func TestMemoryExpire(t *testing.T) {
+	mem := NewMemory(WithCleanupInterval(10 * time.Millisecond))
+	defer mem.Close()
+
+	assert.Nil(t, mem.Set("a", 1, time.Millisecond))
+	assert.Nil(t, mem.Set("b", 2, time.Minute))
+	// 过期数据未被读取也会被后台清理
+	assert.Eventually(t, func() bool { return mem.Stats().Entries == 1 }, time.Second, 5*time.Millisecond)
+	assert.Nil(t, mem.Get("a"))
+	assert.Equal(t, 2, mem.Get("b"))
+
+	assert.Nil(t, mem.Close())
+	assert.Nil(t, mem.Close())
+}
+
+func TestMemoryMaxEntries(t *testing.T) {
+	mem := NewMemory(WithMaxEntries(2), WithCleanupInterval(0))
+
+	assert.Nil(t, mem.Set("a", 1, time.Minute))
+	assert.Nil(t, mem.Set("b", 2, time.Minute))
+	// 访问 a 后 b 成为最久未使用
+	assert.Equal(t, 1, mem.Get("a"))
+	assert.Nil(t, mem.Set("c", 3, time.Minute))
+
+	assert.False(t, mem.IsExist("b"))
+	assert.True(t, mem.IsExist("a"))
+	assert.True(t, mem.IsExist("c"))
+	assert.Equal(t, uint64(1), mem.Stats().Evictions)
+}
+
+func TestMemoryConcurrent(t *testing.T) {
+	mem := NewMemory(WithMaxEntries(50), WithCleanupInterval(time.Millisecond))
+	defer mem.Close()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 200; j++ {
+				key := strconv.Itoa((i * j) % 100)
+				_ = mem.Set(key, j, time.Millisecond)
+				mem.Get(key)
+				mem.IsExist(key)
+				if j%10 == 0 {
+					_ = mem.Delete(key)
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+	assert.LessOrEqual(t, mem.Stats().Entries, 50)
+}
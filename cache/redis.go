@@ -2,6 +2,8 @@ package cache
 
 import (
 	"context"
+	"crypto/tls"
+	"errors"
 	"time"
 
 	"github.com/go-redis/redis/v8"
@@ -9,30 +11,59 @@ import (
 
 // Redis .redis cache
 type Redis struct {
-	ctx  context.Context
-	conn redis.UniversalClient
+	ctx    context.Context
+	conn   redis.UniversalClient
+	prefix string
 }
 
 // RedisOpts redis 连接属性
+//
+// 仅设置 Host 或 Addrs 只有一个地址时连接单机；设置 MasterName 时 Addrs 为哨兵地址；
+// Addrs 有多个地址且未设置 MasterName 时连接集群（集群模式忽略 Database）
 type RedisOpts struct {
-	Host        string `yml:"host" json:"host"`
-	Password    string `yml:"password" json:"password"`
-	Database    int    `yml:"database" json:"database"`
-	MaxIdle     int    `yml:"max_idle" json:"max_idle"`
-	MaxActive   int    `yml:"max_active" json:"max_active"`
-	IdleTimeout int    `yml:"idle_timeout" json:"idle_timeout"` // second
+	Host             string      `yml:"host" json:"host"`
+	Addrs            []string    `yml:"addrs" json:"addrs"`
+	MasterName       string      `yml:"master_name" json:"master_name"`
+	Username         string      `yml:"username" json:"username"`
+	Password         string      `yml:"password" json:"password"`
+	SentinelPassword string      `yml:"sentinel_password" json:"sentinel_password"`
+	Database         int         `yml:"database" json:"database"`
+	MaxIdle          int         `yml:"max_idle" json:"max_idle"`
+	MaxActive        int         `yml:"max_active" json:"max_active"`
+	IdleTimeout      int         `yml:"idle_timeout" json:"idle_timeout"`   // second
+	DialTimeout      int         `yml:"dial_timeout" json:"dial_timeout"`   // second
+	ReadTimeout      int         `yml:"read_timeout" json:"read_timeout"`   // second
+	WriteTimeout     int         `yml:"write_timeout" json:"write_timeout"` // second
+	TLSConfig        *tls.Config `yml:"-" json:"-"`                         // 不为空时使用 TLS 连接
+	KeyPrefix        string      `yml:"key_prefix" json:"key_prefix"`       // 所有 key 的前缀，如按环境区分
 }
 
 // NewRedis 实例化
 func NewRedis(ctx context.Context, opts *RedisOpts) *Redis {
-	conn := redis.NewUniversalClient(&redis.UniversalOptions{
-		Addrs:        []string{opts.Host},
-		DB:           opts.Database,
-		Password:     opts.Password,
-		IdleTimeout:  time.Second * time.Duration(opts.IdleTimeout),
-		MinIdleConns: opts.MaxIdle,
-	})
-	return &Redis{ctx: ctx, conn: conn}
+	conn := redis.NewUniversalClient(opts.universalOptions())
+	return &Redis{ctx: ctx, conn: conn, prefix: opts.KeyPrefix}
+}
+
+func (opts *RedisOpts) universalOptions() *redis.UniversalOptions {
+	addrs := opts.Addrs
+	if len(addrs) == 0 && opts.Host != "" {
+		addrs = []string{opts.Host}
+	}
+	return &redis.UniversalOptions{
+		Addrs:            addrs,
+		MasterName:       opts.MasterName,
+		DB:               opts.Database,
+		Username:         opts.Username,
+		Password:         opts.Password,
+		SentinelPassword: opts.SentinelPassword,
+		IdleTimeout:      time.Second * time.Duration(opts.IdleTimeout),
+		DialTimeout:      time.Second * time.Duration(opts.DialTimeout),
+		ReadTimeout:      time.Second * time.Duration(opts.ReadTimeout),
+		WriteTimeout:     time.Second * time.Duration(opts.WriteTimeout),
+		MinIdleConns:     opts.MaxIdle,
+		PoolSize:         opts.MaxActive,
+		TLSConfig:        opts.TLSConfig,
+	}
 }
 
 // SetConn 设置conn
@@ -45,9 +76,18 @@ func (r *Redis) SetRedisCtx(ctx context.Context) {
 	r.ctx = ctx
 }
 
+// SetKeyPrefix 设置所有 key 的前缀
+func (r *Redis) SetKeyPrefix(prefix string) {
+	r.prefix = prefix
+}
+
+func (r *Redis) key(key string) string {
+	return r.prefix + key
+}
+
 // Get 获取一个值
 func (r *Redis) Get(key string) interface{} {
-	result, err := r.conn.Do(r.ctx, "GET", key).Result()
+	result, err := r.conn.Do(r.ctx, "GET", r.key(key)).Result()
 	if err != nil {
 		return nil
 	}
@@ -56,17 +96,114 @@ func (r *Redis) Get(key string) interface{} {
 
 // Set 设置一个值
 func (r *Redis) Set(key string, val interface{}, timeout time.Duration) error {
-	return r.conn.SetEX(r.ctx, key, val, timeout).Err()
+	return r.conn.SetEX(r.ctx, r.key(key), val, timeout).Err()
 }
 
 // IsExist 判断key是否存在
 func (r *Redis) IsExist(key string) bool {
-	result, _ := r.conn.Exists(r.ctx, key).Result()
+	result, _ := r.conn.Exists(r.ctx, r.key(key)).Result()
 
 	return result > 0
 }
 
 // Delete 删除
 func (r *Redis) Delete(key string) error {
-	return r.conn.Del(r.ctx, key).Err()
+	return r.conn.Del(r.ctx, r.key(key)).Err()
+}
+
+// unlockScript 仅当锁仍由当前持有者持有时删除
+var unlockScript = redis.NewScript(`if redis.call("GET", KEYS[1]) == ARGV[1] then return redis.call("DEL", KEYS[1]) else return 0 end`)
+
+// Lock 基于 SET NX 的分布式锁
+func (r *Redis) Lock(ctx context.Context, key string, ttl time.Duration) (func() error, error) {
+	token := lockToken()
+	for {
+		ok, err := r.conn.SetNX(ctx, r.key(key), token, ttl).Result()
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			return func() error {
+				return unlockScript.Run(r.ctx, r.conn, []string{r.key(key)}, token).Err()
+			}, nil
+		}
+		if err = waitLock(ctx); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// GetString 获取字符串
+func (r *Redis) GetString(ctx context.Context, key string) (string, error) {
+	val, err := r.conn.Get(ctx, r.key(key)).Result()
+	if errors.Is(err, redis.Nil) {
+		return "", ErrNotFound
+	}
+	return val, err
+}
+
+// SetString 写入字符串，ttl 为 NoExpiration 时永不过期，其余 ttl <= 0 时删除 key
+func (r *Redis) SetString(ctx context.Context, key, val string, ttl time.Duration) error {
+	return r.set(ctx, key, val, ttl)
+}
+
+// GetBytes 获取字节
+func (r *Redis) GetBytes(ctx context.Context, key string) ([]byte, error) {
+	val, err := r.conn.Get(ctx, r.key(key)).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, ErrNotFound
+	}
+	return val, err
+}
+
+// SetBytes 写入字节，ttl 为 NoExpiration 时永不过期，其余 ttl <= 0 时删除 key
+func (r *Redis) SetBytes(ctx context.Context, key string, val []byte, ttl time.Duration) error {
+	return r.set(ctx, key, val, ttl)
+}
+
+func (r *Redis) set(ctx context.Context, key string, val interface{}, ttl time.Duration) error {
+	expiration, ok := redisExpiration(ttl)
+	if !ok {
+		return r.conn.Del(ctx, r.key(key)).Err()
+	}
+	return r.conn.Set(ctx, r.key(key), val, expiration).Err()
+}
+
+// SetNX key 不存在时写入，ttl 不为正数且不为 NoExpiration 时返回 ErrInvalidTTL
+func (r *Redis) SetNX(ctx context.Context, key, val string, ttl time.Duration) (bool, error) {
+	expiration, ok := redisExpiration(ttl)
+	if !ok {
+		return false, ErrInvalidTTL
+	}
+	return r.conn.SetNX(ctx, r.key(key), val, expiration).Result()
+}
+
+// TTL 返回剩余有效期
+func (r *Redis) TTL(ctx context.Context, key string) (time.Duration, error) {
+	ttl, err := r.conn.TTL(ctx, r.key(key)).Result()
+	if err != nil {
+		return 0, err
+	}
+	// key 不存在时返回 -2，未设置过期时间时返回 -1
+	switch ttl {
+	case -2:
+		return 0, ErrNotFound
+	case -1:
+		return NoExpiration, nil
+	}
+	return ttl, nil
+}
+
+// DeleteContext 删除
+func (r *Redis) DeleteContext(ctx context.Context, key string) error {
+	return r.conn.Del(ctx, r.key(key)).Err()
+}
+
+// redisExpiration 将 ttl 转换为 go-redis 的过期时间，go-redis 中 0 表示永不过期、-1 表示保留原有过期时间，
+// 因此 NoExpiration 转换为 0；其余 ttl <= 0 时返回 false
+func redisExpiration(ttl time.Duration) (time.Duration, bool) {
+	if ttl == NoExpiration {
+		return 0, true
+	}
+	return ttl, ttl > 0
 }
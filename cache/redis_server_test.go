@@ -0,0 +1,222 @@
+package cache
+
+import (
+	"bufio"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeRedis 测试用的最小 redis 服务，仅支持 cache 包用到的命令
+type fakeRedis struct {
+	ln net.Listener
+
+	mu      sync.Mutex
+	data    map[string]string
+	expires map[string]time.Time
+}
+
+func newFakeRedis(t *testing.T, tlsConfig *tls.Config) *fakeRedis {
+	t.Helper()
+	var (
+		ln  net.Listener
+		err error
+	)
+	if tlsConfig != nil {
+		ln, err = tls.Listen("tcp", "127.0.0.1:0", tlsConfig)
+	} else {
+		ln, err = net.Listen("tcp", "127.0.0.1:0")
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &fakeRedis{ln: ln, data: map[string]string{}, expires: map[string]time.Time{}}
+	t.Cleanup(func() { _ = ln.Close() })
+	go s.serve()
+	return s
+}
+
+func (s *fakeRedis) Addr() string {
+	return s.ln.Addr().String()
+}
+
+// Keys 返回当前所有 key
+func (s *fakeRedis) Keys() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	keys := make([]string, 0, len(s.data))
+	for k := range s.data {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+func (s *fakeRedis) serve() {
+	for {
+		conn, err := s.ln.Accept()
+		if err != nil {
+			return
+		}
+		go s.handle(conn)
+	}
+}
+
+func (s *fakeRedis) handle(conn net.Conn) {
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+	for {
+		args, err := readCommand(r)
+		if err != nil {
+			return
+		}
+		if _, err = io.WriteString(conn, s.exec(args)); err != nil {
+			return
+		}
+	}
+}
+
+func readCommand(r *bufio.Reader) ([]string, error) {
+	line, err := r.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+	if !strings.HasPrefix(line, "*") {
+		return nil, fmt.Errorf("unexpected %q", line)
+	}
+	n, err := strconv.Atoi(strings.TrimSpace(line[1:]))
+	if err != nil {
+		return nil, err
+	}
+	args := make([]string, n)
+	for i := range args {
+		if line, err = r.ReadString('\n'); err != nil {
+			return nil, err
+		}
+		size, err := strconv.Atoi(strings.TrimSpace(line[1:]))
+		if err != nil {
+			return nil, err
+		}
+		buf := make([]byte, size+2)
+		if _, err = io.ReadFull(r, buf); err != nil {
+			return nil, err
+		}
+		args[i] = string(buf[:size])
+	}
+	return args, nil
+}
+
+func bulk(s string) string {
+	return fmt.Sprintf("$%d\r\n%s\r\n", len(s), s)
+}
+
+func integer(n int64) string {
+	return fmt.Sprintf(":%d\r\n", n)
+}
+
+const (
+	respOK  = "+OK\r\n"
+	respNil = "$-1\r\n"
+)
+
+func (s *fakeRedis) exec(args []string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch strings.ToUpper(args[0]) {
+	case "PING":
+		return "+PONG\r\n"
+	case "AUTH", "SELECT":
+		return respOK
+	case "GET":
+		if v, ok := s.get(args[1]); ok {
+			return bulk(v)
+		}
+		return respNil
+	case "SET":
+		var (
+			ttl time.Duration
+			nx  bool
+		)
+		for i := 3; i < len(args); i++ {
+			switch strings.ToUpper(args[i]) {
+			case "NX":
+				nx = true
+			case "EX", "PX":
+				n, _ := strconv.Atoi(args[i+1])
+				ttl = time.Duration(n) * time.Second
+				if strings.ToUpper(args[i]) == "PX" {
+					ttl = time.Duration(n) * time.Millisecond
+				}
+				i++
+			}
+		}
+		if _, ok := s.get(args[1]); ok && nx {
+			return respNil
+		}
+		s.set(args[1], args[2], ttl)
+		return respOK
+	case "SETEX":
+		n, _ := strconv.Atoi(args[2])
+		s.set(args[1], args[3], time.Duration(n)*time.Second)
+		return respOK
+	case "EXISTS", "DEL":
+		var n int64
+		for _, k := range args[1:] {
+			if _, ok := s.get(k); ok {
+				n++
+				if strings.ToUpper(args[0]) == "DEL" {
+					delete(s.data, k)
+					delete(s.expires, k)
+				}
+			}
+		}
+		return integer(n)
+	case "TTL":
+		if _, ok := s.get(args[1]); !ok {
+			return integer(-2)
+		}
+		exp, ok := s.expires[args[1]]
+		if !ok {
+			return integer(-1)
+		}
+		return integer(int64(time.Until(exp).Round(time.Second) / time.Second))
+	case "EVALSHA":
+		return "-NOSCRIPT No matching script\r\n"
+	case "EVAL":
+		// 仅支持 unlockScript：EVAL script 1 key token
+		if v, ok := s.get(args[3]); ok && v == args[4] {
+			delete(s.data, args[3])
+			delete(s.expires, args[3])
+			return integer(1)
+		}
+		return integer(0)
+	}
+	return fmt.Sprintf("-ERR unknown command '%s'\r\n", args[0])
+}
+
+func (s *fakeRedis) get(key string) (string, bool) {
+	v, ok := s.data[key]
+	if !ok {
+		return "", false
+	}
+	if exp, ok := s.expires[key]; ok && !exp.After(time.Now()) {
+		delete(s.data, key)
+		delete(s.expires, key)
+		return "", false
+	}
+	return v, true
+}
+
+func (s *fakeRedis) set(key, val string, ttl time.Duration) {
+	s.data[key] = val
+	delete(s.expires, key)
+	if ttl > 0 {
+		s.expires[key] = time.Now().Add(ttl)
+	}
+}
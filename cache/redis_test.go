@@ -2,16 +2,22 @@ package cache
 
 import (
 	"context"
+	"crypto/tls"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
+
+	"github.com/stretchr/testify/assert"
 )
 
 func TestRedis(t *testing.T) {
 	var (
+		server          = newFakeRedis(t, nil)
 		timeoutDuration = time.Second
 		ctx             = context.Background()
 		opts            = &RedisOpts{
-			Host: "127.0.0.1:6379",
+			Host: server.Addr(),
 		}
 		redis = NewRedis(ctx, opts)
 		err   error
@@ -38,3 +44,90 @@ func TestRedis(t *testing.T) {
 		t.Errorf("delete Error , err=%v", err)
 	}
 }
+
+func TestRedisOpts(t *testing.T) {
+	opts := (&RedisOpts{Host: "127.0.0.1:6379", MaxActive: 20}).universalOptions()
+	assert.Equal(t, []string{"127.0.0.1:6379"}, opts.Addrs)
+	assert.Equal(t, 20, opts.PoolSize)
+
+	// Addrs 优先于 Host
+	opts = (&RedisOpts{
+		Host:       "127.0.0.1:6379",
+		Addrs:      []string{"10.0.0.1:26379", "10.0.0.2:26379"},
+		MasterName: "mymaster",
+	}).universalOptions()
+	assert.Equal(t, []string{"10.0.0.1:26379", "10.0.0.2:26379"}, opts.Addrs)
+	assert.Equal(t, "mymaster", opts.MasterName)
+}
+
+func TestRedisKeyPrefix(t *testing.T) {
+	server := newFakeRedis(t, nil)
+	ctx := context.Background()
+	r := NewRedis(ctx, &RedisOpts{Host: server.Addr(), KeyPrefix: "test:"})
+
+	assert.NoError(t, r.Set("gowechat_officialaccount_access_token_appid", "token", time.Minute))
+	assert.Equal(t, []string{"test:gowechat_officialaccount_access_token_appid"}, server.Keys())
+	assert.True(t, r.IsExist("gowechat_officialaccount_access_token_appid"))
+	assert.Equal(t, "token", r.Get("gowechat_officialaccount_access_token_appid"))
+
+	ok, err := r.SetNX(ctx, "nx", "v", time.Minute)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	ok, err = r.SetNX(ctx, "nx", "v", time.Minute)
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	ttl, err := r.TTL(ctx, "nx")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Minute, ttl)
+	_, err = r.TTL(ctx, "missing")
+	assert.ErrorIs(t, err, ErrNotFound)
+	_, err = r.GetString(ctx, "missing")
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	unlock, err := r.Lock(ctx, "lock", time.Minute)
+	assert.NoError(t, err)
+	assert.Contains(t, server.Keys(), "test:lock")
+	assert.NoError(t, unlock())
+	assert.NotContains(t, server.Keys(), "test:lock")
+
+	assert.NoError(t, r.Delete("nx"))
+	assert.False(t, r.IsExist("nx"))
+}
+
+func TestRedisContextCacheTTL(t *testing.T) {
+	server := newFakeRedis(t, nil)
+	ctx := context.Background()
+	r := NewRedis(ctx, &RedisOpts{Host: server.Addr()})
+
+	assert.NoError(t, r.SetString(ctx, "forever", "v", NoExpiration))
+	ttl, err := r.TTL(ctx, "forever")
+	assert.NoError(t, err)
+	assert.Equal(t, NoExpiration, ttl)
+
+	// 其余 ttl <= 0 时不写入，已有的 key 被删除
+	assert.NoError(t, r.SetString(ctx, "forever", "v", -time.Second))
+	_, err = r.GetString(ctx, "forever")
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.NoError(t, r.SetBytes(ctx, "zero", []byte("v"), 0))
+	assert.Empty(t, server.Keys())
+
+	ok, err := r.SetNX(ctx, "nx", "v", -time.Second)
+	assert.ErrorIs(t, err, ErrInvalidTTL)
+	assert.False(t, ok)
+	assert.Empty(t, server.Keys())
+}
+
+func TestRedisTLS(t *testing.T) {
+	// 借用 httptest 生成的自签名证书
+	ts := httptest.NewTLSServer(http.NotFoundHandler())
+	defer ts.Close()
+	server := newFakeRedis(t, &tls.Config{Certificates: ts.TLS.Certificates})
+
+	r := NewRedis(context.Background(), &RedisOpts{
+		Host:      server.Addr(),
+		TLSConfig: ts.Client().Transport.(*http.Transport).TLSClientConfig,
+	})
+	assert.NoError(t, r.Set("key", "val", time.Minute))
+	assert.Equal(t, "val", r.Get("key"))
+}
@@ -1,6 +1,22 @@
 package credential
 
+import "context"
+
 // AccessTokenHandle AccessToken 接口
 type AccessTokenHandle interface {
 	GetAccessToken() (accessToken string, err error)
 }
+
+// AccessTokenContextHandle AccessToken 接口
+type AccessTokenContextHandle interface {
+	AccessTokenHandle
+	GetAccessTokenContext(ctx context.Context) (accessToken string, err error)
+}
+
+// AccessTokenInvalidator 可使缓存的 access_token 失效的 AccessToken 接口
+//
+// 微信提前废弃 access_token 时（如其他服务调用了获取token接口），请求层会调用 InvalidateAccessToken 后重新获取
+type AccessTokenInvalidator interface {
+	// InvalidateAccessToken 缓存中的值仍为 accessToken 时将其删除，避免删除并发请求已刷新的新值
+	InvalidateAccessToken(accessToken string) error
+}
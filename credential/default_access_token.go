@@ -1,6 +1,7 @@
 package credential
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"sync"
@@ -23,28 +24,46 @@ const (
 	CacheKeyMiniProgramPrefix = "gowechat_miniprogram_"
 	// CacheKeyWorkPrefix 企业微信cache key前缀
 	CacheKeyWorkPrefix = "gowechat_work_"
+
+	// lockKeySuffix 刷新凭证时锁的 key 后缀
+	lockKeySuffix = "_lock"
+	// refreshLockTTL 刷新凭证时锁的过期时间
+	refreshLockTTL = 10 * time.Second
 )
 
+// defaultLocker cache 未实现 cache.Locker 时使用的进程内锁
+var defaultLocker cache.Locker = cache.NewMemoryLocker()
+
+// lockerOf 优先使用 cache 提供的分布式锁，多实例共享 Redis/Memcache 时只有一个实例会刷新凭证
+func lockerOf(c cache.Cache) cache.Locker {
+	if locker, ok := c.(cache.Locker); ok {
+		return locker
+	}
+	return defaultLocker
+}
+
 // DefaultAccessToken 默认AccessToken 获取
 type DefaultAccessToken struct {
 	appID           string
 	appSecret       string
 	cacheKeyPrefix  string
-	cache           cache.Cache
+	cache           cache.ContextCache
 	accessTokenLock *sync.Mutex
+	locker          cache.Locker
 }
 
 // NewDefaultAccessToken new DefaultAccessToken
-func NewDefaultAccessToken(appID, appSecret, cacheKeyPrefix string, cache cache.Cache) AccessTokenHandle {
-	if cache == nil {
+func NewDefaultAccessToken(appID, appSecret, cacheKeyPrefix string, c cache.Cache) AccessTokenHandle {
+	if c == nil {
 		panic("cache is ineed")
 	}
 	return &DefaultAccessToken{
 		appID:           appID,
 		appSecret:       appSecret,
-		cache:           cache,
+		cache:           cache.FromCache(c),
 		cacheKeyPrefix:  cacheKeyPrefix,
 		accessTokenLock: new(sync.Mutex),
+		locker:          lockerOf(c),
 	}
 }
 
@@ -58,10 +77,15 @@ type ResAccessToken struct {
 
 // GetAccessToken 获取access_token,先从cache中获取，没有则从服务端获取
 func (ak *DefaultAccessToken) GetAccessToken() (accessToken string, err error) {
+	return ak.GetAccessTokenContext(context.Background())
+}
+
+// GetAccessTokenContext 获取access_token,先从cache中获取，没有则从服务端获取
+func (ak *DefaultAccessToken) GetAccessTokenContext(ctx context.Context) (accessToken string, err error) {
 	// 先从cache中取
-	accessTokenCacheKey := fmt.Sprintf("%s_access_token_%s", ak.cacheKeyPrefix, ak.appID)
-	if val := ak.cache.Get(accessTokenCacheKey); val != nil {
-		return val.(string), nil
+	accessTokenCacheKey := ak.cacheKey()
+	if val, ok := cachedString(ctx, ak.cache, accessTokenCacheKey); ok {
+		return val, nil
 	}
 
 	// 加上lock，是为了防止在并发获取token时，cache刚好失效，导致从微信服务器上获取到不同token
@@ -69,19 +93,28 @@ func (ak *DefaultAccessToken) GetAccessToken() (accessToken string, err error) {
 	defer ak.accessTokenLock.Unlock()
 
 	// 双检，防止重复从微信服务器获取
-	if val := ak.cache.Get(accessTokenCacheKey); val != nil {
-		return val.(string), nil
+	if val, ok := cachedString(ctx, ak.cache, accessTokenCacheKey); ok {
+		return val, nil
+	}
+
+	// 多实例部署时，通过锁保证同一时刻只有一个实例从微信服务器获取，获取锁后其他实例可能已刷新
+	unlock, err := ak.locker.Lock(ctx, accessTokenCacheKey+lockKeySuffix, refreshLockTTL)
+	if err != nil {
+		return
+	}
+	defer func() { _ = unlock() }()
+	if val, ok := cachedString(ctx, ak.cache, accessTokenCacheKey); ok {
+		return val, nil
 	}
 
 	// cache失效，从微信服务器获取
 	var resAccessToken ResAccessToken
-	resAccessToken, err = GetTokenFromServer(fmt.Sprintf(accessTokenURL, ak.appID, ak.appSecret))
+	resAccessToken, err = GetTokenFromServerContext(ctx, fmt.Sprintf(accessTokenURL, ak.appID, ak.appSecret))
 	if err != nil {
 		return
 	}
 
-	expires := resAccessToken.ExpiresIn - 1500
-	err = ak.cache.Set(accessTokenCacheKey, resAccessToken.AccessToken, time.Duration(expires)*time.Second)
+	err = CacheTokenContext(ctx, ak.cache, accessTokenCacheKey, resAccessToken.AccessToken, resAccessToken.ExpiresIn)
 	if err != nil {
 		return
 	}
@@ -89,53 +122,96 @@ func (ak *DefaultAccessToken) GetAccessToken() (accessToken string, err error) {
 	return
 }
 
+// TokenMeta 返回缓存中access_token的签发与过期时间
+func (ak *DefaultAccessToken) TokenMeta() (TokenMeta, bool) {
+	return CachedTokenMetaContext(context.Background(), ak.cache, ak.cacheKey())
+}
+
+// Renew 提前从微信服务器获取新的access_token，多实例部署时只有一个实例会刷新
+func (ak *DefaultAccessToken) Renew(ctx context.Context) error {
+	return renewCachedToken(ctx, ak.cache, ak.accessTokenLock, ak.locker, ak.cacheKey(), func(ctx context.Context) (string, int64, error) {
+		resAccessToken, err := GetTokenFromServerContext(ctx, fmt.Sprintf(accessTokenURL, ak.appID, ak.appSecret))
+		return resAccessToken.AccessToken, resAccessToken.ExpiresIn, err
+	})
+}
+
+// SetLocker 设置刷新access_token时使用的锁，默认使用 cache 实现的分布式锁或进程内锁
+func (ak *DefaultAccessToken) SetLocker(locker cache.Locker) {
+	ak.locker = locker
+}
+
+// InvalidateAccessToken 使缓存的access_token失效
+func (ak *DefaultAccessToken) InvalidateAccessToken(accessToken string) error {
+	return invalidateCachedToken(context.Background(), ak.cache, ak.accessTokenLock, ak.cacheKey(), accessToken)
+}
+
+func (ak *DefaultAccessToken) cacheKey() string {
+	return fmt.Sprintf("%s_access_token_%s", ak.cacheKeyPrefix, ak.appID)
+}
+
 // WorkAccessToken 企业微信AccessToken 获取
 type WorkAccessToken struct {
 	CorpID          string
 	AgentId         int
 	CorpSecret      string
 	cacheKeyPrefix  string
-	cache           cache.Cache
+	cache           cache.ContextCache
 	accessTokenLock *sync.Mutex
+	locker          cache.Locker
 }
 
 // NewWorkAccessToken new WorkAccessToken
-func NewWorkAccessToken(corpID string, agentId int, corpSecret, cacheKeyPrefix string, cache cache.Cache) AccessTokenHandle {
-	if cache == nil {
+func NewWorkAccessToken(corpID string, agentId int, corpSecret, cacheKeyPrefix string, c cache.Cache) AccessTokenHandle {
+	if c == nil {
 		panic("cache the not exist")
 	}
 	return &WorkAccessToken{
 		CorpID:          corpID,
 		CorpSecret:      corpSecret,
 		AgentId:         agentId,
-		cache:           cache,
+		cache:           cache.FromCache(c),
 		cacheKeyPrefix:  cacheKeyPrefix,
 		accessTokenLock: new(sync.Mutex),
+		locker:          lockerOf(c),
 	}
 }
 
 // GetAccessToken 企业微信获取access_token,先从cache中获取，没有则从服务端获取
 func (ak *WorkAccessToken) GetAccessToken() (accessToken string, err error) {
+	return ak.GetAccessTokenContext(context.Background())
+}
+
+// GetAccessTokenContext 企业微信获取access_token,先从cache中获取，没有则从服务端获取
+func (ak *WorkAccessToken) GetAccessTokenContext(ctx context.Context) (accessToken string, err error) {
 	// 加上lock，是为了防止在并发获取token时，cache刚好失效，导致从微信服务器上获取到不同token
 	ak.accessTokenLock.Lock()
 	defer ak.accessTokenLock.Unlock()
 	// corpSecretMd5Key, _ := util.CalculateSign(ak.CorpSecret, "MD5", "")
-	accessTokenCacheKey := fmt.Sprintf("%s_access_token_%s_%d", ak.cacheKeyPrefix, ak.CorpID, ak.AgentId)
-	val := ak.cache.Get(accessTokenCacheKey)
-	if val != nil {
-		accessToken = val.(string)
+	accessTokenCacheKey := ak.cacheKey()
+	if val, ok := cachedString(ctx, ak.cache, accessTokenCacheKey); ok {
+		accessToken = val
+		return
+	}
+
+	// 多实例部署时，通过锁保证同一时刻只有一个实例从微信服务器获取，获取锁后其他实例可能已刷新
+	unlock, err := ak.locker.Lock(ctx, accessTokenCacheKey+lockKeySuffix, refreshLockTTL)
+	if err != nil {
+		return
+	}
+	defer func() { _ = unlock() }()
+	if val, ok := cachedString(ctx, ak.cache, accessTokenCacheKey); ok {
+		accessToken = val
 		return
 	}
 
 	// cache失效，从微信服务器获取
 	var resAccessToken ResAccessToken
-	resAccessToken, err = GetTokenFromServer(fmt.Sprintf(workAccessTokenURL, ak.CorpID, ak.CorpSecret))
+	resAccessToken, err = GetTokenFromServerContext(ctx, fmt.Sprintf(workAccessTokenURL, ak.CorpID, ak.CorpSecret))
 	if err != nil {
 		return
 	}
 
-	expires := resAccessToken.ExpiresIn - 1500
-	err = ak.cache.Set(accessTokenCacheKey, resAccessToken.AccessToken, time.Duration(expires)*time.Second)
+	err = CacheTokenContext(ctx, ak.cache, accessTokenCacheKey, resAccessToken.AccessToken, resAccessToken.ExpiresIn)
 	if err != nil {
 		return
 	}
@@ -143,20 +219,48 @@ func (ak *WorkAccessToken) GetAccessToken() (accessToken string, err error) {
 	return
 }
 
+// TokenMeta 返回缓存中access_token的签发与过期时间
+func (ak *WorkAccessToken) TokenMeta() (TokenMeta, bool) {
+	return CachedTokenMetaContext(context.Background(), ak.cache, ak.cacheKey())
+}
+
+// Renew 提前从微信服务器获取新的access_token，多实例部署时只有一个实例会刷新
+func (ak *WorkAccessToken) Renew(ctx context.Context) error {
+	return renewCachedToken(ctx, ak.cache, ak.accessTokenLock, ak.locker, ak.cacheKey(), func(ctx context.Context) (string, int64, error) {
+		resAccessToken, err := GetTokenFromServerContext(ctx, fmt.Sprintf(workAccessTokenURL, ak.CorpID, ak.CorpSecret))
+		return resAccessToken.AccessToken, resAccessToken.ExpiresIn, err
+	})
+}
+
+// SetLocker 设置刷新access_token时使用的锁，默认使用 cache 实现的分布式锁或进程内锁
+func (ak *WorkAccessToken) SetLocker(locker cache.Locker) {
+	ak.locker = locker
+}
+
+// InvalidateAccessToken 使缓存的access_token失效
+func (ak *WorkAccessToken) InvalidateAccessToken(accessToken string) error {
+	return invalidateCachedToken(context.Background(), ak.cache, ak.accessTokenLock, ak.cacheKey(), accessToken)
+}
+
+func (ak *WorkAccessToken) cacheKey() string {
+	return fmt.Sprintf("%s_access_token_%s_%d", ak.cacheKeyPrefix, ak.CorpID, ak.AgentId)
+}
+
 // WorkCorpChainAccessToken 企业微信AccessToken 获取
 type WorkCorpChainAccessToken struct {
 	CorpID                string
 	AgentID               int
 	BusinessType          int
 	cacheKeyPrefix        string
-	cache                 cache.Cache
+	cache                 cache.ContextCache
 	accessTokenLock       *sync.Mutex
+	locker                cache.Locker
 	parentCorpAccessToken *WorkAccessToken
 }
 
 // NewWorkCorpChainAccessToken new WorkCorpChainAccessToken
-func NewWorkCorpChainAccessToken(parentCorpAccessTokenHandle AccessTokenHandle, chainCorpId string, agentId int, cacheKeyPrefix string, bizType int, cache cache.Cache) AccessTokenHandle {
-	if cache == nil {
+func NewWorkCorpChainAccessToken(parentCorpAccessTokenHandle AccessTokenHandle, chainCorpId string, agentId int, cacheKeyPrefix string, bizType int, c cache.Cache) AccessTokenHandle {
+	if c == nil {
 		panic("cache the not exist")
 	}
 	parentWorkAccessToken, ok := parentCorpAccessTokenHandle.(*WorkAccessToken)
@@ -168,31 +272,46 @@ func NewWorkCorpChainAccessToken(parentCorpAccessTokenHandle AccessTokenHandle,
 		CorpID:                chainCorpId,
 		AgentID:               agentId,
 		BusinessType:          bizType,
-		cache:                 cache,
+		cache:                 cache.FromCache(c),
 		cacheKeyPrefix:        cacheKeyPrefix,
 		accessTokenLock:       new(sync.Mutex),
+		locker:                lockerOf(c),
 	}
 }
 
 // GetAccessToken 获取下级/下游企业的access_token,先从cache中获取，没有则从服务端获取
 func (ak *WorkCorpChainAccessToken) GetAccessToken() (accessToken string, err error) {
+	return ak.GetAccessTokenContext(context.Background())
+}
+
+// GetAccessTokenContext 获取下级/下游企业的access_token,先从cache中获取，没有则从服务端获取
+func (ak *WorkCorpChainAccessToken) GetAccessTokenContext(ctx context.Context) (accessToken string, err error) {
 	// 加上lock，是为了防止在并发获取token时，cache刚好失效，导致从微信服务器上获取到不同token
 	ak.accessTokenLock.Lock()
 	defer ak.accessTokenLock.Unlock()
-	accessTokenCacheKey := fmt.Sprintf("%s_chain_access_token_%s_%d", ak.cacheKeyPrefix, ak.CorpID, ak.AgentID)
-	val := ak.cache.Get(accessTokenCacheKey)
-	if val != nil {
-		accessToken = val.(string)
+	accessTokenCacheKey := ak.cacheKey()
+	if val, ok := cachedString(ctx, ak.cache, accessTokenCacheKey); ok {
+		accessToken = val
+		return
+	}
+
+	// 多实例部署时，通过锁保证同一时刻只有一个实例从微信服务器获取，获取锁后其他实例可能已刷新
+	unlock, err := ak.locker.Lock(ctx, accessTokenCacheKey+lockKeySuffix, refreshLockTTL)
+	if err != nil {
+		return
+	}
+	defer func() { _ = unlock() }()
+	if val, ok := cachedString(ctx, ak.cache, accessTokenCacheKey); ok {
+		accessToken = val
 		return
 	}
 	// cache失效，从微信服务器获取
 	var resAccessToken ResAccessToken
-	resAccessToken, err = ak.getWorkCorpChainTokenFromServer()
+	resAccessToken, err = ak.getWorkCorpChainTokenFromServer(ctx)
 	if err != nil {
 		return
 	}
-	expires := resAccessToken.ExpiresIn - 1500
-	err = ak.cache.Set(accessTokenCacheKey, resAccessToken.AccessToken, time.Duration(expires)*time.Second)
+	err = CacheTokenContext(ctx, ak.cache, accessTokenCacheKey, resAccessToken.AccessToken, resAccessToken.ExpiresIn)
 	if err != nil {
 		return
 	}
@@ -200,6 +319,33 @@ func (ak *WorkCorpChainAccessToken) GetAccessToken() (accessToken string, err er
 	return
 }
 
+// TokenMeta 返回缓存中access_token的签发与过期时间
+func (ak *WorkCorpChainAccessToken) TokenMeta() (TokenMeta, bool) {
+	return CachedTokenMetaContext(context.Background(), ak.cache, ak.cacheKey())
+}
+
+// Renew 提前从微信服务器获取新的下级/下游企业的access_token，多实例部署时只有一个实例会刷新
+func (ak *WorkCorpChainAccessToken) Renew(ctx context.Context) error {
+	return renewCachedToken(ctx, ak.cache, ak.accessTokenLock, ak.locker, ak.cacheKey(), func(ctx context.Context) (string, int64, error) {
+		resAccessToken, err := ak.getWorkCorpChainTokenFromServer(ctx)
+		return resAccessToken.AccessToken, resAccessToken.ExpiresIn, err
+	})
+}
+
+// SetLocker 设置刷新access_token时使用的锁，默认使用 cache 实现的分布式锁或进程内锁
+func (ak *WorkCorpChainAccessToken) SetLocker(locker cache.Locker) {
+	ak.locker = locker
+}
+
+// InvalidateAccessToken 使缓存的access_token失效
+func (ak *WorkCorpChainAccessToken) InvalidateAccessToken(accessToken string) error {
+	return invalidateCachedToken(context.Background(), ak.cache, ak.accessTokenLock, ak.cacheKey(), accessToken)
+}
+
+func (ak *WorkCorpChainAccessToken) cacheKey() string {
+	return fmt.Sprintf("%s_chain_access_token_%s_%d", ak.cacheKeyPrefix, ak.CorpID, ak.AgentID)
+}
+
 func (ak *WorkCorpChainAccessToken) GetParentAccessToken() (accessToken string, err error) {
 	accessToken, err = ak.parentCorpAccessToken.GetAccessToken()
 	if err != nil {
@@ -214,18 +360,18 @@ type ReqWorkCorpChainToken struct {
 	AgentId int    `json:"agentid"`
 }
 
-func (ak *WorkCorpChainAccessToken) getWorkCorpChainTokenFromServer() (resAccessToken ResAccessToken, err error) {
+func (ak *WorkCorpChainAccessToken) getWorkCorpChainTokenFromServer(ctx context.Context) (resAccessToken ResAccessToken, err error) {
 	var body []byte
 	req := &ReqWorkCorpChainToken{
 		CorpId:  ak.CorpID,
 		BizType: ak.BusinessType,
 		AgentId: ak.AgentID,
 	}
-	parentAccessToken, err := ak.parentCorpAccessToken.GetAccessToken()
+	parentAccessToken, err := ak.parentCorpAccessToken.GetAccessTokenContext(ctx)
 	if err != nil {
 		return
 	}
-	body, err = util.PostJSON(fmt.Sprintf(workCorpChainAccessTokenURL, parentAccessToken), req)
+	body, err = util.PostJSONContext(ctx, fmt.Sprintf(workCorpChainAccessTokenURL, parentAccessToken), req)
 	if err != nil {
 		return
 	}
@@ -240,10 +386,45 @@ func (ak *WorkCorpChainAccessToken) getWorkCorpChainTokenFromServer() (resAccess
 	return
 }
 
+// localDeleter 可单独删除本地副本的缓存，如 cache.Layered
+type localDeleter interface {
+	DeleteLocal(key string)
+}
+
+// invalidateCachedToken 缓存中的token仍为 token 时删除，已被其他请求刷新则保留
+func invalidateCachedToken(ctx context.Context, c cache.ContextCache, lock *sync.Mutex, key, token string) error {
+	lock.Lock()
+	defer lock.Unlock()
+	// 二级缓存的本地副本可能已过时，以远端为准比较，避免删除其他实例刚刷新的token
+	if l, ok := c.(localDeleter); ok {
+		l.DeleteLocal(key)
+	}
+	cached, ok := cachedString(ctx, c, key)
+	if !ok || cached != token {
+		return nil
+	}
+	if err := c.DeleteContext(ctx, key); err != nil {
+		return err
+	}
+	// 同时删除签发时间，避免 Refresher 按已失效的记录跳过刷新
+	return c.DeleteContext(ctx, key+metaKeySuffix)
+}
+
+// cachedString 读取缓存中的凭证，不存在或读取失败时 ok 为 false
+func cachedString(ctx context.Context, c cache.ContextCache, key string) (val string, ok bool) {
+	val, err := c.GetString(ctx, key)
+	return val, err == nil
+}
+
 // GetTokenFromServer 强制从微信服务器获取token
 func GetTokenFromServer(url string) (resAccessToken ResAccessToken, err error) {
+	return GetTokenFromServerContext(context.Background(), url)
+}
+
+// GetTokenFromServerContext 强制从微信服务器获取token
+func GetTokenFromServerContext(ctx context.Context, url string) (resAccessToken ResAccessToken, err error) {
 	var body []byte
-	body, err = util.HTTPGet(url)
+	body, err = util.HTTPGetContext(ctx, url)
 	if err != nil {
 		return
 	}
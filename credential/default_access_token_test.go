@@ -2,9 +2,12 @@ package credential
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"gopkg.in/h2non/gock.v1"
+
+	"github.com/silenceper/wechat/v2/cache"
 )
 
 // TestGetTicketFromServer .
@@ -17,3 +20,38 @@ func TestGetTicketFromServer(t *testing.T) {
 	assert.Equal(t, "mock-ticket", ticket.Ticket, "they should be equal")
 	assert.Equal(t, int64(10), ticket.ExpiresIn, "they should be equal")
 }
+
+func TestInvalidateAccessToken(t *testing.T) {
+	memory := cache.NewMemory()
+	ak := NewDefaultAccessToken("appid", "secret", CacheKeyOfficialAccountPrefix, memory).(*DefaultAccessToken)
+	assert.Nil(t, CacheToken(memory, ak.cacheKey(), "new-token", 7200))
+
+	// 缓存已被刷新，不删除
+	assert.Nil(t, ak.InvalidateAccessToken("old-token"))
+	assert.True(t, memory.IsExist(ak.cacheKey()))
+	_, ok := ak.TokenMeta()
+	assert.True(t, ok)
+
+	assert.Nil(t, ak.InvalidateAccessToken("new-token"))
+	assert.False(t, memory.IsExist(ak.cacheKey()))
+	_, ok = ak.TokenMeta()
+	assert.False(t, ok)
+}
+
+func TestInvalidateAccessTokenLayered(t *testing.T) {
+	remote := cache.NewMemory()
+	a := NewDefaultAccessToken("appid", "secret", CacheKeyOfficialAccountPrefix, cache.NewLayered(remote)).(*DefaultAccessToken)
+	b := NewDefaultAccessToken("appid", "secret", CacheKeyOfficialAccountPrefix, cache.NewLayered(remote)).(*DefaultAccessToken)
+	assert.Nil(t, remote.Set(a.cacheKey(), "old-token", time.Minute))
+	token, err := b.GetAccessToken()
+	assert.Nil(t, err)
+	assert.Equal(t, "old-token", token)
+
+	// 其他实例已刷新，b 的本地副本过时，不应删除远端的新token
+	assert.Nil(t, remote.Set(a.cacheKey(), "new-token", time.Minute))
+	assert.Nil(t, b.InvalidateAccessToken("old-token"))
+	assert.Equal(t, "new-token", remote.Get(a.cacheKey()))
+	token, err = b.GetAccessToken()
+	assert.Nil(t, err)
+	assert.Equal(t, "new-token", token)
+}
@@ -1,10 +1,10 @@
 package credential
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"sync"
-	"time"
 
 	"github.com/silenceper/wechat/v2/cache"
 	"github.com/silenceper/wechat/v2/util"
@@ -17,18 +17,20 @@ const getTicketURL = "https://api.weixin.qq.com/cgi-bin/ticket/getticket?access_
 type DefaultJsTicket struct {
 	appID          string
 	cacheKeyPrefix string
-	cache          cache.Cache
+	cache          cache.ContextCache
 	// jsAPITicket 读写锁 同一个AppID一个
 	jsAPITicketLock *sync.Mutex
+	locker          cache.Locker
 }
 
 // NewDefaultJsTicket new
-func NewDefaultJsTicket(appID string, cacheKeyPrefix string, cache cache.Cache) JsTicketHandle {
+func NewDefaultJsTicket(appID string, cacheKeyPrefix string, c cache.Cache) JsTicketHandle {
 	return &DefaultJsTicket{
 		appID:           appID,
-		cache:           cache,
+		cache:           cache.FromCache(c),
 		cacheKeyPrefix:  cacheKeyPrefix,
 		jsAPITicketLock: new(sync.Mutex),
+		locker:          lockerOf(c),
 	}
 }
 
@@ -42,36 +44,82 @@ type ResTicket struct {
 
 // GetTicket 获取jsapi_ticket
 func (js *DefaultJsTicket) GetTicket(accessToken string) (ticketStr string, err error) {
+	return js.GetTicketContext(context.Background(), accessToken)
+}
+
+// GetTicketContext 获取jsapi_ticket
+func (js *DefaultJsTicket) GetTicketContext(ctx context.Context, accessToken string) (ticketStr string, err error) {
 	// 先从cache中取
-	jsAPITicketCacheKey := fmt.Sprintf("%s_jsapi_ticket_%s", js.cacheKeyPrefix, js.appID)
-	if val := js.cache.Get(jsAPITicketCacheKey); val != nil {
-		return val.(string), nil
+	jsAPITicketCacheKey := js.cacheKey()
+	if val, ok := cachedString(ctx, js.cache, jsAPITicketCacheKey); ok {
+		return val, nil
 	}
 
 	js.jsAPITicketLock.Lock()
 	defer js.jsAPITicketLock.Unlock()
 
 	// 双检，防止重复从微信服务器获取
-	if val := js.cache.Get(jsAPITicketCacheKey); val != nil {
-		return val.(string), nil
+	if val, ok := cachedString(ctx, js.cache, jsAPITicketCacheKey); ok {
+		return val, nil
 	}
 
 	var ticket ResTicket
-	ticket, err = GetTicketFromServer(accessToken)
+	ticket, err = GetTicketFromServerContext(ctx, accessToken)
 	if err != nil {
 		return
 	}
-	expires := ticket.ExpiresIn - 1500
-	err = js.cache.Set(jsAPITicketCacheKey, ticket.Ticket, time.Duration(expires)*time.Second)
+	err = CacheTokenContext(ctx, js.cache, jsAPITicketCacheKey, ticket.Ticket, ticket.ExpiresIn)
 	ticketStr = ticket.Ticket
 	return
 }
 
+// TokenMeta 返回缓存中jsapi_ticket的签发与过期时间
+func (js *DefaultJsTicket) TokenMeta() (TokenMeta, bool) {
+	return CachedTokenMetaContext(context.Background(), js.cache, js.cacheKey())
+}
+
+// RenewTicket 提前从微信服务器获取新的jsapi_ticket，多实例部署时只有一个实例会刷新
+func (js *DefaultJsTicket) RenewTicket(ctx context.Context, accessToken string) error {
+	return renewCachedToken(ctx, js.cache, js.jsAPITicketLock, js.locker, js.cacheKey(), func(ctx context.Context) (string, int64, error) {
+		ticket, err := GetTicketFromServerContext(ctx, accessToken)
+		return ticket.Ticket, ticket.ExpiresIn, err
+	})
+}
+
+// Renewable 返回可注册到 Refresher 的 jsapi_ticket，刷新时通过 akHandle 获取access_token
+func (js *DefaultJsTicket) Renewable(akHandle AccessTokenHandle) Renewable {
+	return RenewableFunc{
+		MetaFunc: js.TokenMeta,
+		RenewFunc: func(ctx context.Context) error {
+			var accessToken string
+			var err error
+			if ctxHandle, ok := akHandle.(AccessTokenContextHandle); ok {
+				accessToken, err = ctxHandle.GetAccessTokenContext(ctx)
+			} else {
+				accessToken, err = akHandle.GetAccessToken()
+			}
+			if err != nil {
+				return err
+			}
+			return js.RenewTicket(ctx, accessToken)
+		},
+	}
+}
+
+func (js *DefaultJsTicket) cacheKey() string {
+	return fmt.Sprintf("%s_jsapi_ticket_%s", js.cacheKeyPrefix, js.appID)
+}
+
 // GetTicketFromServer 从服务器中获取ticket
 func GetTicketFromServer(accessToken string) (ticket ResTicket, err error) {
+	return GetTicketFromServerContext(context.Background(), accessToken)
+}
+
+// GetTicketFromServerContext 从服务器中获取ticket
+func GetTicketFromServerContext(ctx context.Context, accessToken string) (ticket ResTicket, err error) {
 	var response []byte
 	url := fmt.Sprintf(getTicketURL, accessToken)
-	response, err = util.HTTPGet(url)
+	response, err = util.HTTPGetContext(ctx, url)
 	if err != nil {
 		return
 	}
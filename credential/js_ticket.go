@@ -1,7 +1,16 @@
 package credential
 
+import "context"
+
 // JsTicketHandle js ticket获取
 type JsTicketHandle interface {
 	// GetTicket 获取ticket
 	GetTicket(accessToken string) (ticket string, err error)
 }
+
+// JsTicketContextHandle js ticket获取
+type JsTicketContextHandle interface {
+	JsTicketHandle
+	// GetTicketContext 获取ticket
+	GetTicketContext(ctx context.Context, accessToken string) (ticket string, err error)
+}
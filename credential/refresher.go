@@ -0,0 +1,286 @@
+package credential
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/silenceper/wechat/v2/cache"
+	"github.com/silenceper/wechat/v2/logger"
+)
+
+const (
+	// metaKeySuffix 凭证签发与过期时间的 cache key 后缀
+	metaKeySuffix = "_meta"
+	// cacheAheadSeconds 凭证缓存时间比实际有效期提前的秒数
+	cacheAheadSeconds = 1500
+
+	// DefaultRefreshInterval Refresher 默认的检查间隔
+	DefaultRefreshInterval = time.Minute
+	// DefaultRefreshAhead Refresher 默认提前刷新的时间，需大于缓存提前失效的 1500 秒，才能在缓存失效前完成刷新
+	DefaultRefreshAhead = 30 * time.Minute
+)
+
+// TokenMeta 缓存凭证的签发与过期时间（unix 秒）
+type TokenMeta struct {
+	IssuedAt  int64 `json:"issued_at"`
+	ExpiresAt int64 `json:"expires_at"`
+}
+
+// Remaining 凭证的剩余有效期
+func (meta TokenMeta) Remaining() time.Duration {
+	return time.Until(time.Unix(meta.ExpiresAt, 0))
+}
+
+// Renewable 可被 Refresher 提前刷新的凭证
+type Renewable interface {
+	// TokenMeta 返回缓存中凭证的签发与过期时间，未缓存时 ok 为 false
+	TokenMeta() (meta TokenMeta, ok bool)
+	// Renew 从微信服务器重新获取凭证并写入缓存
+	Renew(ctx context.Context) error
+}
+
+// RenewableFunc 由函数实现 Renewable，用于 js ticket、开放平台 component_access_token 等需要额外参数的凭证
+type RenewableFunc struct {
+	MetaFunc  func() (TokenMeta, bool)
+	RenewFunc func(ctx context.Context) error
+}
+
+// TokenMeta 返回凭证的签发与过期时间
+func (f RenewableFunc) TokenMeta() (TokenMeta, bool) {
+	return f.MetaFunc()
+}
+
+// Renew 刷新凭证
+func (f RenewableFunc) Renew(ctx context.Context) error {
+	return f.RenewFunc(ctx)
+}
+
+// CacheToken 将凭证写入缓存，同时记录签发与过期时间，缓存时间见 tokenCacheTTL
+func CacheToken(c cache.Cache, key, token string, expiresIn int64) error {
+	return CacheTokenContext(context.Background(), cache.FromCache(c), key, token, expiresIn)
+}
+
+// CacheTokenContext 将凭证写入缓存，同时记录签发与过期时间，缓存时间见 tokenCacheTTL
+func CacheTokenContext(ctx context.Context, c cache.ContextCache, key, token string, expiresIn int64) error {
+	timeout := tokenCacheTTL(expiresIn)
+	if err := c.SetString(ctx, key, token, timeout); err != nil {
+		return err
+	}
+	now := time.Now()
+	meta, err := json.Marshal(TokenMeta{
+		IssuedAt:  now.Unix(),
+		ExpiresAt: now.Add(time.Duration(expiresIn) * time.Second).Unix(),
+	})
+	if err != nil {
+		return err
+	}
+	return c.SetBytes(ctx, key+metaKeySuffix, meta, timeout)
+}
+
+// tokenCacheTTL 凭证的缓存时间，比 expiresIn 提前 1500 秒；
+// stable_token 等接口返回的剩余有效期可能不足 1500 秒，此时至少缓存 expiresIn 的一半，且不少于 1 秒
+func tokenCacheTTL(expiresIn int64) time.Duration {
+	seconds := expiresIn - cacheAheadSeconds
+	if seconds < expiresIn/2 {
+		seconds = expiresIn / 2
+	}
+	if seconds < 1 {
+		seconds = 1
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+// CachedTokenMeta 读取 CacheToken 写入的签发与过期时间
+func CachedTokenMeta(c cache.Cache, key string) (meta TokenMeta, ok bool) {
+	return CachedTokenMetaContext(context.Background(), cache.FromCache(c), key)
+}
+
+// CachedTokenMetaContext 读取 CacheToken 写入的签发与过期时间
+func CachedTokenMetaContext(ctx context.Context, c cache.ContextCache, key string) (meta TokenMeta, ok bool) {
+	val, err := c.GetBytes(ctx, key+metaKeySuffix)
+	if err != nil {
+		return
+	}
+	if err = json.Unmarshal(val, &meta); err != nil {
+		return
+	}
+	return meta, true
+}
+
+// renewCachedToken 在锁保护下重新获取凭证并写入缓存，等待锁期间其他实例已刷新时直接返回
+func renewCachedToken(ctx context.Context, c cache.ContextCache, lock *sync.Mutex, locker cache.Locker, key string,
+	fetch func(ctx context.Context) (token string, expiresIn int64, err error)) error {
+	before, _ := CachedTokenMetaContext(ctx, c, key)
+
+	lock.Lock()
+	defer lock.Unlock()
+	unlock, err := locker.Lock(ctx, key+lockKeySuffix, refreshLockTTL)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = unlock() }()
+	if after, ok := CachedTokenMetaContext(ctx, c, key); ok && after.IssuedAt > before.IssuedAt {
+		return nil
+	}
+
+	token, expiresIn, err := fetch(ctx)
+	if err != nil {
+		return err
+	}
+	return CacheTokenContext(ctx, c, key, token, expiresIn)
+}
+
+// Refresher 在凭证过期前于后台提前刷新，避免缓存失效后的首个请求承担获取凭证的耗时
+type Refresher struct {
+	interval time.Duration
+	ahead    time.Duration
+	logger   logger.Logger
+
+	mu        sync.Mutex
+	renewable map[string]Renewable
+	// unchanged 刷新后过期时间未变化的凭证（如 stable_token 非强制刷新返回原凭证），记录其过期时间，
+	// 过期时间变化或缓存失效前不再刷新
+	unchanged map[string]int64
+
+	startOnce sync.Once
+	stopOnce  sync.Once
+	stop      chan struct{}
+	done      chan struct{}
+}
+
+// NewRefresher 实例化 Refresher，interval 为检查间隔，ahead 为提前刷新的时间，传 0 使用默认值
+func NewRefresher(interval, ahead time.Duration) *Refresher {
+	if interval <= 0 {
+		interval = DefaultRefreshInterval
+	}
+	if ahead <= 0 {
+		ahead = DefaultRefreshAhead
+	}
+	return &Refresher{
+		interval:  interval,
+		ahead:     ahead,
+		renewable: map[string]Renewable{},
+		unchanged: map[string]int64{},
+		stop:      make(chan struct{}),
+		done:      make(chan struct{}),
+	}
+}
+
+// Register 注册需要刷新的凭证，name 用于区分不同的 appID 与凭证类型
+func (r *Refresher) Register(name string, renewable Renewable) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.renewable[name] = renewable
+}
+
+// Unregister 取消刷新
+func (r *Refresher) Unregister(name string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.renewable, name)
+	delete(r.unchanged, name)
+}
+
+// Remaining 返回已注册凭证的剩余有效期，未注册或未缓存时 ok 为 false
+func (r *Refresher) Remaining(name string) (remaining time.Duration, ok bool) {
+	r.mu.Lock()
+	renewable, registered := r.renewable[name]
+	r.mu.Unlock()
+	if !registered {
+		return
+	}
+	meta, ok := renewable.TokenMeta()
+	if !ok {
+		return
+	}
+	return meta.Remaining(), true
+}
+
+// Start 启动后台刷新，重复调用无效
+func (r *Refresher) Start() {
+	r.startOnce.Do(func() {
+		go r.run()
+	})
+}
+
+// Stop 停止后台刷新并等待进行中的刷新结束
+func (r *Refresher) Stop() {
+	r.stopOnce.Do(func() {
+		close(r.stop)
+	})
+	r.startOnce.Do(func() {
+		close(r.done)
+	})
+	<-r.done
+}
+
+func (r *Refresher) run() {
+	defer close(r.done)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		select {
+		case <-r.stop:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+	for {
+		r.RefreshDue(ctx)
+		select {
+		case <-ticker.C:
+		case <-r.stop:
+			return
+		}
+	}
+}
+
+// SetLogger 设置刷新失败时使用的日志
+func (r *Refresher) SetLogger(l logger.Logger) {
+	r.logger = l
+}
+
+// RefreshDue 刷新所有剩余有效期小于 ahead 或尚未缓存的凭证；
+// 刷新后过期时间未变化（微信返回了原凭证）时，在该凭证过期或缓存失效前不再重复刷新
+func (r *Refresher) RefreshDue(ctx context.Context) {
+	r.mu.Lock()
+	due := make(map[string]Renewable, len(r.renewable))
+	for name, renewable := range r.renewable {
+		due[name] = renewable
+	}
+	r.mu.Unlock()
+
+	for name, renewable := range due {
+		before, ok := renewable.TokenMeta()
+		if ok && (before.Remaining() > r.ahead || r.isUnchanged(name, before)) {
+			continue
+		}
+		if err := renewable.Renew(ctx); err != nil {
+			logger.Redact(logger.Or(r.logger)).Error("refresh token failed", logger.String("name", name), logger.Err(err))
+			continue
+		}
+		if after, renewed := renewable.TokenMeta(); ok && renewed && sameExpiry(before, after) {
+			r.mu.Lock()
+			r.unchanged[name] = after.ExpiresAt
+			r.mu.Unlock()
+		}
+	}
+}
+
+func (r *Refresher) isUnchanged(name string, meta TokenMeta) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	expiresAt, ok := r.unchanged[name]
+	return ok && sameExpiry(TokenMeta{ExpiresAt: expiresAt}, meta)
+}
+
+// sameExpiry 过期时间相差不超过 1 秒视为同一凭证，ExpiresAt 由写入时间加 expires_in 计算，可能存在取整误差
+func sameExpiry(a, b TokenMeta) bool {
+	diff := a.ExpiresAt - b.ExpiresAt
+	return diff >= -1 && diff <= 1
+}
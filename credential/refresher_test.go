@@ -0,0 +1,118 @@
+package credential
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+
+	"github.com/silenceper/wechat/v2/cache"
+)
+
+func TestCacheToken(t *testing.T) {
+	memory := cache.NewMemory()
+	_, ok := CachedTokenMeta(memory, "key")
+	assert.False(t, ok)
+
+	assert.Nil(t, CacheToken(memory, "key", "token", 7200))
+	assert.Equal(t, "token", memory.Get("key"))
+	meta, ok := CachedTokenMeta(memory, "key")
+	assert.True(t, ok)
+	assert.Equal(t, int64(7200), meta.ExpiresAt-meta.IssuedAt)
+	assert.InDelta(t, float64(7200), meta.Remaining().Seconds(), 2)
+}
+
+func TestCacheTokenShortExpiresIn(t *testing.T) {
+	ctx := context.Background()
+	memory := cache.NewMemory()
+
+	// expires_in 不足 1500 秒时缓存 expires_in 的一半，凭证及签发时间均会过期
+	assert.Nil(t, CacheToken(memory, "key", "token", 600))
+	ttl, err := memory.TTL(ctx, "key")
+	assert.Nil(t, err)
+	assert.InDelta(t, float64(300), ttl.Seconds(), 1)
+	ttl, err = memory.TTL(ctx, "key"+metaKeySuffix)
+	assert.Nil(t, err)
+	assert.InDelta(t, float64(300), ttl.Seconds(), 1)
+
+	assert.Equal(t, 5700*time.Second, tokenCacheTTL(7200))
+	assert.Equal(t, 1000*time.Second, tokenCacheTTL(2000))
+	assert.Equal(t, time.Second, tokenCacheTTL(1))
+	assert.Equal(t, time.Second, tokenCacheTTL(0))
+}
+
+func TestDefaultAccessTokenRenew(t *testing.T) {
+	defer gock.Off()
+	gock.New("https://api.weixin.qq.com").Get("/cgi-bin/token").Reply(200).JSON(&ResAccessToken{AccessToken: "renewed", ExpiresIn: 7200})
+
+	memory := cache.NewMemory()
+	ak := NewDefaultAccessToken("appid", "secret", CacheKeyOfficialAccountPrefix, memory).(*DefaultAccessToken)
+	assert.Nil(t, ak.Renew(context.Background()))
+	accessToken, err := ak.GetAccessToken()
+	assert.Nil(t, err)
+	assert.Equal(t, "renewed", accessToken)
+	_, ok := ak.TokenMeta()
+	assert.True(t, ok)
+}
+
+func TestRefresher(t *testing.T) {
+	var renewed int32
+	expiresAt := time.Now().Add(10 * time.Minute).Unix()
+	renewable := RenewableFunc{
+		MetaFunc: func() (TokenMeta, bool) {
+			return TokenMeta{ExpiresAt: atomic.LoadInt64(&expiresAt)}, true
+		},
+		RenewFunc: func(ctx context.Context) error {
+			atomic.AddInt32(&renewed, 1)
+			atomic.StoreInt64(&expiresAt, time.Now().Add(2*time.Hour).Unix())
+			return nil
+		},
+	}
+
+	refresher := NewRefresher(10*time.Millisecond, 0)
+	refresher.Register("officialaccount_appid", renewable)
+	_, ok := refresher.Remaining("unknown")
+	assert.False(t, ok)
+
+	refresher.Start()
+	assert.Eventually(t, func() bool {
+		return atomic.LoadInt32(&renewed) == 1
+	}, time.Second, 5*time.Millisecond)
+	refresher.Stop()
+	refresher.Stop()
+
+	remaining, ok := refresher.Remaining("officialaccount_appid")
+	assert.True(t, ok)
+	assert.True(t, remaining > DefaultRefreshAhead)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&renewed))
+}
+
+func TestRefresherUnchangedToken(t *testing.T) {
+	var renewed int32
+	expiresAt := time.Now().Add(10 * time.Minute).Unix()
+	// 模拟 stable_token 非强制刷新：返回原凭证，过期时间不变
+	renewable := RenewableFunc{
+		MetaFunc: func() (TokenMeta, bool) {
+			return TokenMeta{IssuedAt: time.Now().Unix(), ExpiresAt: atomic.LoadInt64(&expiresAt)}, true
+		},
+		RenewFunc: func(ctx context.Context) error {
+			atomic.AddInt32(&renewed, 1)
+			return nil
+		},
+	}
+
+	refresher := NewRefresher(time.Minute, 0)
+	refresher.Register("stable", renewable)
+	for i := 0; i < 3; i++ {
+		refresher.RefreshDue(context.Background())
+	}
+	assert.Equal(t, int32(1), atomic.LoadInt32(&renewed))
+
+	// 凭证更换后恢复提前刷新
+	atomic.StoreInt64(&expiresAt, time.Now().Add(5*time.Minute).Unix())
+	refresher.RefreshDue(context.Background())
+	assert.Equal(t, int32(2), atomic.LoadInt32(&renewed))
+}
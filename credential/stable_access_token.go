@@ -0,0 +1,179 @@
+package credential
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/silenceper/wechat/v2/cache"
+	"github.com/silenceper/wechat/v2/util"
+)
+
+// stableAccessTokenURL 获取稳定版接口调用凭据
+// 文档：https://developers.weixin.qq.com/doc/offiaccount/Basic_Information/getStableAccessToken.html
+const stableAccessTokenURL = "https://api.weixin.qq.com/cgi-bin/stable_token"
+
+// StableAccessToken 稳定版access_token获取
+//
+// 与 /cgi-bin/token 相互独立，普通模式下在有效期内重复获取不会使其他调用方的access_token失效；
+// 强制刷新模式每天限 20 次，会使之前的access_token立即失效。cache key 与 DefaultAccessToken 相同
+type StableAccessToken struct {
+	appID           string
+	appSecret       string
+	cacheKeyPrefix  string
+	cache           cache.ContextCache
+	accessTokenLock *sync.Mutex
+	locker          cache.Locker
+}
+
+// NewStableAccessToken new StableAccessToken
+func NewStableAccessToken(appID, appSecret, cacheKeyPrefix string, c cache.Cache) AccessTokenHandle {
+	if c == nil {
+		panic("cache is need")
+	}
+	return &StableAccessToken{
+		appID:           appID,
+		appSecret:       appSecret,
+		cache:           cache.FromCache(c),
+		cacheKeyPrefix:  cacheKeyPrefix,
+		accessTokenLock: new(sync.Mutex),
+		locker:          lockerOf(c),
+	}
+}
+
+// ReqStableAccessToken 获取稳定版access_token的请求参数
+type ReqStableAccessToken struct {
+	GrantType    string `json:"grant_type"`
+	AppID        string `json:"appid"`
+	Secret       string `json:"secret"`
+	ForceRefresh bool   `json:"force_refresh"`
+}
+
+// GetAccessToken 获取access_token,先从cache中获取，没有则从服务端获取
+func (ak *StableAccessToken) GetAccessToken() (accessToken string, err error) {
+	return ak.GetAccessTokenContext(context.Background())
+}
+
+// GetAccessTokenContext 获取access_token,先从cache中获取，没有则以普通模式从服务端获取
+func (ak *StableAccessToken) GetAccessTokenContext(ctx context.Context) (accessToken string, err error) {
+	// 先从cache中取
+	accessTokenCacheKey := ak.cacheKey()
+	if val, ok := cachedString(ctx, ak.cache, accessTokenCacheKey); ok {
+		return val, nil
+	}
+
+	// 加上lock，是为了防止在并发获取token时，cache刚好失效，导致从微信服务器上获取到不同token
+	ak.accessTokenLock.Lock()
+	defer ak.accessTokenLock.Unlock()
+
+	// 双检，防止重复从微信服务器获取
+	if val, ok := cachedString(ctx, ak.cache, accessTokenCacheKey); ok {
+		return val, nil
+	}
+
+	// 多实例部署时，通过锁保证同一时刻只有一个实例从微信服务器获取，获取锁后其他实例可能已刷新
+	unlock, err := ak.locker.Lock(ctx, accessTokenCacheKey+lockKeySuffix, refreshLockTTL)
+	if err != nil {
+		return
+	}
+	defer func() { _ = unlock() }()
+	if val, ok := cachedString(ctx, ak.cache, accessTokenCacheKey); ok {
+		return val, nil
+	}
+
+	// cache失效，从微信服务器获取
+	var resAccessToken ResAccessToken
+	resAccessToken, err = ak.getTokenFromServer(ctx, false)
+	if err != nil {
+		return
+	}
+
+	err = CacheTokenContext(ctx, ak.cache, accessTokenCacheKey, resAccessToken.AccessToken, resAccessToken.ExpiresIn)
+	if err != nil {
+		return
+	}
+	accessToken = resAccessToken.AccessToken
+	return
+}
+
+// ForceRefresh 以强制刷新模式获取新的access_token并写入缓存，之前的access_token会立即失效
+//
+// 每天限用 20 次，两次调用需间隔 30 秒，仅在确认access_token泄漏或异常时使用
+func (ak *StableAccessToken) ForceRefresh(ctx context.Context) (accessToken string, err error) {
+	ak.accessTokenLock.Lock()
+	defer ak.accessTokenLock.Unlock()
+
+	accessTokenCacheKey := ak.cacheKey()
+	unlock, err := ak.locker.Lock(ctx, accessTokenCacheKey+lockKeySuffix, refreshLockTTL)
+	if err != nil {
+		return
+	}
+	defer func() { _ = unlock() }()
+
+	var resAccessToken ResAccessToken
+	resAccessToken, err = ak.getTokenFromServer(ctx, true)
+	if err != nil {
+		return
+	}
+	err = CacheTokenContext(ctx, ak.cache, accessTokenCacheKey, resAccessToken.AccessToken, resAccessToken.ExpiresIn)
+	if err != nil {
+		return
+	}
+	accessToken = resAccessToken.AccessToken
+	return
+}
+
+// TokenMeta 返回缓存中access_token的签发与过期时间
+func (ak *StableAccessToken) TokenMeta() (TokenMeta, bool) {
+	return CachedTokenMetaContext(context.Background(), ak.cache, ak.cacheKey())
+}
+
+// Renew 以普通模式重新获取access_token，有效期内返回的仍是同一个access_token，临近过期时会返回新的
+func (ak *StableAccessToken) Renew(ctx context.Context) error {
+	return renewCachedToken(ctx, ak.cache, ak.accessTokenLock, ak.locker, ak.cacheKey(), func(ctx context.Context) (string, int64, error) {
+		resAccessToken, err := ak.getTokenFromServer(ctx, false)
+		return resAccessToken.AccessToken, resAccessToken.ExpiresIn, err
+	})
+}
+
+// SetLocker 设置刷新access_token时使用的锁，默认使用 cache 实现的分布式锁或进程内锁
+func (ak *StableAccessToken) SetLocker(locker cache.Locker) {
+	ak.locker = locker
+}
+
+// InvalidateAccessToken 使缓存的access_token失效
+func (ak *StableAccessToken) InvalidateAccessToken(accessToken string) error {
+	return invalidateCachedToken(context.Background(), ak.cache, ak.accessTokenLock, ak.cacheKey(), accessToken)
+}
+
+func (ak *StableAccessToken) cacheKey() string {
+	return fmt.Sprintf("%s_access_token_%s", ak.cacheKeyPrefix, ak.appID)
+}
+
+func (ak *StableAccessToken) getTokenFromServer(ctx context.Context, forceRefresh bool) (ResAccessToken, error) {
+	return GetStableTokenFromServerContext(ctx, ak.appID, ak.appSecret, forceRefresh)
+}
+
+// GetStableTokenFromServerContext 从微信服务器获取稳定版access_token，forceRefresh 为 true 时使用强制刷新模式
+func GetStableTokenFromServerContext(ctx context.Context, appID, appSecret string, forceRefresh bool) (resAccessToken ResAccessToken, err error) {
+	var body []byte
+	body, err = util.PostJSONContext(ctx, stableAccessTokenURL, &ReqStableAccessToken{
+		GrantType:    "client_credential",
+		AppID:        appID,
+		Secret:       appSecret,
+		ForceRefresh: forceRefresh,
+	})
+	if err != nil {
+		return
+	}
+	err = json.Unmarshal(body, &resAccessToken)
+	if err != nil {
+		return
+	}
+	if resAccessToken.ErrCode != 0 {
+		err = fmt.Errorf("get stable access_token error : errcode=%v , errormsg=%v", resAccessToken.ErrCode, resAccessToken.ErrMsg)
+		return
+	}
+	return
+}
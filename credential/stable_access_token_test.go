@@ -0,0 +1,72 @@
+package credential
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+
+	"github.com/silenceper/wechat/v2/cache"
+)
+
+func TestStableAccessToken(t *testing.T) {
+	defer gock.Off()
+	gock.New(stableAccessTokenURL).
+		BodyString(`"force_refresh":false`).
+		Reply(200).
+		JSON(&ResAccessToken{AccessToken: "stable-token", ExpiresIn: 7200})
+	gock.New(stableAccessTokenURL).
+		BodyString(`"force_refresh":true`).
+		Reply(200).
+		JSON(&ResAccessToken{AccessToken: "force-token", ExpiresIn: 7200})
+
+	memory := cache.NewMemory()
+	ak := NewStableAccessToken("appid", "secret", CacheKeyOfficialAccountPrefix, memory).(*StableAccessToken)
+	// 与 DefaultAccessToken 共用 cache key
+	assert.Equal(t, NewDefaultAccessToken("appid", "secret", CacheKeyOfficialAccountPrefix, memory).(*DefaultAccessToken).cacheKey(), ak.cacheKey())
+
+	token, err := ak.GetAccessToken()
+	assert.Nil(t, err)
+	assert.Equal(t, "stable-token", token)
+	// 命中缓存，不再请求
+	token, err = ak.GetAccessToken()
+	assert.Nil(t, err)
+	assert.Equal(t, "stable-token", token)
+
+	token, err = ak.ForceRefresh(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, "force-token", token)
+	assert.Equal(t, "force-token", memory.Get(ak.cacheKey()))
+	assert.True(t, gock.IsDone())
+}
+
+func TestStableAccessTokenShortExpiresIn(t *testing.T) {
+	defer gock.Off()
+	// 普通模式返回的是剩余有效期，可能不足 1500 秒
+	gock.New(stableAccessTokenURL).
+		Reply(200).
+		JSON(&ResAccessToken{AccessToken: "stable-token", ExpiresIn: 2})
+	gock.New(stableAccessTokenURL).
+		Reply(200).
+		JSON(&ResAccessToken{AccessToken: "next-token", ExpiresIn: 7200})
+
+	ctx := context.Background()
+	memory := cache.NewMemory()
+	ak := NewStableAccessToken("appid", "secret", CacheKeyOfficialAccountPrefix, memory).(*StableAccessToken)
+
+	token, err := ak.GetAccessToken()
+	assert.Nil(t, err)
+	assert.Equal(t, "stable-token", token)
+	ttl, err := memory.TTL(ctx, ak.cacheKey())
+	assert.Nil(t, err)
+	assert.True(t, ttl > 0 && ttl <= time.Second)
+
+	// 缓存过期后重新获取
+	assert.Eventually(t, func() bool { return !memory.IsExist(ak.cacheKey()) }, 2*time.Second, 50*time.Millisecond)
+	token, err = ak.GetAccessToken()
+	assert.Nil(t, err)
+	assert.Equal(t, "next-token", token)
+	assert.True(t, gock.IsDone())
+}
@@ -0,0 +1,51 @@
+// Package logger 日志接口，可通过 Wechat 实例或各模块的 config 注入，默认输出到 logrus 的 StandardLogger
+package logger
+
+import "github.com/sirupsen/logrus"
+
+// Logger 结构化日志接口
+type Logger interface {
+	Debug(msg string, fields ...Field)
+	Info(msg string, fields ...Field)
+	Warn(msg string, fields ...Field)
+	Error(msg string, fields ...Field)
+}
+
+// Field 日志字段
+type Field struct {
+	Key   string
+	Value interface{}
+}
+
+// String 字符串字段
+func String(key, value string) Field {
+	return Field{Key: key, Value: value}
+}
+
+// Any 任意类型字段
+func Any(key string, value interface{}) Field {
+	return Field{Key: key, Value: value}
+}
+
+// Err 错误字段，key 为 error
+func Err(err error) Field {
+	return Field{Key: "error", Value: err}
+}
+
+// Or 返回 l，为空时返回默认日志：脱敏后输出到 logrus 的 StandardLogger，不修改其配置
+func Or(l Logger) Logger {
+	if l == nil {
+		return Redact(NewLogrus(logrus.StandardLogger()))
+	}
+	return l
+}
+
+// Nop 不输出任何日志
+var Nop Logger = nopLogger{}
+
+type nopLogger struct{}
+
+func (nopLogger) Debug(string, ...Field) {}
+func (nopLogger) Info(string, ...Field)  {}
+func (nopLogger) Warn(string, ...Field)  {}
+func (nopLogger) Error(string, ...Field) {}
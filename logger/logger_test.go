@@ -0,0 +1,52 @@
+package logger
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestLogrus(level logrus.Level) (*logrus.Logger, *bytes.Buffer) {
+	buf := new(bytes.Buffer)
+	l := logrus.New()
+	l.SetOutput(buf)
+	l.SetLevel(level)
+	l.SetFormatter(&logrus.TextFormatter{DisableTimestamp: true})
+	return l, buf
+}
+
+func TestLogrus(t *testing.T) {
+	l, buf := newTestLogrus(logrus.InfoLevel)
+	log := NewLogrus(l)
+
+	log.Debug("debug msg")
+	assert.Empty(t, buf.String())
+
+	log.Info("info msg", String("appid", "wx123"))
+	assert.Equal(t, "level=info msg=\"info msg\" appid=wx123\n", buf.String())
+}
+
+func TestRedact(t *testing.T) {
+	l, buf := newTestLogrus(logrus.DebugLevel)
+	log := Redact(NewLogrus(l))
+
+	log.Debug("request msg",
+		String("openid", "oGZUI0egBJY1zhBYw2KhdUfwVJJE"),
+		Any("body", []byte("<xml><ToUserName><![CDATA[gh_123456789]]></ToUserName><FromUserName><![CDATA[oGZUI0egBJY1zhBYw2KhdUfwVJJE]]></FromUserName><MsgType><![CDATA[text]]></MsgType></xml>")),
+		String("json", `{"openid":"oGZUI0egBJY1zhBYw2KhdUfwVJJE","errcode":0}`),
+		Err(errors.New(`Get "https://api.weixin.qq.com/cgi-bin/user/info?access_token=ACCESS_TOKEN_VALUE&lang=zh_CN": timeout`)),
+	)
+	out := buf.String()
+	assert.NotContains(t, out, "oGZUI0egBJY1zhBYw2KhdUfwVJJE")
+	assert.NotContains(t, out, "ACCESS_TOKEN_VALUE")
+	assert.Contains(t, out, "openid=\"oG***JE\"")
+	assert.Contains(t, out, "<FromUserName><![CDATA[oG***JE]]></FromUserName>")
+	assert.Contains(t, out, "<MsgType><![CDATA[text]]></MsgType>")
+	assert.Contains(t, out, "lang=zh_CN")
+
+	// 已脱敏的日志不重复包装
+	assert.Equal(t, log, Redact(log))
+}
@@ -0,0 +1,43 @@
+package logger
+
+import "github.com/sirupsen/logrus"
+
+type logrusLogger struct {
+	l logrus.FieldLogger
+}
+
+// NewLogrus 使用 logrus 输出日志，可传入 *logrus.Logger 或 *logrus.Entry
+func NewLogrus(l logrus.FieldLogger) Logger {
+	return &logrusLogger{l: l}
+}
+
+func (l *logrusLogger) Debug(msg string, fields ...Field) {
+	l.log(logrus.DebugLevel, msg, fields)
+}
+
+func (l *logrusLogger) Info(msg string, fields ...Field) {
+	l.log(logrus.InfoLevel, msg, fields)
+}
+
+func (l *logrusLogger) Warn(msg string, fields ...Field) {
+	l.log(logrus.WarnLevel, msg, fields)
+}
+
+func (l *logrusLogger) Error(msg string, fields ...Field) {
+	l.log(logrus.ErrorLevel, msg, fields)
+}
+
+func (l *logrusLogger) log(level logrus.Level, msg string, fields []Field) {
+	entry := l.l.WithFields(nil)
+	if !entry.Logger.IsLevelEnabled(level) {
+		return
+	}
+	if len(fields) > 0 {
+		data := make(logrus.Fields, len(fields))
+		for _, f := range fields {
+			data[f.Key] = f.Value
+		}
+		entry = entry.WithFields(data)
+	}
+	entry.Log(level, msg)
+}
@@ -0,0 +1,127 @@
+package logger
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// DefaultRedactKeys 默认脱敏的字段名，比较时忽略大小写、下划线和中划线
+var DefaultRedactKeys = []string{
+	"secret", "app_secret", "corp_secret", "component_appsecret", "api_key", "password",
+	"token", "access_token", "component_access_token", "authorizer_access_token",
+	"refresh_token", "authorizer_refresh_token", "encoding_aes_key", "session_key", "ticket",
+	"openid", "unionid", "FromUserName", "ToUserName", "external_userid",
+}
+
+var (
+	xmlFieldRegexp   = regexp.MustCompile(`<(\w+)>(<!\[CDATA\[)?([^<\]]*)`)
+	jsonFieldRegexp  = regexp.MustCompile(`"(\w+)"(\s*:\s*")([^"]*)`)
+	queryFieldRegexp = regexp.MustCompile(`([?&]|^)(\w+)=([^&\s#"]*)`)
+)
+
+type redactLogger struct {
+	l    Logger
+	keys map[string]bool
+}
+
+// Redact 对日志字段脱敏：字段名命中 keys 时整体打码，
+// 其他字符串及 error 字段中出现的 xml 标签、json 字段和 url 参数命中 keys 时对其值打码。keys 为空时使用 DefaultRedactKeys
+func Redact(l Logger, keys ...string) Logger {
+	if len(keys) == 0 {
+		if r, ok := l.(*redactLogger); ok {
+			return r
+		}
+		keys = DefaultRedactKeys
+	}
+	set := make(map[string]bool, len(keys))
+	for _, key := range keys {
+		set[normalizeKey(key)] = true
+	}
+	return &redactLogger{l: l, keys: set}
+}
+
+func (r *redactLogger) Debug(msg string, fields ...Field) {
+	r.l.Debug(msg, r.redact(fields)...)
+}
+
+func (r *redactLogger) Info(msg string, fields ...Field) {
+	r.l.Info(msg, r.redact(fields)...)
+}
+
+func (r *redactLogger) Warn(msg string, fields ...Field) {
+	r.l.Warn(msg, r.redact(fields)...)
+}
+
+func (r *redactLogger) Error(msg string, fields ...Field) {
+	r.l.Error(msg, r.redact(fields)...)
+}
+
+func (r *redactLogger) redact(fields []Field) []Field {
+	if len(fields) == 0 {
+		return fields
+	}
+	redacted := make([]Field, len(fields))
+	for i, f := range fields {
+		redacted[i] = Field{Key: f.Key, Value: r.redactValue(f.Key, f.Value)}
+	}
+	return redacted
+}
+
+func (r *redactLogger) redactValue(key string, value interface{}) interface{} {
+	if value == nil {
+		return nil
+	}
+	if r.keys[normalizeKey(key)] {
+		return mask(fmt.Sprint(value))
+	}
+	switch v := value.(type) {
+	case string:
+		return r.scrub(v)
+	case []byte:
+		return r.scrub(string(v))
+	case error:
+		return r.scrub(v.Error())
+	}
+	return value
+}
+
+// scrub 对字符串中的 xml 标签、json 字段和 url 参数脱敏
+func (r *redactLogger) scrub(s string) string {
+	s = xmlFieldRegexp.ReplaceAllStringFunc(s, func(m string) string {
+		sub := xmlFieldRegexp.FindStringSubmatch(m)
+		if !r.keys[normalizeKey(sub[1])] {
+			return m
+		}
+		return "<" + sub[1] + ">" + sub[2] + mask(sub[3])
+	})
+	s = jsonFieldRegexp.ReplaceAllStringFunc(s, func(m string) string {
+		sub := jsonFieldRegexp.FindStringSubmatch(m)
+		if !r.keys[normalizeKey(sub[1])] {
+			return m
+		}
+		return `"` + sub[1] + `"` + sub[2] + mask(sub[3])
+	})
+	return queryFieldRegexp.ReplaceAllStringFunc(s, func(m string) string {
+		sub := queryFieldRegexp.FindStringSubmatch(m)
+		if !r.keys[normalizeKey(sub[2])] {
+			return m
+		}
+		return sub[1] + sub[2] + "=" + mask(sub[3])
+	})
+}
+
+func normalizeKey(key string) string {
+	key = strings.ToLower(key)
+	key = strings.ReplaceAll(key, "_", "")
+	return strings.ReplaceAll(key, "-", "")
+}
+
+// mask 保留首尾各两个字符，其余打码
+func mask(s string) string {
+	runes := []rune(s)
+	if len(runes) <= 6 {
+		return "***"
+	}
+	return string(runes[:2]) + "***" + string(runes[len(runes)-2:])
+}
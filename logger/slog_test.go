@@ -0,0 +1,30 @@
+//go:build go1.21
+// +build go1.21
+
+package logger
+
+import (
+	"bytes"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSlog(t *testing.T) {
+	buf := new(bytes.Buffer)
+	log := NewSlog(slog.New(slog.NewTextHandler(buf, &slog.HandlerOptions{
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if a.Key == slog.TimeKey {
+				return slog.Attr{}
+			}
+			return a
+		},
+	})))
+
+	log.Debug("debug msg")
+	assert.Empty(t, buf.String())
+
+	log.Warn("warn msg", String("appid", "wx123"))
+	assert.Equal(t, "level=WARN msg=\"warn msg\" appid=wx123\n", buf.String())
+}
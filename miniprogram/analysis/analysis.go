@@ -50,7 +50,7 @@ func (analysis *Analysis) fetchData(urlStr string, body interface{}) (response [
 		return
 	}
 	urlStr = fmt.Sprintf(urlStr, accessToken)
-	response, err = util.PostJSON(urlStr, body)
+	response, err = analysis.PostJSON(urlStr, body)
 	return
 }
 
@@ -34,7 +34,7 @@ func (business *Business) GetPhoneNumber(in *GetPhoneNumberRequest) (info PhoneI
 	}
 
 	uri := fmt.Sprintf(getPhoneNumberURL, accessToken)
-	response, err := util.PostJSON(uri, in)
+	response, err := business.PostJSON(uri, in)
 	if err != nil {
 		return
 	}
@@ -3,11 +3,21 @@ package config
 
 import (
 	"github.com/silenceper/wechat/v2/cache"
+	"github.com/silenceper/wechat/v2/logger"
+	"github.com/silenceper/wechat/v2/ratelimit"
+	"github.com/silenceper/wechat/v2/util"
 )
 
 // Config .config for 小程序
 type Config struct {
-	AppID     string `json:"app_id"`     // appid
-	AppSecret string `json:"app_secret"` // appSecret
-	Cache     cache.Cache
+	AppID          string `json:"app_id"`           // appid
+	AppSecret      string `json:"app_secret"`       // appSecret
+	Token          string `json:"token"`            // 消息推送的 Token，用于校验推送请求的签名
+	EncodingAESKey string `json:"encoding_aes_key"` // 消息推送的 EncodingAESKey，安全模式下用于解密消息
+	UseStableAK    bool   `json:"use_stable_ak"`    // 是否使用稳定版 access_token(/cgi-bin/stable_token)
+	Cache          cache.Cache
+	HTTPClient     util.HTTPClient    // 自定义http client，为空时使用 util.DefaultHTTPClient
+	Logger         logger.Logger      // 日志，为空时输出到 logrus 的 StandardLogger
+	Interceptors   []util.Interceptor // 请求拦截器，可用于统计接口耗时、错误码及链路追踪
+	RateLimiter    ratelimit.Limiter  // 限流，为空时不限流
 }
@@ -31,7 +31,7 @@ func (content *Content) CheckText(text string) error {
 	if err != nil {
 		return err
 	}
-	response, err := util.PostJSON(
+	response, err := content.PostJSON(
 		fmt.Sprintf(checkTextURL, accessToken),
 		map[string]string{
 			"content": text,
@@ -53,7 +53,7 @@ func (content *Content) CheckImage(media string) error {
 	if err != nil {
 		return err
 	}
-	response, err := util.PostFile(
+	response, err := content.PostFile(
 		"media",
 		media,
 		fmt.Sprintf(checkImageURL, accessToken),
@@ -0,0 +1,200 @@
+package live
+
+import (
+	context2 "context"
+	"net/url"
+	"strconv"
+
+	"github.com/silenceper/wechat/v2/util"
+)
+
+const (
+	addGoodsURL          = "https://api.weixin.qq.com/wxaapi/broadcast/goods/add?access_token=%s"
+	resetAuditGoodsURL   = "https://api.weixin.qq.com/wxaapi/broadcast/goods/resetaudit?access_token=%s"
+	auditGoodsURL        = "https://api.weixin.qq.com/wxaapi/broadcast/goods/audit?access_token=%s"
+	deleteGoodsURL       = "https://api.weixin.qq.com/wxaapi/broadcast/goods/delete?access_token=%s"
+	updateGoodsURL       = "https://api.weixin.qq.com/wxaapi/broadcast/goods/update?access_token=%s"
+	getGoodsWarehouseURL = "https://api.weixin.qq.com/wxa/business/getgoodswarehouse?access_token=%s"
+	getApprovedGoodsURL  = "https://api.weixin.qq.com/wxaapi/broadcast/goods/getapproved?access_token=%s"
+)
+
+// PriceType 商品价格类型
+type PriceType int
+
+const (
+	// PriceTypeFixed 一口价，只需填写 Price
+	PriceTypeFixed PriceType = 1
+	// PriceTypeRange 价格区间，Price 为左边界，Price2 为右边界
+	PriceTypeRange PriceType = 2
+	// PriceTypeDiscount 显示折扣价，Price 为原价，Price2 为现价
+	PriceTypeDiscount PriceType = 3
+)
+
+// AuditStatus 商品审核状态
+type AuditStatus int
+
+const (
+	// AuditStatusUnaudited 未审核
+	AuditStatusUnaudited AuditStatus = 0
+	// AuditStatusAuditing 审核中
+	AuditStatusAuditing AuditStatus = 1
+	// AuditStatusApproved 审核通过
+	AuditStatusApproved AuditStatus = 2
+	// AuditStatusRejected 审核驳回
+	AuditStatusRejected AuditStatus = 3
+)
+
+// GoodsInfo 商品信息，添加商品时 CoverImgURL 为 UploadMedia 返回的 media_id，价格单位为元
+type GoodsInfo struct {
+	GoodsID         int64     `json:"goodsId,omitempty"` // 更新商品时必填
+	CoverImgURL     string    `json:"coverImgUrl,omitempty"`
+	Name            string    `json:"name,omitempty"` // 商品名称，最长 14 个汉字
+	PriceType       PriceType `json:"priceType,omitempty"`
+	Price           float64   `json:"price,omitempty"`
+	Price2          float64   `json:"price2,omitempty"`
+	URL             string    `json:"url,omitempty"`             // 商品详情页的小程序路径
+	ThirdPartyAppID string    `json:"thirdPartyAppid,omitempty"` // 商品详情页所在的小程序 appid，为空时为当前小程序
+}
+
+// AddGoodsResponse 添加商品返回
+type AddGoodsResponse struct {
+	util.CommonError
+	GoodsID int64 `json:"goodsId"`
+	AuditID int64 `json:"auditId"`
+}
+
+// WarehouseGoods 商品库中的商品
+type WarehouseGoods struct {
+	GoodsID         int64       `json:"goods_id"`
+	CoverImgURL     string      `json:"cover_img_url"`
+	Name            string      `json:"name"`
+	Price           float64     `json:"price"` // 单位为分
+	Price2          float64     `json:"price2"`
+	PriceType       PriceType   `json:"price_type"`
+	URL             string      `json:"url"`
+	AuditStatus     AuditStatus `json:"audit_status"`
+	ThirdPartyTag   int         `json:"third_party_tag"` // 1、2 表示由第三方平台添加
+	ThirdPartyAppID string      `json:"third_party_appid"`
+}
+
+// ApprovedGoods 审核状态列表中的商品
+type ApprovedGoods struct {
+	GoodsID         int64     `json:"goodsId"`
+	CoverImgURL     string    `json:"coverImgUrl"`
+	Name            string    `json:"name"`
+	Price           float64   `json:"price"`
+	Price2          float64   `json:"price2"`
+	PriceType       PriceType `json:"priceType"`
+	URL             string    `json:"url"`
+	ThirdPartyTag   int       `json:"thirdPartyTag"`
+	ThirdPartyAppID string    `json:"thirdPartyAppid"`
+}
+
+// GetApprovedGoodsResponse 获取商品列表返回
+type GetApprovedGoodsResponse struct {
+	util.CommonError
+	Total int             `json:"total"`
+	Goods []ApprovedGoods `json:"goods"`
+}
+
+// AddGoods 添加商品并提交审核
+func (live *Live) AddGoods(info *GoodsInfo) (*AddGoodsResponse, error) {
+	return live.AddGoodsContext(context2.Background(), info)
+}
+
+// AddGoodsContext 添加商品并提交审核
+func (live *Live) AddGoodsContext(ctx context2.Context, info *GoodsInfo) (*AddGoodsResponse, error) {
+	req := map[string]interface{}{"goodsInfo": info}
+	res := &AddGoodsResponse{}
+	if err := live.post(ctx, addGoodsURL, req, res, "AddGoods"); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ResetAuditGoods 撤回商品审核
+func (live *Live) ResetAuditGoods(auditID, goodsID int64) error {
+	return live.ResetAuditGoodsContext(context2.Background(), auditID, goodsID)
+}
+
+// ResetAuditGoodsContext 撤回商品审核
+func (live *Live) ResetAuditGoodsContext(ctx context2.Context, auditID, goodsID int64) error {
+	req := map[string]int64{"auditId": auditID, "goodsId": goodsID}
+	return live.post(ctx, resetAuditGoodsURL, req, nil, "ResetAuditGoods")
+}
+
+// AuditGoods 重新提交审核，返回审核单 id
+func (live *Live) AuditGoods(goodsID int64) (auditID int64, err error) {
+	return live.AuditGoodsContext(context2.Background(), goodsID)
+}
+
+// AuditGoodsContext 重新提交审核
+func (live *Live) AuditGoodsContext(ctx context2.Context, goodsID int64) (auditID int64, err error) {
+	var res struct {
+		util.CommonError
+		AuditID int64 `json:"auditId"`
+	}
+	req := map[string]int64{"goodsId": goodsID}
+	if err = live.post(ctx, auditGoodsURL, req, &res, "AuditGoods"); err != nil {
+		return
+	}
+	return res.AuditID, nil
+}
+
+// DeleteGoods 删除商品
+func (live *Live) DeleteGoods(goodsID int64) error {
+	return live.DeleteGoodsContext(context2.Background(), goodsID)
+}
+
+// DeleteGoodsContext 删除商品
+func (live *Live) DeleteGoodsContext(ctx context2.Context, goodsID int64) error {
+	req := map[string]int64{"goodsId": goodsID}
+	return live.post(ctx, deleteGoodsURL, req, nil, "DeleteGoods")
+}
+
+// UpdateGoods 更新商品，审核通过的商品只能更新价格类型、价格及路径，info.GoodsID 必填
+func (live *Live) UpdateGoods(info *GoodsInfo) error {
+	return live.UpdateGoodsContext(context2.Background(), info)
+}
+
+// UpdateGoodsContext 更新商品
+func (live *Live) UpdateGoodsContext(ctx context2.Context, info *GoodsInfo) error {
+	req := map[string]interface{}{"goodsInfo": info}
+	return live.post(ctx, updateGoodsURL, req, nil, "UpdateGoods")
+}
+
+// GetGoodsWarehouse 获取商品的信息与审核状态
+func (live *Live) GetGoodsWarehouse(goodsIDs []int64) ([]WarehouseGoods, error) {
+	return live.GetGoodsWarehouseContext(context2.Background(), goodsIDs)
+}
+
+// GetGoodsWarehouseContext 获取商品的信息与审核状态
+func (live *Live) GetGoodsWarehouseContext(ctx context2.Context, goodsIDs []int64) ([]WarehouseGoods, error) {
+	var res struct {
+		util.CommonError
+		Goods []WarehouseGoods `json:"goods"`
+	}
+	req := map[string][]int64{"goods_ids": goodsIDs}
+	if err := live.post(ctx, getGoodsWarehouseURL, req, &res, "GetGoodsWarehouse"); err != nil {
+		return nil, err
+	}
+	return res.Goods, nil
+}
+
+// GetApprovedGoods 按审核状态获取商品列表，limit 不超过 100
+func (live *Live) GetApprovedGoods(offset, limit int, status AuditStatus) (*GetApprovedGoodsResponse, error) {
+	return live.GetApprovedGoodsContext(context2.Background(), offset, limit, status)
+}
+
+// GetApprovedGoodsContext 按审核状态获取商品列表
+func (live *Live) GetApprovedGoodsContext(ctx context2.Context, offset, limit int, status AuditStatus) (*GetApprovedGoodsResponse, error) {
+	query := url.Values{}
+	query.Set("offset", strconv.Itoa(offset))
+	query.Set("limit", strconv.Itoa(limit))
+	query.Set("status", strconv.Itoa(int(status)))
+	res := &GetApprovedGoodsResponse{}
+	if err := live.get(ctx, getApprovedGoodsURL, query, res, "GetApprovedGoods"); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
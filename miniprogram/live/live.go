@@ -0,0 +1,93 @@
+package live
+
+import (
+	context2 "context"
+	"fmt"
+	"net/url"
+
+	"github.com/silenceper/wechat/v2/miniprogram/context"
+	"github.com/silenceper/wechat/v2/util"
+)
+
+const (
+	mediaUploadURL = "https://api.weixin.qq.com/cgi-bin/media/upload?access_token=%s&type=image"
+)
+
+// Live 小程序直播，包括直播间、商品库、成员管理及回放
+//
+// 直播间封面、商品封面等图片需先通过 UploadMedia 上传，使用返回的 media_id
+type Live struct {
+	*context.Context
+}
+
+// NewLive init
+func NewLive(ctx *context.Context) *Live {
+	return &Live{ctx}
+}
+
+// Media 临时素材上传返回信息
+type Media struct {
+	util.CommonError
+
+	Type      string `json:"type"`
+	MediaID   string `json:"media_id"`
+	CreatedAt int64  `json:"created_at"`
+}
+
+// UploadMedia 上传图片临时素材，返回的 media_id 用于直播间及商品的图片字段，有效期 3 天
+func (live *Live) UploadMedia(filename string) (media Media, err error) {
+	return live.UploadMediaContext(context2.Background(), filename)
+}
+
+// UploadMediaContext 上传图片临时素材
+func (live *Live) UploadMediaContext(ctx context2.Context, filename string) (media Media, err error) {
+	var accessToken string
+	accessToken, err = live.GetAccessTokenContext(ctx)
+	if err != nil {
+		return
+	}
+	var response []byte
+	response, err = live.PostFileContext(ctx, "media", filename, fmt.Sprintf(mediaUploadURL, accessToken))
+	if err != nil {
+		return
+	}
+	err = util.DecodeWithError(response, &media, "UploadMedia")
+	return
+}
+
+// post 请求接口，res 为 nil 时只解析错误码
+func (live *Live) post(ctx context2.Context, urlTpl string, req, res interface{}, apiName string) error {
+	accessToken, err := live.GetAccessTokenContext(ctx)
+	if err != nil {
+		return err
+	}
+	response, err := live.PostJSONContext(ctx, fmt.Sprintf(urlTpl, accessToken), req)
+	if err != nil {
+		return err
+	}
+	return decode(response, res, apiName)
+}
+
+// get 请求接口，query 为 access_token 以外的参数
+func (live *Live) get(ctx context2.Context, urlTpl string, query url.Values, res interface{}, apiName string) error {
+	accessToken, err := live.GetAccessTokenContext(ctx)
+	if err != nil {
+		return err
+	}
+	uri := fmt.Sprintf(urlTpl, accessToken)
+	if len(query) > 0 {
+		uri += "&" + query.Encode()
+	}
+	response, err := live.HTTPGetContext(ctx, uri)
+	if err != nil {
+		return err
+	}
+	return decode(response, res, apiName)
+}
+
+func decode(response []byte, res interface{}, apiName string) error {
+	if res == nil {
+		return util.DecodeWithCommonError(response, apiName)
+	}
+	return util.DecodeWithError(response, res, apiName)
+}
@@ -0,0 +1,150 @@
+package live
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+
+	"github.com/silenceper/wechat/v2/miniprogram/config"
+	"github.com/silenceper/wechat/v2/miniprogram/context"
+	"github.com/silenceper/wechat/v2/util"
+)
+
+type stubAccessToken struct{}
+
+func (stubAccessToken) GetAccessToken() (string, error) {
+	return "mock-access-token", nil
+}
+
+func newTestLive() *Live {
+	return NewLive(&context.Context{Config: &config.Config{AppID: "appid"}, AccessTokenHandle: stubAccessToken{}})
+}
+
+func TestCreateRoom(t *testing.T) {
+	defer gock.Off()
+	gock.New("https://api.weixin.qq.com").
+		Post("/wxaapi/broadcast/room/create").
+		MatchParam("access_token", "mock-access-token").
+		BodyString(`"name":"新品发布".*"coverImg":"media_1".*"type":1`).
+		Reply(200).
+		JSON(map[string]interface{}{"errcode": 0, "roomId": 33})
+
+	res, err := newTestLive().CreateRoom(&Room{Name: "新品发布", CoverImg: "media_1", Type: 1})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(33), res.RoomID)
+	assert.True(t, gock.IsDone())
+}
+
+func TestGetSharedCode(t *testing.T) {
+	defer gock.Off()
+	gock.New("https://api.weixin.qq.com").
+		Get("/wxaapi/broadcast/room/getsharedcode").
+		MatchParam("access_token", "mock-access-token").
+		MatchParam("roomId", "33").
+		MatchParam("params", `\{"source":"banner"\}`).
+		Reply(200).
+		JSON(map[string]interface{}{"errcode": 0, "cdnUrl": "https://cdn/qr.png", "pagePath": "plugin-private://wx2b03c6e691cd7370/pages/live-player-plugin?room_id=33"})
+
+	res, err := newTestLive().GetSharedCode(33, `{"source":"banner"}`)
+	assert.Nil(t, err)
+	assert.Equal(t, "https://cdn/qr.png", res.CdnURL)
+	assert.True(t, gock.IsDone())
+}
+
+func TestGetReplay(t *testing.T) {
+	defer gock.Off()
+	gock.New("https://api.weixin.qq.com").
+		Post("/wxa/business/getliveinfo").
+		MatchParam("access_token", "mock-access-token").
+		BodyString(`\{"action":"get_replay","limit":10,"room_id":33,"start":0\}`).
+		Reply(200).
+		JSON(map[string]interface{}{"errcode": 0, "total": 1, "live_replay": []map[string]interface{}{{"media_url": "https://cdn/replay.mp4", "create_time": "2023-06-01T10:00:00Z", "expire_time": "2023-06-30T10:00:00Z"}}})
+
+	res, err := newTestLive().GetReplay(33, 0, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, res.Total)
+	if assert.Len(t, res.LiveReplay, 1) {
+		assert.Equal(t, "https://cdn/replay.mp4", res.LiveReplay[0].MediaURL)
+	}
+	assert.True(t, gock.IsDone())
+}
+
+func TestRole(t *testing.T) {
+	defer gock.Off()
+	gock.New("https://api.weixin.qq.com").
+		Post("/wxaapi/broadcast/role/addrole").
+		MatchParam("access_token", "mock-access-token").
+		BodyString(`\{"role":2,"username":"anchor"\}`).
+		Reply(200).
+		JSON(map[string]interface{}{"errcode": 0})
+	gock.New("https://api.weixin.qq.com").
+		Get("/wxaapi/broadcast/role/getrolelist").
+		MatchParam("access_token", "mock-access-token").
+		MatchParam("role", "-1").
+		MatchParam("offset", "0").
+		MatchParam("limit", "30").
+		MatchParam("keyword", "anchor").
+		Reply(200).
+		JSON(map[string]interface{}{"errcode": 0, "total": 1, "list": []map[string]interface{}{{"openid": "openid", "nickname": "主播", "roleList": []int{2}, "username": "an***or"}}})
+	gock.New("https://api.weixin.qq.com").
+		Post("/wxaapi/broadcast/role/deleterole").
+		BodyString(`\{"role":2,"username":"anchor"\}`).
+		Reply(200).
+		JSON(map[string]interface{}{"errcode": 400002, "errmsg": "成员不存在"})
+
+	live := newTestLive()
+	assert.Nil(t, live.AddRole("anchor", RoleAnchor))
+
+	res, err := live.GetRoleList(&GetRoleListRequest{Role: RoleAll, Limit: 30, Keyword: "anchor"})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, res.Total)
+	if assert.Len(t, res.List, 1) {
+		assert.Equal(t, []Role{RoleAnchor}, res.List[0].RoleList)
+	}
+
+	err = live.DeleteRole("anchor", RoleAnchor)
+	code, ok := util.ErrCodeOf(err)
+	assert.True(t, ok)
+	assert.Equal(t, int64(400002), code)
+	assert.True(t, gock.IsDone())
+}
+
+func TestAddGoods(t *testing.T) {
+	defer gock.Off()
+	gock.New("https://api.weixin.qq.com").
+		Post("/wxaapi/broadcast/goods/add").
+		BodyString(`\{"goodsInfo":\{"coverImgUrl":"media_1","name":"T恤","priceType":1,"price":99.5,"url":"pages/goods/index"\}\}`).
+		Reply(200).
+		JSON(map[string]interface{}{"errcode": 0, "goodsId": 51, "auditId": 52})
+	gock.New("https://api.weixin.qq.com").
+		Post("/wxaapi/broadcast/goods/delete").
+		Reply(200).
+		JSON(map[string]interface{}{"errcode": 300001, "errmsg": "商品不存在"})
+
+	live := newTestLive()
+	res, err := live.AddGoods(&GoodsInfo{CoverImgURL: "media_1", Name: "T恤", PriceType: PriceTypeFixed, Price: 99.5, URL: "pages/goods/index"})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(51), res.GoodsID)
+	assert.Equal(t, int64(52), res.AuditID)
+
+	assert.NotNil(t, live.DeleteGoods(51))
+	assert.True(t, gock.IsDone())
+}
+
+func TestUploadMedia(t *testing.T) {
+	defer gock.Off()
+	gock.New("https://api.weixin.qq.com").
+		Post("/cgi-bin/media/upload").
+		MatchParam("type", "image").
+		Reply(200).
+		JSON(map[string]interface{}{"type": "image", "media_id": "media_1", "created_at": 1700000000})
+
+	filename := filepath.Join(t.TempDir(), "cover.png")
+	assert.Nil(t, os.WriteFile(filename, []byte("png"), 0o600))
+	media, err := newTestLive().UploadMedia(filename)
+	assert.Nil(t, err)
+	assert.Equal(t, "media_1", media.MediaID)
+}
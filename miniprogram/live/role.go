@@ -0,0 +1,99 @@
+package live
+
+import (
+	context2 "context"
+	"net/url"
+	"strconv"
+
+	"github.com/silenceper/wechat/v2/util"
+)
+
+const (
+	addRoleURL     = "https://api.weixin.qq.com/wxaapi/broadcast/role/addrole?access_token=%s"
+	deleteRoleURL  = "https://api.weixin.qq.com/wxaapi/broadcast/role/deleterole?access_token=%s"
+	getRoleListURL = "https://api.weixin.qq.com/wxaapi/broadcast/role/getrolelist?access_token=%s"
+)
+
+// Role 直播成员角色
+type Role int
+
+const (
+	// RoleAll 查询时表示所有角色
+	RoleAll Role = -1
+	// RoleSuperAdmin 超级管理员
+	RoleSuperAdmin Role = 0
+	// RoleAdmin 管理员
+	RoleAdmin Role = 1
+	// RoleAnchor 主播
+	RoleAnchor Role = 2
+	// RoleOperator 运营者
+	RoleOperator Role = 3
+)
+
+// GetRoleListRequest 查询成员列表的参数
+type GetRoleListRequest struct {
+	Role    Role
+	Offset  int
+	Limit   int    // 不超过 30
+	Keyword string // 搜索的微信号或昵称
+}
+
+// RoleMember 成员信息
+type RoleMember struct {
+	HeadingImg      string `json:"headingimg"`
+	Nickname        string `json:"nickname"`
+	OpenID          string `json:"openid"`
+	RoleList        []Role `json:"roleList"`
+	UpdateTimestamp string `json:"updateTimestamp"`
+	Username        string `json:"username"` // 脱敏后的微信号
+}
+
+// GetRoleListResponse 查询成员列表返回
+type GetRoleListResponse struct {
+	util.CommonError
+	Total int          `json:"total"`
+	List  []RoleMember `json:"list"`
+}
+
+// AddRole 设置成员角色，username 为微信号，需完成实名认证
+func (live *Live) AddRole(username string, role Role) error {
+	return live.AddRoleContext(context2.Background(), username, role)
+}
+
+// AddRoleContext 设置成员角色
+func (live *Live) AddRoleContext(ctx context2.Context, username string, role Role) error {
+	req := map[string]interface{}{"username": username, "role": role}
+	return live.post(ctx, addRoleURL, req, nil, "AddRole")
+}
+
+// DeleteRole 解除成员角色
+func (live *Live) DeleteRole(username string, role Role) error {
+	return live.DeleteRoleContext(context2.Background(), username, role)
+}
+
+// DeleteRoleContext 解除成员角色
+func (live *Live) DeleteRoleContext(ctx context2.Context, username string, role Role) error {
+	req := map[string]interface{}{"username": username, "role": role}
+	return live.post(ctx, deleteRoleURL, req, nil, "DeleteRole")
+}
+
+// GetRoleList 查询成员列表
+func (live *Live) GetRoleList(in *GetRoleListRequest) (*GetRoleListResponse, error) {
+	return live.GetRoleListContext(context2.Background(), in)
+}
+
+// GetRoleListContext 查询成员列表
+func (live *Live) GetRoleListContext(ctx context2.Context, in *GetRoleListRequest) (*GetRoleListResponse, error) {
+	query := url.Values{}
+	query.Set("role", strconv.Itoa(int(in.Role)))
+	query.Set("offset", strconv.Itoa(in.Offset))
+	query.Set("limit", strconv.Itoa(in.Limit))
+	if in.Keyword != "" {
+		query.Set("keyword", in.Keyword)
+	}
+	res := &GetRoleListResponse{}
+	if err := live.get(ctx, getRoleListURL, query, res, "GetRoleList"); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
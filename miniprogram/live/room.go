@@ -0,0 +1,324 @@
+package live
+
+import (
+	context2 "context"
+	"net/url"
+	"strconv"
+
+	"github.com/silenceper/wechat/v2/util"
+)
+
+const (
+	createRoomURL       = "https://api.weixin.qq.com/wxaapi/broadcast/room/create?access_token=%s"
+	deleteRoomURL       = "https://api.weixin.qq.com/wxaapi/broadcast/room/deleteroom?access_token=%s"
+	editRoomURL         = "https://api.weixin.qq.com/wxaapi/broadcast/room/editroom?access_token=%s"
+	getLiveInfoURL      = "https://api.weixin.qq.com/wxa/business/getliveinfo?access_token=%s"
+	getPushURL          = "https://api.weixin.qq.com/wxaapi/broadcast/room/getpushurl?access_token=%s"
+	getSharedCodeURL    = "https://api.weixin.qq.com/wxaapi/broadcast/room/getsharedcode?access_token=%s"
+	addRoomGoodsURL     = "https://api.weixin.qq.com/wxaapi/broadcast/room/addgoods?access_token=%s"
+	addAssistantURL     = "https://api.weixin.qq.com/wxaapi/broadcast/room/addassistant?access_token=%s"
+	modifyAssistantURL  = "https://api.weixin.qq.com/wxaapi/broadcast/room/modifyassistant?access_token=%s"
+	removeAssistantURL  = "https://api.weixin.qq.com/wxaapi/broadcast/room/removeassistant?access_token=%s"
+	getAssistantListURL = "https://api.weixin.qq.com/wxaapi/broadcast/room/getassistantlist?access_token=%s"
+)
+
+// LiveStatus 直播间状态
+type LiveStatus int
+
+const (
+	// LiveStatusLiving 直播中
+	LiveStatusLiving LiveStatus = 101
+	// LiveStatusNotStarted 未开始
+	LiveStatusNotStarted LiveStatus = 102
+	// LiveStatusEnded 已结束
+	LiveStatusEnded LiveStatus = 103
+	// LiveStatusBanned 禁播
+	LiveStatusBanned LiveStatus = 104
+	// LiveStatusPaused 暂停
+	LiveStatusPaused LiveStatus = 105
+	// LiveStatusAbnormal 异常
+	LiveStatusAbnormal LiveStatus = 106
+	// LiveStatusExpired 已过期
+	LiveStatusExpired LiveStatus = 107
+)
+
+// Room 创建直播间的参数，图片字段为 UploadMedia 返回的 media_id，close 开头的字段 1 为关闭
+type Room struct {
+	Name            string `json:"name"`     // 直播间名字，3-17 个汉字
+	CoverImg        string `json:"coverImg"` // 背景图，建议 1080*1920，不超过 2M
+	StartTime       int64  `json:"startTime"`
+	EndTime         int64  `json:"endTime"`
+	AnchorName      string `json:"anchorName"`
+	AnchorWechat    string `json:"anchorWechat"`              // 主播微信号，需完成实名认证
+	SubAnchorWechat string `json:"subAnchorWechat,omitempty"` // 主播副号微信号
+	CreaterWechat   string `json:"createrWechat,omitempty"`   // 创建者微信号
+	ShareImg        string `json:"shareImg"`                  // 分享图，建议 800*640
+	FeedsImg        string `json:"feedsImg"`                  // 购物直播频道封面图，建议 800*800
+	IsFeedsPublic   int    `json:"isFeedsPublic"`             // 是否开启官方收录
+	Type            int    `json:"type"`                      // 直播类型，1 推流，0 手机直播
+	CloseLike       int    `json:"closeLike"`
+	CloseGoods      int    `json:"closeGoods"`
+	CloseComment    int    `json:"closeComment"`
+	CloseReplay     int    `json:"closeReplay"`
+	CloseShare      int    `json:"closeShare"`
+	CloseKf         int    `json:"closeKf"`
+}
+
+// EditRoomRequest 编辑直播间的参数
+type EditRoomRequest struct {
+	ID int64 `json:"id"`
+	Room
+}
+
+// CreateRoomResponse 创建直播间返回
+type CreateRoomResponse struct {
+	util.CommonError
+	RoomID    int64  `json:"roomId"`
+	QRCodeURL string `json:"qrcode_url"` // 主播未实名认证时返回，需主播扫码认证后重新创建
+}
+
+// RoomGoods 直播间商品
+type RoomGoods struct {
+	GoodsID         int64   `json:"goods_id"`
+	CoverImg        string  `json:"cover_img"`
+	URL             string  `json:"url"`
+	Name            string  `json:"name"`
+	Price           float64 `json:"price"` // 单位为分
+	Price2          float64 `json:"price2"`
+	PriceType       int     `json:"price_type"`
+	ThirdPartyAppID string  `json:"third_party_appid"`
+}
+
+// RoomInfo 直播间信息
+type RoomInfo struct {
+	Name          string      `json:"name"`
+	RoomID        int64       `json:"roomid"`
+	CoverImg      string      `json:"cover_img"`
+	ShareImg      string      `json:"share_img"`
+	FeedsImg      string      `json:"feeds_img"`
+	LiveStatus    LiveStatus  `json:"live_status"`
+	StartTime     int64       `json:"start_time"`
+	EndTime       int64       `json:"end_time"`
+	AnchorName    string      `json:"anchor_name"`
+	Goods         []RoomGoods `json:"goods"`
+	LiveType      int         `json:"live_type"`
+	CloseLike     int         `json:"close_like"`
+	CloseGoods    int         `json:"close_goods"`
+	CloseComment  int         `json:"close_comment"`
+	CloseKf       int         `json:"close_kf"`
+	CloseReplay   int         `json:"close_replay"`
+	IsFeedsPublic int         `json:"is_feeds_public"`
+	CreaterOpenID string      `json:"creater_openid"`
+}
+
+// GetLiveInfoResponse 获取直播间列表返回
+type GetLiveInfoResponse struct {
+	util.CommonError
+	RoomInfo []RoomInfo `json:"room_info"`
+	Total    int        `json:"total"`
+}
+
+// Replay 回放视频
+type Replay struct {
+	ExpireTime string `json:"expire_time"`
+	CreateTime string `json:"create_time"`
+	MediaURL   string `json:"media_url"`
+}
+
+// GetReplayResponse 获取直播间回放返回
+type GetReplayResponse struct {
+	util.CommonError
+	LiveReplay []Replay `json:"live_replay"`
+	Total      int      `json:"total"`
+}
+
+// SharedCode 直播间分享二维码
+type SharedCode struct {
+	util.CommonError
+	CdnURL    string `json:"cdnUrl"`    // 分享二维码
+	PagePath  string `json:"pagePath"`  // 分享路径
+	PosterURL string `json:"posterUrl"` // 分享海报
+}
+
+// Assistant 直播间小助手
+type Assistant struct {
+	Username string `json:"username"` // 微信号
+	Nickname string `json:"nickname"`
+}
+
+// AssistantInfo 小助手信息
+type AssistantInfo struct {
+	Timestamp int64  `json:"timestamp"` // 修改时间
+	HeadImg   string `json:"headimg"`
+	Nickname  string `json:"nickname"`
+	Alias     string `json:"alias"` // 微信号
+	OpenID    string `json:"openid"`
+}
+
+// GetAssistantListResponse 获取小助手列表返回
+type GetAssistantListResponse struct {
+	util.CommonError
+	List     []AssistantInfo `json:"list"`
+	Count    int             `json:"count"`
+	MaxCount int             `json:"maxCount"`
+}
+
+// CreateRoom 创建直播间
+func (live *Live) CreateRoom(in *Room) (*CreateRoomResponse, error) {
+	return live.CreateRoomContext(context2.Background(), in)
+}
+
+// CreateRoomContext 创建直播间
+func (live *Live) CreateRoomContext(ctx context2.Context, in *Room) (*CreateRoomResponse, error) {
+	res := &CreateRoomResponse{}
+	if err := live.post(ctx, createRoomURL, in, res, "CreateRoom"); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// DeleteRoom 删除直播间
+func (live *Live) DeleteRoom(roomID int64) error {
+	return live.DeleteRoomContext(context2.Background(), roomID)
+}
+
+// DeleteRoomContext 删除直播间
+func (live *Live) DeleteRoomContext(ctx context2.Context, roomID int64) error {
+	req := map[string]int64{"id": roomID}
+	return live.post(ctx, deleteRoomURL, req, nil, "DeleteRoom")
+}
+
+// EditRoom 编辑直播间，直播开始后不可编辑
+func (live *Live) EditRoom(in *EditRoomRequest) error {
+	return live.EditRoomContext(context2.Background(), in)
+}
+
+// EditRoomContext 编辑直播间
+func (live *Live) EditRoomContext(ctx context2.Context, in *EditRoomRequest) error {
+	return live.post(ctx, editRoomURL, in, nil, "EditRoom")
+}
+
+// GetLiveInfo 获取直播间列表，start 从 0 开始，limit 不超过 100
+func (live *Live) GetLiveInfo(start, limit int) (*GetLiveInfoResponse, error) {
+	return live.GetLiveInfoContext(context2.Background(), start, limit)
+}
+
+// GetLiveInfoContext 获取直播间列表
+func (live *Live) GetLiveInfoContext(ctx context2.Context, start, limit int) (*GetLiveInfoResponse, error) {
+	req := map[string]int{"start": start, "limit": limit}
+	res := &GetLiveInfoResponse{}
+	if err := live.post(ctx, getLiveInfoURL, req, res, "GetLiveInfo"); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetReplay 获取直播间回放，直播结束后生成
+func (live *Live) GetReplay(roomID int64, start, limit int) (*GetReplayResponse, error) {
+	return live.GetReplayContext(context2.Background(), roomID, start, limit)
+}
+
+// GetReplayContext 获取直播间回放
+func (live *Live) GetReplayContext(ctx context2.Context, roomID int64, start, limit int) (*GetReplayResponse, error) {
+	req := map[string]interface{}{"action": "get_replay", "room_id": roomID, "start": start, "limit": limit}
+	res := &GetReplayResponse{}
+	if err := live.post(ctx, getLiveInfoURL, req, res, "GetReplay"); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetPushURL 获取直播间推流地址
+func (live *Live) GetPushURL(roomID int64) (string, error) {
+	return live.GetPushURLContext(context2.Background(), roomID)
+}
+
+// GetPushURLContext 获取直播间推流地址
+func (live *Live) GetPushURLContext(ctx context2.Context, roomID int64) (string, error) {
+	var res struct {
+		util.CommonError
+		PushAddr string `json:"pushAddr"`
+	}
+	query := url.Values{"roomId": {strconv.FormatInt(roomID, 10)}}
+	if err := live.get(ctx, getPushURL, query, &res, "GetPushURL"); err != nil {
+		return "", err
+	}
+	return res.PushAddr, nil
+}
+
+// GetSharedCode 获取直播间分享二维码，params 为自定义参数，可为空
+func (live *Live) GetSharedCode(roomID int64, params string) (*SharedCode, error) {
+	return live.GetSharedCodeContext(context2.Background(), roomID, params)
+}
+
+// GetSharedCodeContext 获取直播间分享二维码
+func (live *Live) GetSharedCodeContext(ctx context2.Context, roomID int64, params string) (*SharedCode, error) {
+	query := url.Values{"roomId": {strconv.FormatInt(roomID, 10)}}
+	if params != "" {
+		query.Set("params", params)
+	}
+	res := &SharedCode{}
+	if err := live.get(ctx, getSharedCodeURL, query, res, "GetSharedCode"); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// AddRoomGoods 导入商品库中审核通过的商品到直播间
+func (live *Live) AddRoomGoods(roomID int64, goodsIDs []int64) error {
+	return live.AddRoomGoodsContext(context2.Background(), roomID, goodsIDs)
+}
+
+// AddRoomGoodsContext 导入商品到直播间
+func (live *Live) AddRoomGoodsContext(ctx context2.Context, roomID int64, goodsIDs []int64) error {
+	req := map[string]interface{}{"roomId": roomID, "ids": goodsIDs}
+	return live.post(ctx, addRoomGoodsURL, req, nil, "AddRoomGoods")
+}
+
+// AddAssistant 添加直播间小助手
+func (live *Live) AddAssistant(roomID int64, users []Assistant) error {
+	return live.AddAssistantContext(context2.Background(), roomID, users)
+}
+
+// AddAssistantContext 添加直播间小助手
+func (live *Live) AddAssistantContext(ctx context2.Context, roomID int64, users []Assistant) error {
+	req := map[string]interface{}{"roomId": roomID, "users": users}
+	return live.post(ctx, addAssistantURL, req, nil, "AddAssistant")
+}
+
+// ModifyAssistant 修改直播间小助手昵称
+func (live *Live) ModifyAssistant(roomID int64, user Assistant) error {
+	return live.ModifyAssistantContext(context2.Background(), roomID, user)
+}
+
+// ModifyAssistantContext 修改直播间小助手昵称
+func (live *Live) ModifyAssistantContext(ctx context2.Context, roomID int64, user Assistant) error {
+	req := map[string]interface{}{"roomId": roomID, "username": user.Username, "nickname": user.Nickname}
+	return live.post(ctx, modifyAssistantURL, req, nil, "ModifyAssistant")
+}
+
+// RemoveAssistant 删除直播间小助手
+func (live *Live) RemoveAssistant(roomID int64, username string) error {
+	return live.RemoveAssistantContext(context2.Background(), roomID, username)
+}
+
+// RemoveAssistantContext 删除直播间小助手
+func (live *Live) RemoveAssistantContext(ctx context2.Context, roomID int64, username string) error {
+	req := map[string]interface{}{"roomId": roomID, "username": username}
+	return live.post(ctx, removeAssistantURL, req, nil, "RemoveAssistant")
+}
+
+// GetAssistantList 查询直播间小助手
+func (live *Live) GetAssistantList(roomID int64) (*GetAssistantListResponse, error) {
+	return live.GetAssistantListContext(context2.Background(), roomID)
+}
+
+// GetAssistantListContext 查询直播间小助手
+func (live *Live) GetAssistantListContext(ctx context2.Context, roomID int64) (*GetAssistantListResponse, error) {
+	query := url.Values{"roomId": {strconv.FormatInt(roomID, 10)}}
+	res := &GetAssistantListResponse{}
+	if err := live.get(ctx, getAssistantListURL, query, res, "GetAssistantList"); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
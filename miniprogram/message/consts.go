@@ -20,38 +20,57 @@ const (
 	MsgTypeLink = "link"
 	// MsgTypeMiniProgramPage 小程序卡片
 	MsgTypeMiniProgramPage = "miniprogrampage"
+	// MsgTypeEvent 事件推送
+	MsgTypeEvent MsgType = "event"
+)
+
+const (
+	// EventUserEnterTempsession 用户进入客服会话
+	EventUserEnterTempsession EventType = "user_enter_tempsession"
+	// EventWxaMediaCheck 异步校验图片/音频内容安全结果
+	EventWxaMediaCheck EventType = "wxa_media_check"
+	// EventTradeManageRemindShipping 提醒上传发货信息
+	EventTradeManageRemindShipping EventType = "trade_manage_remind_shipping"
+	// EventTradeManageRemindAccessAPI 提醒接入发货信息管理服务 API
+	EventTradeManageRemindAccessAPI EventType = "trade_manage_remind_access_api"
+	// EventTradeManageOrderSettlement 订单将要结算或已经结算
+	EventTradeManageOrderSettlement EventType = "trade_manage_order_settlement"
+	// EventXpayGoodsDeliverNotify 虚拟支付道具发货推送
+	EventXpayGoodsDeliverNotify EventType = "xpay_goods_deliver_notify"
+	// EventXpayCoinPayNotify 虚拟支付代币支付推送
+	EventXpayCoinPayNotify EventType = "xpay_coin_pay_notify"
 )
 
 // CommonToken 消息中通用的结构
 type CommonToken struct {
-	XMLName      xml.Name `xml:"xml"`
-	ToUserName   string   `xml:"ToUserName"`
-	FromUserName string   `xml:"FromUserName"`
-	CreateTime   int64    `xml:"CreateTime"`
-	MsgType      MsgType  `xml:"MsgType"`
+	XMLName      xml.Name `xml:"xml" json:"-"`
+	ToUserName   string   `xml:"ToUserName" json:"ToUserName"`
+	FromUserName string   `xml:"FromUserName" json:"FromUserName"`
+	CreateTime   int64    `xml:"CreateTime" json:"CreateTime"`
+	MsgType      MsgType  `xml:"MsgType" json:"MsgType"`
 }
 
 // MiniProgramMixMessage 小程序回调的消息结构
 type MiniProgramMixMessage struct {
 	CommonToken
 
-	MsgID int64 `xml:"MsgId"`
+	MsgID int64 `xml:"MsgId" json:"MsgId"`
 
 	// 文本消息
-	Content string `xml:"Content"`
+	Content string `xml:"Content" json:"Content"`
 
 	// 图片消息
-	PicURL  string `xml:"PicUrl"`
-	MediaID string `xml:"MediaId"`
+	PicURL  string `xml:"PicUrl" json:"PicUrl"`
+	MediaID string `xml:"MediaId" json:"MediaId"`
 
 	// 小程序卡片消息
-	Title        string `xml:"Title"`
-	AppID        string `xml:"AppId"`
-	PagePath     string `xml:"PagePath"`
-	ThumbURL     string `xml:"ThumbUrl"`
-	ThumbMediaID string `xml:"ThumbMediaId"`
+	Title        string `xml:"Title" json:"Title"`
+	AppID        string `xml:"AppId" json:"AppId"`
+	PagePath     string `xml:"PagePath" json:"PagePath"`
+	ThumbURL     string `xml:"ThumbUrl" json:"ThumbUrl"`
+	ThumbMediaID string `xml:"ThumbMediaId" json:"ThumbMediaId"`
 
 	// 进入会话事件
-	Event       string `xml:"Event"`
-	SessionFrom string `xml:"SessionFrom"`
+	Event       string `xml:"Event" json:"Event"`
+	SessionFrom string `xml:"SessionFrom" json:"SessionFrom"`
 }
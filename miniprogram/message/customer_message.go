@@ -115,7 +115,7 @@ func (manager *Manager) Send(msg *CustomerMessage) error {
 		return err
 	}
 	uri := fmt.Sprintf("%s?access_token=%s", customerSendMessage, accessToken)
-	response, err := util.PostJSON(uri, msg)
+	response, err := manager.PostJSON(uri, msg)
 	if err != nil {
 		return err
 	}
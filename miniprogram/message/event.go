@@ -0,0 +1,109 @@
+package message
+
+// EncryptedMsg 安全模式下的消息体
+type EncryptedMsg struct {
+	XMLName      struct{} `xml:"xml" json:"-"`
+	ToUserName   string   `xml:"ToUserName" json:"ToUserName"`
+	EncryptedMsg string   `xml:"Encrypt" json:"Encrypt"`
+}
+
+// EventCommon 事件推送的公共字段
+type EventCommon struct {
+	CommonToken
+	Event EventType `xml:"Event" json:"Event"`
+}
+
+// UserEnterTempsessionEvent 用户进入客服会话
+type UserEnterTempsessionEvent struct {
+	EventCommon
+	SessionFrom string `xml:"SessionFrom" json:"SessionFrom"` // 开发者在客服会话按钮设置的 session-from 属性
+}
+
+// WxaMediaCheckEvent 异步校验图片/音频内容安全结果，见 security.MediaCheckAsync
+type WxaMediaCheckEvent struct {
+	EventCommon
+	AppID   string `xml:"appid" json:"appid"`
+	TraceID string `xml:"trace_id" json:"trace_id"`
+	Version int    `xml:"version" json:"version"`
+	Result  struct {
+		Suggest string `xml:"suggest" json:"suggest"` // risky、pass、review
+		Label   int    `xml:"label" json:"label"`
+	} `xml:"result" json:"result"`
+	Detail []struct {
+		Strategy string `xml:"strategy" json:"strategy"`
+		ErrCode  int    `xml:"errcode" json:"errcode"`
+		Suggest  string `xml:"suggest" json:"suggest"`
+		Label    int    `xml:"label" json:"label"`
+		Prob     int    `xml:"prob" json:"prob"`
+	} `xml:"detail" json:"detail"`
+}
+
+// TradeManageRemindShippingEvent 提醒上传发货信息，订单支付后长时间未上传发货信息时推送
+type TradeManageRemindShippingEvent struct {
+	EventCommon
+	TransactionID   string `xml:"transaction_id" json:"transaction_id"`       // 微信支付订单号
+	MerchantID      string `xml:"merchant_id" json:"merchant_id"`             // 商户号
+	SubMerchantID   string `xml:"sub_merchant_id" json:"sub_merchant_id"`     // 子商户号
+	MerchantTradeNo string `xml:"merchant_trade_no" json:"merchant_trade_no"` // 商户订单号
+	PayTime         int64  `xml:"pay_time" json:"pay_time"`                   // 支付成功时间，秒级时间戳
+	Msg             string `xml:"msg" json:"msg"`                             // 提醒内容
+}
+
+// TradeManageRemindAccessAPIEvent 提醒接入发货信息管理服务 API
+type TradeManageRemindAccessAPIEvent struct {
+	EventCommon
+	Msg string `xml:"msg" json:"msg"` // 提醒内容
+}
+
+// TradeManageOrderSettlementEvent 订单将要结算或已经结算
+type TradeManageOrderSettlementEvent struct {
+	EventCommon
+	TransactionID           string `xml:"transaction_id" json:"transaction_id"`                       // 微信支付订单号
+	MerchantID              string `xml:"merchant_id" json:"merchant_id"`                             // 商户号
+	SubMerchantID           string `xml:"sub_merchant_id" json:"sub_merchant_id"`                     // 子商户号
+	MerchantTradeNo         string `xml:"merchant_trade_no" json:"merchant_trade_no"`                 // 商户订单号
+	PayTime                 int64  `xml:"pay_time" json:"pay_time"`                                   // 支付成功时间，秒级时间戳
+	ShippedTime             int64  `xml:"shipped_time" json:"shipped_time"`                           // 发货时间
+	EstimatedSettlementTime int64  `xml:"estimated_settlement_time" json:"estimated_settlement_time"` // 预计结算时间，确认收货时推送
+	ConfirmReceiveMethod    int    `xml:"confirm_receive_method" json:"confirm_receive_method"`       // 确认收货方式：1 手动确认收货，2 自动确认收货
+	ConfirmReceiveTime      int64  `xml:"confirm_receive_time" json:"confirm_receive_time"`           // 确认收货时间
+	SettlementTime          int64  `xml:"settlement_time" json:"settlement_time"`                     // 订单结算时间，结算时推送
+}
+
+// XpayWeChatPayInfo 虚拟支付推送中的微信支付信息
+type XpayWeChatPayInfo struct {
+	MchOrderNo    string `xml:"MchOrderNo" json:"MchOrderNo"`       // 微信支付商户单号
+	TransactionID string `xml:"TransactionId" json:"TransactionId"` // 微信支付订单号
+	PaidTime      int64  `xml:"PaidTime" json:"PaidTime"`           // 支付时间，秒级时间戳
+}
+
+// XpayGoodsDeliverNotifyEvent 虚拟支付道具发货推送，处理完成后需在 ErrCode 为 0 的回复中确认
+type XpayGoodsDeliverNotifyEvent struct {
+	EventCommon
+	OpenID     string `xml:"OpenId" json:"OpenId"`
+	Env        int    `xml:"Env" json:"Env"`               // 0 现网环境，1 沙箱环境
+	OutTradeNo string `xml:"OutTradeNo" json:"OutTradeNo"` // 商户订单号
+	GoodsInfo  struct {
+		ProductID   string `xml:"ProductId" json:"ProductId"`
+		Quantity    int    `xml:"Quantity" json:"Quantity"`
+		OrigPrice   int64  `xml:"OrigPrice" json:"OrigPrice"`     // 物品原始价格，单位为分
+		ActualPrice int64  `xml:"ActualPrice" json:"ActualPrice"` // 物品实际支付价格
+		Attach      string `xml:"Attach" json:"Attach"`           // 透传信息
+	} `xml:"GoodsInfo" json:"GoodsInfo"`
+	WeChatPayInfo XpayWeChatPayInfo `xml:"WeChatPayInfo" json:"WeChatPayInfo"`
+}
+
+// XpayCoinPayNotifyEvent 虚拟支付代币支付推送
+type XpayCoinPayNotifyEvent struct {
+	EventCommon
+	OpenID     string `xml:"OpenId" json:"OpenId"`
+	Env        int    `xml:"Env" json:"Env"`
+	OutTradeNo string `xml:"OutTradeNo" json:"OutTradeNo"`
+	CoinInfo   struct {
+		Quantity    int64  `xml:"Quantity" json:"Quantity"` // 代币数量
+		OrigPrice   int64  `xml:"OrigPrice" json:"OrigPrice"`
+		ActualPrice int64  `xml:"ActualPrice" json:"ActualPrice"`
+		Attach      string `xml:"Attach" json:"Attach"`
+	} `xml:"CoinInfo" json:"CoinInfo"`
+	WeChatPayInfo XpayWeChatPayInfo `xml:"WeChatPayInfo" json:"WeChatPayInfo"`
+}
@@ -9,17 +9,21 @@ import (
 	"github.com/silenceper/wechat/v2/miniprogram/content"
 	"github.com/silenceper/wechat/v2/miniprogram/context"
 	"github.com/silenceper/wechat/v2/miniprogram/encryptor"
+	"github.com/silenceper/wechat/v2/miniprogram/live"
 	"github.com/silenceper/wechat/v2/miniprogram/message"
 	"github.com/silenceper/wechat/v2/miniprogram/privacy"
 	"github.com/silenceper/wechat/v2/miniprogram/qrcode"
 	"github.com/silenceper/wechat/v2/miniprogram/riskcontrol"
 	"github.com/silenceper/wechat/v2/miniprogram/security"
+	"github.com/silenceper/wechat/v2/miniprogram/server"
+	"github.com/silenceper/wechat/v2/miniprogram/shipping"
 	"github.com/silenceper/wechat/v2/miniprogram/shortlink"
 	"github.com/silenceper/wechat/v2/miniprogram/subscribe"
 	"github.com/silenceper/wechat/v2/miniprogram/tcb"
 	"github.com/silenceper/wechat/v2/miniprogram/urllink"
 	"github.com/silenceper/wechat/v2/miniprogram/urlscheme"
 	"github.com/silenceper/wechat/v2/miniprogram/werun"
+	"github.com/silenceper/wechat/v2/miniprogram/xpay"
 )
 
 // MiniProgram 微信小程序相关API
@@ -29,7 +33,12 @@ type MiniProgram struct {
 
 // NewMiniProgram 实例化小程序API
 func NewMiniProgram(cfg *config.Config) *MiniProgram {
-	defaultAkHandle := credential.NewDefaultAccessToken(cfg.AppID, cfg.AppSecret, credential.CacheKeyMiniProgramPrefix, cfg.Cache)
+	var defaultAkHandle credential.AccessTokenHandle
+	if cfg.UseStableAK {
+		defaultAkHandle = credential.NewStableAccessToken(cfg.AppID, cfg.AppSecret, credential.CacheKeyMiniProgramPrefix, cfg.Cache)
+	} else {
+		defaultAkHandle = credential.NewDefaultAccessToken(cfg.AppID, cfg.AppSecret, credential.CacheKeyMiniProgramPrefix, cfg.Cache)
+	}
 	ctx := &context.Context{
 		Config:            cfg,
 		AccessTokenHandle: defaultAkHandle,
@@ -112,6 +121,26 @@ func (miniProgram *MiniProgram) GetRiskControl() *riskcontrol.RiskControl {
 	return riskcontrol.NewRiskControl(miniProgram.ctx)
 }
 
+// GetServer 消息推送服务，返回的 Server 实现 http.Handler，需注册处理方法后长期复用
+func (miniProgram *MiniProgram) GetServer() *server.Server {
+	return server.NewServer(miniProgram.ctx)
+}
+
+// GetShipping 发货信息管理
+func (miniProgram *MiniProgram) GetShipping() *shipping.Shipping {
+	return shipping.NewShipping(miniProgram.ctx)
+}
+
+// GetXPay 虚拟支付，appKey 为小程序后台虚拟支付中 env 对应的 AppKey
+func (miniProgram *MiniProgram) GetXPay(appKey string, env xpay.Env) *xpay.XPay {
+	return xpay.NewXPay(miniProgram.ctx, appKey, env)
+}
+
+// GetLive 小程序直播
+func (miniProgram *MiniProgram) GetLive() *live.Live {
+	return live.NewLive(miniProgram.ctx)
+}
+
 // GetSecurity 内容安全接口
 func (miniProgram *MiniProgram) GetSecurity() *security.Security {
 	return security.NewSecurity(miniProgram.ctx)
@@ -95,7 +95,7 @@ func (s *Privacy) GetPrivacySetting(privacyVer int) (GetPrivacySettingResponse,
 		return GetPrivacySettingResponse{}, err
 	}
 
-	response, err := util.PostJSON(fmt.Sprintf("%s?access_token=%s", getPrivacySettingURL, accessToken), map[string]int{
+	response, err := s.PostJSON(fmt.Sprintf("%s?access_token=%s", getPrivacySettingURL, accessToken), map[string]int{
 		"privacy_ver": privacyVer,
 	})
 	if err != nil {
@@ -120,7 +120,7 @@ func (s *Privacy) SetPrivacySetting(privacyVer int, ownerSetting OwnerSetting, s
 		return err
 	}
 
-	response, err := util.PostJSON(fmt.Sprintf("%s?access_token=%s", setPrivacySettingURL, accessToken), SetPrivacySettingRequest{
+	response, err := s.PostJSON(fmt.Sprintf("%s?access_token=%s", setPrivacySettingURL, accessToken), SetPrivacySettingRequest{
 		PrivacyVer:   privacyVer,
 		OwnerSetting: ownerSetting,
 		SettingList:  settingList,
@@ -150,7 +150,7 @@ func (s *Privacy) UploadPrivacyExtFile(fileData []byte) (UploadPrivacyExtFileRes
 		return UploadPrivacyExtFileResponse{}, err
 	}
 
-	response, err := util.PostJSON(fmt.Sprintf("%s?access_token=%s", uploadPrivacyExtFileURL, accessToken), map[string][]byte{
+	response, err := s.PostJSON(fmt.Sprintf("%s?access_token=%s", uploadPrivacyExtFileURL, accessToken), map[string][]byte{
 		"file": fileData,
 	})
 	if err != nil {
@@ -66,7 +66,7 @@ func (qrCode *QRCode) fetchCode(urlStr string, body interface{}) (response []byt
 
 	urlStr = fmt.Sprintf(urlStr, accessToken)
 	var contentType string
-	response, contentType, err = util.PostJSONWithRespContentType(urlStr, body)
+	response, contentType, err = qrCode.PostJSONWithRespContentType(urlStr, body)
 	if err != nil {
 		return
 	}
@@ -49,7 +49,7 @@ func (riskControl *RiskControl) GetUserRiskRank(in *UserRiskRankRequest) (res Us
 	}
 
 	uri := fmt.Sprintf(getUserRiskRankURL, accessToken)
-	response, err := util.PostJSON(uri, in)
+	response, err := riskControl.PostJSON(uri, in)
 	if err != nil {
 		return
 	}
@@ -40,7 +40,7 @@ func (security *Security) MediaCheckAsyncV1(in *MediaCheckAsyncV1Request) (trace
 	}
 
 	uri := fmt.Sprintf(mediaCheckAsyncURL, accessToken)
-	response, err := util.PostJSON(uri, in)
+	response, err := security.PostJSON(uri, in)
 	if err != nil {
 		return
 	}
@@ -82,7 +82,7 @@ func (security *Security) MediaCheckAsync(in *MediaCheckAsyncRequest) (traceID s
 	req.Version = 2
 
 	uri := fmt.Sprintf(mediaCheckAsyncURL, accessToken)
-	response, err := util.PostJSON(uri, req)
+	response, err := security.PostJSON(uri, req)
 	if err != nil {
 		return
 	}
@@ -112,7 +112,7 @@ func (security *Security) ImageCheckV1(filename string) (err error) {
 	}
 
 	uri := fmt.Sprintf(imageCheckURL, accessToken)
-	response, err := util.PostFile("media", filename, uri)
+	response, err := security.PostFile("media", filename, uri)
 	if err != nil {
 		return
 	}
@@ -220,7 +220,7 @@ func (security *Security) MsgCheckV1(content string) (res MsgCheckResponse, err
 	req.Content = content
 
 	uri := fmt.Sprintf(msgCheckURL, accessToken)
-	response, err := util.PostJSON(uri, req)
+	response, err := security.PostJSON(uri, req)
 	if err != nil {
 		return
 	}
@@ -245,7 +245,7 @@ func (security *Security) MsgCheck(in *MsgCheckRequest) (res MsgCheckResponse, e
 	req.Version = 2
 
 	uri := fmt.Sprintf(msgCheckURL, accessToken)
-	response, err := util.PostJSON(uri, req)
+	response, err := security.PostJSON(uri, req)
 	if err != nil {
 		return
 	}
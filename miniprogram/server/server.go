@@ -0,0 +1,285 @@
+package server
+
+import (
+	context2 "context"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"runtime/debug"
+	"strings"
+
+	"github.com/silenceper/wechat/v2/logger"
+	"github.com/silenceper/wechat/v2/miniprogram/context"
+	"github.com/silenceper/wechat/v2/miniprogram/message"
+	"github.com/silenceper/wechat/v2/util"
+)
+
+// xpayEventPrefix 虚拟支付推送的事件类型前缀
+const xpayEventPrefix = "xpay_"
+
+// ErrInvalidSignature 推送请求签名校验失败
+var ErrInvalidSignature = errors.New("请求校验失败")
+
+// Push 小程序消息推送
+type Push struct {
+	*message.MiniProgramMixMessage
+	Request *http.Request
+	Raw     []byte // 解密后的消息
+	IsJSON  bool   // 消息格式是否为 JSON
+}
+
+// Decode 将原始消息解析为具体类型，如 *message.WxaMediaCheckEvent
+func (push *Push) Decode(v interface{}) error {
+	if push.IsJSON {
+		return json.Unmarshal(push.Raw, v)
+	}
+	return xml.Unmarshal(push.Raw, v)
+}
+
+// HandlerFunc 消息处理方法，返回错误时回复 500，微信会重试推送
+type HandlerFunc func(ctx context2.Context, push *Push) error
+
+// Server 小程序消息推送服务，实现 http.Handler，可长期复用
+//
+// 支持明文及安全模式，XML 及 JSON 数据格式（按 Content-Type 区分）；
+// 匹配顺序：事件类型、消息类型、Fallback，事件类型不区分大小写；处理完成后回复 success
+type Server struct {
+	*context.Context
+
+	skipValidate bool
+	replayGuard  *util.ReplayGuard
+
+	msgHandlers   map[message.MsgType]HandlerFunc
+	eventHandlers map[string]HandlerFunc
+	fallback      HandlerFunc
+}
+
+// NewServer init
+func NewServer(ctx *context.Context) *Server {
+	return &Server{
+		Context:       ctx,
+		msgHandlers:   map[message.MsgType]HandlerFunc{},
+		eventHandlers: map[string]HandlerFunc{},
+	}
+}
+
+// log 日志，字段经过脱敏
+func (srv *Server) log() logger.Logger {
+	return logger.Redact(logger.Or(srv.Logger))
+}
+
+// SkipValidate set skip validate
+func (srv *Server) SkipValidate(skip bool) {
+	srv.skipValidate = skip
+}
+
+// SetReplayGuard 设置防重放校验，签名校验通过后检查时间戳及 nonce，见 util.ReplayGuard
+func (srv *Server) SetReplayGuard(guard *util.ReplayGuard) {
+	srv.replayGuard = guard
+}
+
+// HandleMsg 注册消息类型的处理方法，如 message.MsgTypeText
+func (srv *Server) HandleMsg(msgType message.MsgType, handler HandlerFunc) *Server {
+	srv.msgHandlers[msgType] = handler
+	return srv
+}
+
+// HandleEvent 注册事件类型的处理方法，如 message.EventWxaMediaCheck
+func (srv *Server) HandleEvent(event message.EventType, handler HandlerFunc) *Server {
+	srv.eventHandlers[strings.ToLower(string(event))] = handler
+	return srv
+}
+
+// Fallback 注册未匹配时的处理方法，未设置时直接回复 success
+func (srv *Server) Fallback(handler HandlerFunc) *Server {
+	srv.fallback = handler
+	return srv
+}
+
+// OnUserEnterTempsession 用户进入客服会话
+func (srv *Server) OnUserEnterTempsession(handler func(ctx context2.Context, event *message.UserEnterTempsessionEvent) error) *Server {
+	return srv.HandleEvent(message.EventUserEnterTempsession, func(ctx context2.Context, push *Push) error {
+		event := &message.UserEnterTempsessionEvent{}
+		if err := push.Decode(event); err != nil {
+			return err
+		}
+		return handler(ctx, event)
+	})
+}
+
+// OnWxaMediaCheck 异步校验图片/音频内容安全结果
+func (srv *Server) OnWxaMediaCheck(handler func(ctx context2.Context, event *message.WxaMediaCheckEvent) error) *Server {
+	return srv.HandleEvent(message.EventWxaMediaCheck, func(ctx context2.Context, push *Push) error {
+		event := &message.WxaMediaCheckEvent{}
+		if err := push.Decode(event); err != nil {
+			return err
+		}
+		return handler(ctx, event)
+	})
+}
+
+// OnTradeManageRemindShipping 提醒上传发货信息
+func (srv *Server) OnTradeManageRemindShipping(handler func(ctx context2.Context, event *message.TradeManageRemindShippingEvent) error) *Server {
+	return srv.HandleEvent(message.EventTradeManageRemindShipping, func(ctx context2.Context, push *Push) error {
+		event := &message.TradeManageRemindShippingEvent{}
+		if err := push.Decode(event); err != nil {
+			return err
+		}
+		return handler(ctx, event)
+	})
+}
+
+// OnTradeManageRemindAccessAPI 提醒接入发货信息管理服务 API
+func (srv *Server) OnTradeManageRemindAccessAPI(handler func(ctx context2.Context, event *message.TradeManageRemindAccessAPIEvent) error) *Server {
+	return srv.HandleEvent(message.EventTradeManageRemindAccessAPI, func(ctx context2.Context, push *Push) error {
+		event := &message.TradeManageRemindAccessAPIEvent{}
+		if err := push.Decode(event); err != nil {
+			return err
+		}
+		return handler(ctx, event)
+	})
+}
+
+// OnTradeManageOrderSettlement 订单将要结算或已经结算
+func (srv *Server) OnTradeManageOrderSettlement(handler func(ctx context2.Context, event *message.TradeManageOrderSettlementEvent) error) *Server {
+	return srv.HandleEvent(message.EventTradeManageOrderSettlement, func(ctx context2.Context, push *Push) error {
+		event := &message.TradeManageOrderSettlementEvent{}
+		if err := push.Decode(event); err != nil {
+			return err
+		}
+		return handler(ctx, event)
+	})
+}
+
+// OnXpayGoodsDeliverNotify 虚拟支付道具发货推送，返回 nil 表示已发货
+func (srv *Server) OnXpayGoodsDeliverNotify(handler func(ctx context2.Context, event *message.XpayGoodsDeliverNotifyEvent) error) *Server {
+	return srv.HandleEvent(message.EventXpayGoodsDeliverNotify, func(ctx context2.Context, push *Push) error {
+		event := &message.XpayGoodsDeliverNotifyEvent{}
+		if err := push.Decode(event); err != nil {
+			return err
+		}
+		return handler(ctx, event)
+	})
+}
+
+// OnXpayCoinPayNotify 虚拟支付代币支付推送
+func (srv *Server) OnXpayCoinPayNotify(handler func(ctx context2.Context, event *message.XpayCoinPayNotifyEvent) error) *Server {
+	return srv.HandleEvent(message.EventXpayCoinPayNotify, func(ctx context2.Context, push *Push) error {
+		event := &message.XpayCoinPayNotifyEvent{}
+		if err := push.Decode(event); err != nil {
+			return err
+		}
+		return handler(ctx, event)
+	})
+}
+
+// ServeHTTP 处理微信的推送请求，GET 请求为服务器地址校验
+func (srv *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	timestamp, nonce := query.Get("timestamp"), query.Get("nonce")
+	if !srv.Validate(r) {
+		srv.log().Error("validate signature failed", logger.String("timestamp", timestamp), logger.String("nonce", nonce))
+		http.Error(w, ErrInvalidSignature.Error(), http.StatusForbidden)
+		return
+	}
+	if srv.replayGuard != nil {
+		if err := srv.replayGuard.Check(r.Context(), timestamp, nonce); err != nil {
+			srv.log().Error("check replay failed", logger.Err(err), logger.String("timestamp", timestamp), logger.String("nonce", nonce))
+			http.Error(w, err.Error(), http.StatusForbidden)
+			return
+		}
+	}
+	if echostr, ok := query["echostr"]; ok {
+		_, _ = io.WriteString(w, echostr[0])
+		return
+	}
+
+	push, err := srv.parsePush(r)
+	if err != nil {
+		srv.log().Error("parse push message failed", logger.Err(err))
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err = srv.handle(r.Context(), push); err != nil {
+		if srv.replayGuard != nil {
+			_ = srv.replayGuard.Release(context2.Background(), timestamp, nonce)
+		}
+		srv.log().Error("handle push message failed", logger.Err(err), logger.Any("msg_type", push.MsgType), logger.String("event", push.Event))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	if push.MsgType == message.MsgTypeEvent && strings.HasPrefix(push.Event, xpayEventPrefix) {
+		// 虚拟支付推送需回复 JSON，ErrCode 为 0 表示处理成功
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = io.WriteString(w, `{"ErrCode":0,"ErrMsg":"success"}`)
+		return
+	}
+	_, _ = io.WriteString(w, "success")
+}
+
+// Validate 校验请求签名
+func (srv *Server) Validate(r *http.Request) bool {
+	if srv.skipValidate {
+		return true
+	}
+	query := r.URL.Query()
+	return query.Get("signature") == util.Signature(srv.Token, query.Get("timestamp"), query.Get("nonce"))
+}
+
+// parsePush 读取推送消息，安全模式下校验消息签名并解密
+func (srv *Server) parsePush(r *http.Request) (*Push, error) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, fmt.Errorf("读取body失败, err=%v", err)
+	}
+	push := &Push{
+		Request: r,
+		Raw:     body,
+		IsJSON:  strings.Contains(r.Header.Get("Content-Type"), "application/json"),
+	}
+	query := r.URL.Query()
+	if query.Get("encrypt_type") == "aes" {
+		encrypted := &message.EncryptedMsg{}
+		if err = push.Decode(encrypted); err != nil {
+			return nil, fmt.Errorf("解析加密消息失败, err=%v", err)
+		}
+		msgSignature := util.Signature(srv.Token, query.Get("timestamp"), query.Get("nonce"), encrypted.EncryptedMsg)
+		if !srv.skipValidate && query.Get("msg_signature") != msgSignature {
+			return nil, fmt.Errorf("消息不合法，验证签名失败")
+		}
+		if _, push.Raw, err = util.DecryptMsg(srv.AppID, encrypted.EncryptedMsg, srv.EncodingAESKey); err != nil {
+			return nil, fmt.Errorf("消息解密失败, err=%v", err)
+		}
+	}
+	push.MiniProgramMixMessage = &message.MiniProgramMixMessage{}
+	if err = push.Decode(push.MiniProgramMixMessage); err != nil {
+		return nil, fmt.Errorf("解析消息失败, err=%v", err)
+	}
+	return push, nil
+}
+
+func (srv *Server) handle(ctx context2.Context, push *Push) (err error) {
+	defer func() {
+		if e := recover(); e != nil {
+			err = fmt.Errorf("panic error: %v\n%s", e, debug.Stack())
+		}
+	}()
+	if handler := srv.match(push); handler != nil {
+		return handler(ctx, push)
+	}
+	return nil
+}
+
+func (srv *Server) match(push *Push) HandlerFunc {
+	if push.MsgType == message.MsgTypeEvent {
+		if handler, ok := srv.eventHandlers[strings.ToLower(push.Event)]; ok {
+			return handler
+		}
+	} else if handler, ok := srv.msgHandlers[push.MsgType]; ok {
+		return handler
+	}
+	return srv.fallback
+}
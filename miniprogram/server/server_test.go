@@ -0,0 +1,132 @@
+package server
+
+import (
+	context2 "context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/silenceper/wechat/v2/miniprogram/config"
+	"github.com/silenceper/wechat/v2/miniprogram/context"
+	"github.com/silenceper/wechat/v2/miniprogram/message"
+	"github.com/silenceper/wechat/v2/util"
+)
+
+const (
+	testToken  = "token"
+	testAESKey = "abcdefghijklmnopqrstuvwxyz0123456789ABCDEFG"
+)
+
+func newTestServer() *Server {
+	return NewServer(&context.Context{Config: &config.Config{AppID: "appid", Token: testToken, EncodingAESKey: testAESKey}})
+}
+
+func newTestRequest(method string, query url.Values, body string) *http.Request {
+	query.Set("timestamp", "1700000000")
+	query.Set("nonce", "nonce")
+	query.Set("signature", util.Signature(testToken, "1700000000", "nonce"))
+	return httptest.NewRequest(method, "/wxa?"+query.Encode(), strings.NewReader(body))
+}
+
+func TestServerEchostr(t *testing.T) {
+	srv := newTestServer()
+	w := httptest.NewRecorder()
+	srv.ServeHTTP(w, newTestRequest(http.MethodGet, url.Values{"echostr": {"hello"}}, ""))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "hello", w.Body.String())
+
+	req := newTestRequest(http.MethodGet, url.Values{"echostr": {"hello"}}, "")
+	req.URL.RawQuery = strings.Replace(req.URL.RawQuery, "nonce=nonce", "nonce=other", 1)
+	w = httptest.NewRecorder()
+	srv.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusForbidden, w.Code)
+}
+
+func TestServerXML(t *testing.T) {
+	var content, sessionFrom string
+	srv := newTestServer().
+		HandleMsg(message.MsgTypeText, func(_ context2.Context, push *Push) error {
+			content = push.Content
+			return nil
+		}).
+		OnUserEnterTempsession(func(_ context2.Context, event *message.UserEnterTempsessionEvent) error {
+			sessionFrom = event.SessionFrom
+			return nil
+		})
+
+	w := httptest.NewRecorder()
+	srv.ServeHTTP(w, newTestRequest(http.MethodPost, url.Values{}, `<xml><ToUserName>gh_1</ToUserName><FromUserName>openid</FromUserName><CreateTime>1700000000</CreateTime><MsgType>text</MsgType><Content>hi</Content><MsgId>1</MsgId></xml>`))
+	assert.Equal(t, "success", w.Body.String())
+	assert.Equal(t, "hi", content)
+
+	w = httptest.NewRecorder()
+	srv.ServeHTTP(w, newTestRequest(http.MethodPost, url.Values{}, `<xml><ToUserName>gh_1</ToUserName><FromUserName>openid</FromUserName><CreateTime>1700000000</CreateTime><MsgType>event</MsgType><Event>user_enter_tempsession</Event><SessionFrom>card</SessionFrom></xml>`))
+	assert.Equal(t, "success", w.Body.String())
+	assert.Equal(t, "card", sessionFrom)
+}
+
+func TestServerSafeModeJSON(t *testing.T) {
+	var event *message.TradeManageRemindShippingEvent
+	srv := newTestServer().OnTradeManageRemindShipping(func(_ context2.Context, e *message.TradeManageRemindShippingEvent) error {
+		event = e
+		return nil
+	})
+
+	raw := `{"ToUserName":"gh_1","FromUserName":"openid","CreateTime":1700000000,"MsgType":"event","Event":"trade_manage_remind_shipping","transaction_id":"4200001","merchant_id":"1230000109","merchant_trade_no":"order_1","pay_time":1699990000,"msg":"请尽快发货"}`
+	encrypted, err := util.EncryptMsg([]byte("0123456789abcdef"), []byte(raw), "appid", testAESKey)
+	assert.Nil(t, err)
+	query := url.Values{"encrypt_type": {"aes"}}
+	query.Set("msg_signature", util.Signature(testToken, "1700000000", "nonce", string(encrypted)))
+	req := newTestRequest(http.MethodPost, query, fmt.Sprintf(`{"ToUserName":"gh_1","Encrypt":%q}`, encrypted))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	srv.ServeHTTP(w, req)
+	assert.Equal(t, "success", w.Body.String())
+	if assert.NotNil(t, event) {
+		assert.Equal(t, "4200001", event.TransactionID)
+		assert.Equal(t, "order_1", event.MerchantTradeNo)
+		assert.Equal(t, int64(1699990000), event.PayTime)
+		assert.Equal(t, "请尽快发货", event.Msg)
+	}
+
+	query.Set("msg_signature", "invalid")
+	req = newTestRequest(http.MethodPost, query, fmt.Sprintf(`{"ToUserName":"gh_1","Encrypt":%q}`, encrypted))
+	req.Header.Set("Content-Type", "application/json")
+	w = httptest.NewRecorder()
+	srv.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestServerHandlerError(t *testing.T) {
+	srv := newTestServer().Fallback(func(context2.Context, *Push) error {
+		return errors.New("db unavailable")
+	})
+	w := httptest.NewRecorder()
+	srv.ServeHTTP(w, newTestRequest(http.MethodPost, url.Values{}, `<xml><MsgType>event</MsgType><Event>wxa_media_check</Event></xml>`))
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+}
+
+func TestServerXpayReply(t *testing.T) {
+	var event *message.XpayGoodsDeliverNotifyEvent
+	srv := newTestServer().OnXpayGoodsDeliverNotify(func(_ context2.Context, e *message.XpayGoodsDeliverNotifyEvent) error {
+		event = e
+		return nil
+	})
+	req := newTestRequest(http.MethodPost, url.Values{}, `{"ToUserName":"gh_1","FromUserName":"openid","CreateTime":1700000000,"MsgType":"event","Event":"xpay_goods_deliver_notify","OpenId":"openid","Env":1,"OutTradeNo":"order_1","GoodsInfo":{"ProductId":"p1","Quantity":2,"OrigPrice":100,"ActualPrice":90,"Attach":"a"},"WeChatPayInfo":{"MchOrderNo":"m1","TransactionId":"t1","PaidTime":1700000000}}`)
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	srv.ServeHTTP(w, req)
+	assert.JSONEq(t, `{"ErrCode":0,"ErrMsg":"success"}`, w.Body.String())
+	if assert.NotNil(t, event) {
+		assert.Equal(t, "p1", event.GoodsInfo.ProductID)
+		assert.Equal(t, int64(90), event.GoodsInfo.ActualPrice)
+		assert.Equal(t, "t1", event.WeChatPayInfo.TransactionID)
+	}
+}
@@ -0,0 +1,138 @@
+package shipping
+
+import (
+	context2 "context"
+	"fmt"
+
+	"github.com/silenceper/wechat/v2/miniprogram/context"
+	"github.com/silenceper/wechat/v2/util"
+)
+
+const (
+	uploadShippingInfoURL         = "https://api.weixin.qq.com/wxa/sec/order/upload_shipping_info?access_token=%s"
+	uploadCombinedShippingInfoURL = "https://api.weixin.qq.com/wxa/sec/order/upload_combined_shipping_info?access_token=%s"
+	getOrderURL                   = "https://api.weixin.qq.com/wxa/sec/order/get_order?access_token=%s"
+	getOrderListURL               = "https://api.weixin.qq.com/wxa/sec/order/get_order_list?access_token=%s"
+	notifyConfirmReceiveURL       = "https://api.weixin.qq.com/wxa/sec/order/notify_confirm_receive?access_token=%s"
+	setMsgJumpPathURL             = "https://api.weixin.qq.com/wxa/sec/order/set_msg_jump_path?access_token=%s"
+	isTradeManagedURL             = "https://api.weixin.qq.com/wxa/sec/order/is_trade_managed?access_token=%s"
+)
+
+// Shipping 发货信息管理
+//
+// 使用微信支付的小程序需在用户支付后上传发货信息，否则资金将被冻结，见
+// https://developers.weixin.qq.com/miniprogram/dev/platform-capabilities/business-capabilities/order-shipping/order-shipping.html
+type Shipping struct {
+	*context.Context
+}
+
+// NewShipping init
+func NewShipping(ctx *context.Context) *Shipping {
+	return &Shipping{ctx}
+}
+
+// UploadShippingInfo 发货信息录入
+func (shipping *Shipping) UploadShippingInfo(in *UploadShippingInfoRequest) error {
+	return shipping.UploadShippingInfoContext(context2.Background(), in)
+}
+
+// UploadShippingInfoContext 发货信息录入
+func (shipping *Shipping) UploadShippingInfoContext(ctx context2.Context, in *UploadShippingInfoRequest) error {
+	return shipping.post(ctx, uploadShippingInfoURL, in, nil, "UploadShippingInfo")
+}
+
+// UploadCombinedShippingInfo 合单发货信息录入
+func (shipping *Shipping) UploadCombinedShippingInfo(in *UploadCombinedShippingInfoRequest) error {
+	return shipping.UploadCombinedShippingInfoContext(context2.Background(), in)
+}
+
+// UploadCombinedShippingInfoContext 合单发货信息录入
+func (shipping *Shipping) UploadCombinedShippingInfoContext(ctx context2.Context, in *UploadCombinedShippingInfoRequest) error {
+	return shipping.post(ctx, uploadCombinedShippingInfoURL, in, nil, "UploadCombinedShippingInfo")
+}
+
+// GetOrder 查询订单发货状态
+func (shipping *Shipping) GetOrder(in *GetOrderRequest) (*Order, error) {
+	return shipping.GetOrderContext(context2.Background(), in)
+}
+
+// GetOrderContext 查询订单发货状态
+func (shipping *Shipping) GetOrderContext(ctx context2.Context, in *GetOrderRequest) (*Order, error) {
+	var res struct {
+		util.CommonError
+		Order Order `json:"order"`
+	}
+	if err := shipping.post(ctx, getOrderURL, in, &res, "GetOrder"); err != nil {
+		return nil, err
+	}
+	return &res.Order, nil
+}
+
+// GetOrderList 查询订单列表
+func (shipping *Shipping) GetOrderList(in *GetOrderListRequest) (*GetOrderListResponse, error) {
+	return shipping.GetOrderListContext(context2.Background(), in)
+}
+
+// GetOrderListContext 查询订单列表
+func (shipping *Shipping) GetOrderListContext(ctx context2.Context, in *GetOrderListRequest) (*GetOrderListResponse, error) {
+	res := &GetOrderListResponse{}
+	if err := shipping.post(ctx, getOrderListURL, in, res, "GetOrderList"); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// NotifyConfirmReceive 确认收货提醒，物流为同城配送等无法查询轨迹时，在用户收货后调用
+func (shipping *Shipping) NotifyConfirmReceive(in *NotifyConfirmReceiveRequest) error {
+	return shipping.NotifyConfirmReceiveContext(context2.Background(), in)
+}
+
+// NotifyConfirmReceiveContext 确认收货提醒
+func (shipping *Shipping) NotifyConfirmReceiveContext(ctx context2.Context, in *NotifyConfirmReceiveRequest) error {
+	return shipping.post(ctx, notifyConfirmReceiveURL, in, nil, "NotifyConfirmReceive")
+}
+
+// SetMsgJumpPath 设置消息跳转路径，用户点击发货、确认收货等消息时跳转到小程序的该页面
+func (shipping *Shipping) SetMsgJumpPath(path string) error {
+	return shipping.SetMsgJumpPathContext(context2.Background(), path)
+}
+
+// SetMsgJumpPathContext 设置消息跳转路径
+func (shipping *Shipping) SetMsgJumpPathContext(ctx context2.Context, path string) error {
+	req := map[string]string{"path": path}
+	return shipping.post(ctx, setMsgJumpPathURL, req, nil, "SetMsgJumpPath")
+}
+
+// IsTradeManaged 查询小程序是否已开通发货信息管理服务
+func (shipping *Shipping) IsTradeManaged(appID string) (bool, error) {
+	return shipping.IsTradeManagedContext(context2.Background(), appID)
+}
+
+// IsTradeManagedContext 查询小程序是否已开通发货信息管理服务
+func (shipping *Shipping) IsTradeManagedContext(ctx context2.Context, appID string) (bool, error) {
+	var res struct {
+		util.CommonError
+		IsTradeManaged bool `json:"is_trade_managed"`
+	}
+	req := map[string]string{"appid": appID}
+	if err := shipping.post(ctx, isTradeManagedURL, req, &res, "IsTradeManaged"); err != nil {
+		return false, err
+	}
+	return res.IsTradeManaged, nil
+}
+
+// post 请求接口，res 为 nil 时只解析错误码
+func (shipping *Shipping) post(ctx context2.Context, urlTpl string, req, res interface{}, apiName string) error {
+	accessToken, err := shipping.GetAccessTokenContext(ctx)
+	if err != nil {
+		return err
+	}
+	response, err := shipping.PostJSONContext(ctx, fmt.Sprintf(urlTpl, accessToken), req)
+	if err != nil {
+		return err
+	}
+	if res == nil {
+		return util.DecodeWithCommonError(response, apiName)
+	}
+	return util.DecodeWithError(response, res, apiName)
+}
@@ -0,0 +1,87 @@
+package shipping
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+
+	"github.com/silenceper/wechat/v2/miniprogram/config"
+	"github.com/silenceper/wechat/v2/miniprogram/context"
+	"github.com/silenceper/wechat/v2/util"
+)
+
+type stubAccessToken struct{}
+
+func (stubAccessToken) GetAccessToken() (string, error) {
+	return "mock-access-token", nil
+}
+
+func newTestShipping() *Shipping {
+	return NewShipping(&context.Context{Config: &config.Config{AppID: "appid"}, AccessTokenHandle: stubAccessToken{}})
+}
+
+func TestUploadShippingInfo(t *testing.T) {
+	defer gock.Off()
+	gock.New("https://api.weixin.qq.com").
+		Post("/wxa/sec/order/upload_shipping_info").
+		MatchParam("access_token", "mock-access-token").
+		BodyString(`"order_key":\{"order_number_type":2,"transaction_id":"4200001"\}.*"logistics_type":1.*"tracking_no":"SF001".*"payer":\{"openid":"openid"\}`).
+		Reply(200).
+		JSON(map[string]interface{}{"errcode": 0, "errmsg": "ok"})
+	gock.New("https://api.weixin.qq.com").
+		Post("/wxa/sec/order/upload_shipping_info").
+		Reply(200).
+		JSON(map[string]interface{}{"errcode": 10060001, "errmsg": "支付单不存在"})
+
+	req := &UploadShippingInfoRequest{
+		OrderKey:      OrderKey{OrderNumberType: OrderNumberTypeTransaction, TransactionID: "4200001"},
+		LogisticsType: LogisticsTypeExpress,
+		DeliveryMode:  DeliveryModeUnified,
+		ShippingList:  []ShippingItem{{TrackingNo: "SF001", ExpressCompany: "SF", ItemDesc: "T恤"}},
+		UploadTime:    "2023-06-01T10:00:00+08:00",
+		Payer:         Payer{OpenID: "openid"},
+	}
+	shipping := newTestShipping()
+	assert.Nil(t, shipping.UploadShippingInfo(req))
+
+	err := shipping.UploadShippingInfo(req)
+	code, ok := util.ErrCodeOf(err)
+	assert.True(t, ok)
+	assert.Equal(t, int64(10060001), code)
+	assert.True(t, gock.IsDone())
+}
+
+func TestGetOrderList(t *testing.T) {
+	defer gock.Off()
+	gock.New("https://api.weixin.qq.com").
+		Post("/wxa/sec/order/get_order_list").
+		BodyString(`"order_state":1.*"page_size":10`).
+		Reply(200).
+		BodyString(`{"errcode":0,"errmsg":"ok","last_index":"idx","has_more":true,"order_list":[{"transaction_id":"4200001","merchant_trade_no":"order_1","paid_amount":100,"order_state":1,"shipping":{"delivery_mode":1,"logistics_type":1,"shipping_list":[{"tracking_no":"SF001","upload_time":1685584800}]}}]}`)
+
+	res, err := newTestShipping().GetOrderList(&GetOrderListRequest{OrderState: OrderStateWaitShipping, PageSize: 10})
+	assert.Nil(t, err)
+	assert.Equal(t, "idx", res.LastIndex)
+	assert.True(t, res.HasMore)
+	if assert.Len(t, res.OrderList, 1) {
+		order := res.OrderList[0]
+		assert.Equal(t, "order_1", order.MerchantTradeNo)
+		assert.Equal(t, int64(100), order.PaidAmount)
+		assert.Equal(t, OrderStateWaitShipping, order.OrderState)
+		assert.Equal(t, "SF001", order.Shipping.ShippingList[0].TrackingNo)
+	}
+}
+
+func TestIsTradeManaged(t *testing.T) {
+	defer gock.Off()
+	gock.New("https://api.weixin.qq.com").
+		Post("/wxa/sec/order/is_trade_managed").
+		BodyString(`\{"appid":"appid"\}`).
+		Reply(200).
+		JSON(map[string]interface{}{"errcode": 0, "errmsg": "ok", "is_trade_managed": true})
+
+	managed, err := newTestShipping().IsTradeManaged("appid")
+	assert.Nil(t, err)
+	assert.True(t, managed)
+}
@@ -0,0 +1,182 @@
+package shipping
+
+import "github.com/silenceper/wechat/v2/util"
+
+// OrderNumberType 订单单号类型
+type OrderNumberType int
+
+const (
+	// OrderNumberTypeMerchant 使用下单商户号和商户侧单号
+	OrderNumberTypeMerchant OrderNumberType = 1
+	// OrderNumberTypeTransaction 使用微信支付单号
+	OrderNumberTypeTransaction OrderNumberType = 2
+)
+
+// LogisticsType 物流模式
+type LogisticsType int
+
+const (
+	// LogisticsTypeExpress 实体物流配送，采用快递公司进行实体物流配送形式
+	LogisticsTypeExpress LogisticsType = 1
+	// LogisticsTypeLocalDelivery 同城配送
+	LogisticsTypeLocalDelivery LogisticsType = 2
+	// LogisticsTypeVirtual 虚拟商品，如话费充值、点卡等，无实体配送形式
+	LogisticsTypeVirtual LogisticsType = 3
+	// LogisticsTypeSelfPickup 用户自提
+	LogisticsTypeSelfPickup LogisticsType = 4
+)
+
+// DeliveryMode 发货模式
+type DeliveryMode int
+
+const (
+	// DeliveryModeUnified 统一发货
+	DeliveryModeUnified DeliveryMode = 1
+	// DeliveryModeSplit 分拆发货
+	DeliveryModeSplit DeliveryMode = 2
+)
+
+// OrderState 订单状态
+type OrderState int
+
+const (
+	// OrderStateWaitShipping 待发货
+	OrderStateWaitShipping OrderState = 1
+	// OrderStateShipped 已发货
+	OrderStateShipped OrderState = 2
+	// OrderStateConfirmed 确认收货
+	OrderStateConfirmed OrderState = 3
+	// OrderStateCompleted 交易完成
+	OrderStateCompleted OrderState = 4
+	// OrderStateRefunded 已退款
+	OrderStateRefunded OrderState = 5
+	// OrderStateWaitSettlement 资金待结算
+	OrderStateWaitSettlement OrderState = 6
+)
+
+// OrderKey 订单，通过微信支付单号或商户号和商户侧单号确定
+type OrderKey struct {
+	OrderNumberType OrderNumberType `json:"order_number_type"`
+	TransactionID   string          `json:"transaction_id,omitempty"` // 微信支付单号
+	MchID           string          `json:"mchid,omitempty"`          // 支付下单商户的商户号
+	OutTradeNo      string          `json:"out_trade_no,omitempty"`   // 商户系统内部订单号
+}
+
+// Contact 联系方式，顺丰速运需填写，手机号需掩码处理，如 189****1234
+type Contact struct {
+	ConsignorContact string `json:"consignor_contact,omitempty"` // 寄件人联系方式
+	ReceiverContact  string `json:"receiver_contact,omitempty"`  // 收件人联系方式
+}
+
+// ShippingItem 物流信息
+type ShippingItem struct {
+	TrackingNo     string   `json:"tracking_no,omitempty"`     // 物流单号，物流快递发货时必填
+	ExpressCompany string   `json:"express_company,omitempty"` // 物流公司编码，物流快递发货时必填
+	ItemDesc       string   `json:"item_desc"`                 // 商品信息，最多 120 个字
+	Contact        *Contact `json:"contact,omitempty"`
+}
+
+// Payer 支付者信息
+type Payer struct {
+	OpenID string `json:"openid"`
+}
+
+// UploadShippingInfoRequest 发货信息录入请求
+type UploadShippingInfoRequest struct {
+	OrderKey       OrderKey       `json:"order_key"`
+	LogisticsType  LogisticsType  `json:"logistics_type"`
+	DeliveryMode   DeliveryMode   `json:"delivery_mode"`
+	IsAllDelivered bool           `json:"is_all_delivered,omitempty"` // 分拆发货模式时必填，是否已全部发货
+	ShippingList   []ShippingItem `json:"shipping_list"`              // 统一发货模式下只能有一条，分拆发货模式下最多 10 条
+	UploadTime     string         `json:"upload_time"`                // 上传时间，RFC 3339 格式，如 2022-12-15T13:29:35.120+08:00
+	Payer          Payer          `json:"payer"`
+}
+
+// SubOrder 合单中的子单
+type SubOrder struct {
+	OrderKey       OrderKey       `json:"order_key"`
+	DeliveryMode   DeliveryMode   `json:"delivery_mode"`
+	LogisticsType  LogisticsType  `json:"logistics_type"`
+	IsAllDelivered bool           `json:"is_all_delivered,omitempty"`
+	ShippingList   []ShippingItem `json:"shipping_list"`
+}
+
+// UploadCombinedShippingInfoRequest 合单发货信息录入请求
+type UploadCombinedShippingInfoRequest struct {
+	OrderKey   OrderKey   `json:"order_key"` // 合单订单，使用合单支付单号或合单商户号和商户侧合单单号
+	SubOrders  []SubOrder `json:"sub_orders"`
+	UploadTime string     `json:"upload_time"`
+	Payer      Payer      `json:"payer"`
+}
+
+// GetOrderRequest 查询订单发货状态请求，使用微信支付单号或商户号和商户侧单号
+type GetOrderRequest struct {
+	TransactionID   string `json:"transaction_id,omitempty"`
+	MerchantID      string `json:"merchant_id,omitempty"`
+	SubMerchantID   string `json:"sub_merchant_id,omitempty"`
+	MerchantTradeNo string `json:"merchant_trade_no,omitempty"`
+}
+
+// ShippingDetail 订单的发货信息
+type ShippingDetail struct {
+	DeliveryMode        DeliveryMode  `json:"delivery_mode"`
+	LogisticsType       LogisticsType `json:"logistics_type"`
+	FinishShipping      bool          `json:"finish_shipping"`       // 是否已完成全部发货
+	GoodsDesc           string        `json:"goods_desc"`            // 在小程序后台发货信息录入的商品描述
+	FinishShippingCount int           `json:"finish_shipping_count"` // 已完成全部发货的次数，未完成时为 0
+	ShippingList        []struct {
+		TrackingNo     string  `json:"tracking_no"`
+		ExpressCompany string  `json:"express_company"`
+		GoodsDesc      string  `json:"goods_desc"`
+		UploadTime     int64   `json:"upload_time"` // 秒级时间戳
+		Contact        Contact `json:"contact"`
+	} `json:"shipping_list"`
+}
+
+// Order 订单发货状态
+type Order struct {
+	TransactionID   string         `json:"transaction_id"`
+	MerchantID      string         `json:"merchant_id"`
+	SubMerchantID   string         `json:"sub_merchant_id"`
+	MerchantTradeNo string         `json:"merchant_trade_no"`
+	Description     string         `json:"description"`
+	PaidAmount      int64          `json:"paid_amount"` // 支付金额，单位为分
+	OpenID          string         `json:"openid"`
+	TradeCreateTime int64          `json:"trade_create_time"`
+	PayTime         int64          `json:"pay_time"`
+	OrderState      OrderState     `json:"order_state"`
+	InComplaint     bool           `json:"in_complaint"` // 是否处在交易纠纷中
+	Shipping        ShippingDetail `json:"shipping"`
+}
+
+// PayTimeRange 支付时间范围，秒级时间戳
+type PayTimeRange struct {
+	BeginTime int64 `json:"begin_time,omitempty"`
+	EndTime   int64 `json:"end_time,omitempty"`
+}
+
+// GetOrderListRequest 查询订单列表请求
+type GetOrderListRequest struct {
+	PayTimeRange *PayTimeRange `json:"pay_time_range,omitempty"`
+	OrderState   OrderState    `json:"order_state,omitempty"`
+	OpenID       string        `json:"openid,omitempty"`
+	LastIndex    string        `json:"last_index,omitempty"` // 翻页时使用，为上次返回的 last_index
+	PageSize     int           `json:"page_size,omitempty"`  // 每页数量，最多 100 条
+}
+
+// GetOrderListResponse 查询订单列表返回
+type GetOrderListResponse struct {
+	util.CommonError
+	LastIndex string  `json:"last_index"`
+	HasMore   bool    `json:"has_more"`
+	OrderList []Order `json:"order_list"`
+}
+
+// NotifyConfirmReceiveRequest 确认收货提醒请求
+type NotifyConfirmReceiveRequest struct {
+	TransactionID   string `json:"transaction_id,omitempty"`
+	MerchantID      string `json:"merchant_id,omitempty"`
+	SubMerchantID   string `json:"sub_merchant_id,omitempty"`
+	MerchantTradeNo string `json:"merchant_trade_no,omitempty"`
+	ReceivedTime    int64  `json:"received_time"` // 快递签收时间，秒级时间戳
+}
@@ -52,7 +52,7 @@ func (shortLink *ShortLink) generate(shortLinkParams ShortLinker) (string, error
 	}
 
 	urlStr := fmt.Sprintf(generateShortLinkURL, accessToken)
-	response, err := util.PostJSON(urlStr, shortLinkParams)
+	response, err := shortLink.PostJSON(urlStr, shortLinkParams)
 	if err != nil {
 		return "", err
 	}
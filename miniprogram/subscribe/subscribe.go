@@ -78,7 +78,7 @@ func (s *Subscribe) Send(msg *Message) (err error) {
 		return
 	}
 	uri := fmt.Sprintf("%s?access_token=%s", subscribeSendURL, accessToken)
-	response, err := util.PostJSON(uri, msg)
+	response, err := s.PostJSON(uri, msg)
 	if err != nil {
 		return
 	}
@@ -93,7 +93,7 @@ func (s *Subscribe) ListTemplates() (*TemplateList, error) {
 		return nil, err
 	}
 	uri := fmt.Sprintf("%s?access_token=%s", getTemplateURL, accessToken)
-	response, err := util.HTTPGet(uri)
+	response, err := s.HTTPGet(uri)
 	if err != nil {
 		return nil, err
 	}
@@ -135,7 +135,7 @@ func (s *Subscribe) UniformSend(msg *UniformMessage) (err error) {
 		return
 	}
 	uri := fmt.Sprintf("%s?access_token=%s", uniformMessageSend, accessToken)
-	response, err := util.PostJSON(uri, msg)
+	response, err := s.PostJSON(uri, msg)
 	if err != nil {
 		return
 	}
@@ -162,7 +162,7 @@ func (s *Subscribe) Add(ShortID string, kidList []int, sceneDesc string) (templa
 	}{TemplateIDShort: ShortID, SceneDesc: sceneDesc, KidList: kidList}
 	uri := fmt.Sprintf("%s?access_token=%s", addTemplateURL, accessToken)
 	var response []byte
-	response, err = util.PostJSON(uri, msg)
+	response, err = s.PostJSON(uri, msg)
 	if err != nil {
 		return
 	}
@@ -187,7 +187,7 @@ func (s *Subscribe) Delete(templateID string) (err error) {
 	}{TemplateID: templateID}
 	uri := fmt.Sprintf("%s?access_token=%s", delTemplateURL, accessToken)
 	var response []byte
-	response, err = util.PostJSON(uri, msg)
+	response, err = s.PostJSON(uri, msg)
 	if err != nil {
 		return
 	}
@@ -26,7 +26,7 @@ func (tcb *Tcb) InvokeCloudFunction(env, name, args string) (*InvokeCloudFunctio
 		return nil, err
 	}
 	uri := fmt.Sprintf("%s?access_token=%s&env=%s&name=%s", invokeCloudFunctionURL, accessToken, env, name)
-	response, err := util.HTTPPost(uri, args)
+	response, err := tcb.HTTPPost(uri, args)
 	if err != nil {
 		return nil, err
 	}
@@ -200,7 +200,7 @@ func (tcb *Tcb) DatabaseMigrateImport(req *DatabaseMigrateImportReq) (*DatabaseM
 		return nil, err
 	}
 	uri := fmt.Sprintf("%s?access_token=%s", databaseMigrateImportURL, accessToken)
-	response, err := util.PostJSON(uri, req)
+	response, err := tcb.PostJSON(uri, req)
 	if err != nil {
 		return nil, err
 	}
@@ -218,7 +218,7 @@ func (tcb *Tcb) DatabaseMigrateExport(req *DatabaseMigrateExportReq) (*DatabaseM
 		return nil, err
 	}
 	uri := fmt.Sprintf("%s?access_token=%s", databaseMigrateExportURL, accessToken)
-	response, err := util.PostJSON(uri, req)
+	response, err := tcb.PostJSON(uri, req)
 	if err != nil {
 		return nil, err
 	}
@@ -236,7 +236,7 @@ func (tcb *Tcb) DatabaseMigrateQueryInfo(env string, jobID int64) (*DatabaseMigr
 		return nil, err
 	}
 	uri := fmt.Sprintf("%s?access_token=%s", databaseMigrateQueryInfoURL, accessToken)
-	response, err := util.PostJSON(uri, map[string]interface{}{
+	response, err := tcb.PostJSON(uri, map[string]interface{}{
 		"env":    env,
 		"job_id": jobID,
 	})
@@ -256,7 +256,7 @@ func (tcb *Tcb) UpdateIndex(req *UpdateIndexReq) error {
 		return err
 	}
 	uri := fmt.Sprintf("%s?access_token=%s", updateIndexURL, accessToken)
-	response, err := util.PostJSON(uri, req)
+	response, err := tcb.PostJSON(uri, req)
 	if err != nil {
 		return err
 	}
@@ -272,7 +272,7 @@ func (tcb *Tcb) DatabaseCollectionAdd(env, collectionName string) error {
 		return err
 	}
 	uri := fmt.Sprintf("%s?access_token=%s", databaseCollectionAddURL, accessToken)
-	response, err := util.PostJSON(uri, &DatabaseCollectionReq{
+	response, err := tcb.PostJSON(uri, &DatabaseCollectionReq{
 		Env:            env,
 		CollectionName: collectionName,
 	})
@@ -291,7 +291,7 @@ func (tcb *Tcb) DatabaseCollectionDelete(env, collectionName string) error {
 		return err
 	}
 	uri := fmt.Sprintf("%s?access_token=%s", databaseCollectionDeleteURL, accessToken)
-	response, err := util.PostJSON(uri, &DatabaseCollectionReq{
+	response, err := tcb.PostJSON(uri, &DatabaseCollectionReq{
 		Env:            env,
 		CollectionName: collectionName,
 	})
@@ -310,7 +310,7 @@ func (tcb *Tcb) DatabaseCollectionGet(env string, limit, offset int64) (*Databas
 		return nil, err
 	}
 	uri := fmt.Sprintf("%s?access_token=%s", databaseCollectionGetURL, accessToken)
-	response, err := util.PostJSON(uri, &DatabaseCollectionGetReq{
+	response, err := tcb.PostJSON(uri, &DatabaseCollectionGetReq{
 		Env:    env,
 		Limit:  limit,
 		Offset: offset,
@@ -332,7 +332,7 @@ func (tcb *Tcb) DatabaseAdd(env, query string) (*DatabaseAddRes, error) {
 		return nil, err
 	}
 	uri := fmt.Sprintf("%s?access_token=%s", databaseAddURL, accessToken)
-	response, err := util.PostJSON(uri, &DatabaseReq{
+	response, err := tcb.PostJSON(uri, &DatabaseReq{
 		Env:   env,
 		Query: query,
 	})
@@ -353,7 +353,7 @@ func (tcb *Tcb) DatabaseDelete(env, query string) (*DatabaseDeleteRes, error) {
 		return nil, err
 	}
 	uri := fmt.Sprintf("%s?access_token=%s", databaseDeleteURL, accessToken)
-	response, err := util.PostJSON(uri, &DatabaseReq{
+	response, err := tcb.PostJSON(uri, &DatabaseReq{
 		Env:   env,
 		Query: query,
 	})
@@ -374,7 +374,7 @@ func (tcb *Tcb) DatabaseUpdate(env, query string) (*DatabaseUpdateRes, error) {
 		return nil, err
 	}
 	uri := fmt.Sprintf("%s?access_token=%s", databaseUpdateURL, accessToken)
-	response, err := util.PostJSON(uri, &DatabaseReq{
+	response, err := tcb.PostJSON(uri, &DatabaseReq{
 		Env:   env,
 		Query: query,
 	})
@@ -395,7 +395,7 @@ func (tcb *Tcb) DatabaseQuery(env, query string) (*DatabaseQueryRes, error) {
 		return nil, err
 	}
 	uri := fmt.Sprintf("%s?access_token=%s", databaseQueryURL, accessToken)
-	response, err := util.PostJSON(uri, &DatabaseReq{
+	response, err := tcb.PostJSON(uri, &DatabaseReq{
 		Env:   env,
 		Query: query,
 	})
@@ -416,7 +416,7 @@ func (tcb *Tcb) DatabaseCount(env, query string) (*DatabaseCountRes, error) {
 		return nil, err
 	}
 	uri := fmt.Sprintf("%s?access_token=%s", databaseCountURL, accessToken)
-	response, err := util.PostJSON(uri, &DatabaseReq{
+	response, err := tcb.PostJSON(uri, &DatabaseReq{
 		Env:   env,
 		Query: query,
 	})
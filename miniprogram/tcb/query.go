@@ -0,0 +1,399 @@
+package tcb
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Expr 数据库操作语句中的表达式，如查询条件、查询指令及更新指令
+type Expr interface {
+	render() (string, error)
+}
+
+// Cond 查询条件或更新内容，key 为字段名（可使用 "a.b" 表示嵌套字段），value 为值或 Expr，
+// 值按 JSON 编码并转义，time.Time 编码为 new Date(毫秒时间戳)
+//
+// Expr 及 time.Time 只能直接作为 Cond、map、切片的元素或指令的参数，结构体按 JSON 编码，其字段中的 Expr 及 time.Time 会返回错误
+type Cond map[string]interface{}
+
+func (c Cond) render() (string, error) {
+	return renderMap(reflect.ValueOf(map[string]interface{}(c)))
+}
+
+// command 数据库指令，渲染为 db.command.<name>(args...)
+type command struct {
+	name string
+	args []interface{}
+}
+
+func (c command) render() (string, error) {
+	args := make([]string, 0, len(c.args))
+	for _, arg := range c.args {
+		s, err := renderValue(arg)
+		if err != nil {
+			return "", err
+		}
+		args = append(args, s)
+	}
+	return fmt.Sprintf("db.command.%s(%s)", c.name, strings.Join(args, ", ")), nil
+}
+
+// Eq 等于
+func Eq(v interface{}) Expr { return command{"eq", []interface{}{v}} }
+
+// Neq 不等于
+func Neq(v interface{}) Expr { return command{"neq", []interface{}{v}} }
+
+// Gt 大于
+func Gt(v interface{}) Expr { return command{"gt", []interface{}{v}} }
+
+// Gte 大于等于
+func Gte(v interface{}) Expr { return command{"gte", []interface{}{v}} }
+
+// Lt 小于
+func Lt(v interface{}) Expr { return command{"lt", []interface{}{v}} }
+
+// Lte 小于等于
+func Lte(v interface{}) Expr { return command{"lte", []interface{}{v}} }
+
+// In 在数组中
+func In(values ...interface{}) Expr { return command{"in", []interface{}{values}} }
+
+// Nin 不在数组中
+func Nin(values ...interface{}) Expr { return command{"nin", []interface{}{values}} }
+
+// Exists 字段是否存在
+func Exists(exists bool) Expr { return command{"exists", []interface{}{exists}} }
+
+// And 逻辑与，可用于字段的多个指令或多个查询条件
+func And(exprs ...Expr) Expr { return command{"and", exprArgs(exprs)} }
+
+// Or 逻辑或，可用于字段的多个指令或多个查询条件
+func Or(exprs ...Expr) Expr { return command{"or", exprArgs(exprs)} }
+
+// Not 逻辑非
+func Not(expr Expr) Expr { return command{"not", []interface{}{expr}} }
+
+// Set 更新指令，将字段设置为指定值（对象不与原值合并）
+func Set(v interface{}) Expr { return command{"set", []interface{}{v}} }
+
+// Remove 更新指令，删除字段
+func Remove() Expr { return command{"remove", nil} }
+
+// Inc 更新指令，字段自增
+func Inc(n interface{}) Expr { return command{"inc", []interface{}{n}} }
+
+// Mul 更新指令，字段自乘
+func Mul(n interface{}) Expr { return command{"mul", []interface{}{n}} }
+
+// Push 更新指令，数组尾部添加元素
+func Push(values ...interface{}) Expr { return command{"push", []interface{}{values}} }
+
+// Pop 更新指令，删除数组尾部元素
+func Pop() Expr { return command{"pop", nil} }
+
+// Unshift 更新指令，数组头部添加元素
+func Unshift(values ...interface{}) Expr { return command{"unshift", []interface{}{values}} }
+
+// Shift 更新指令，删除数组头部元素
+func Shift() Expr { return command{"shift", nil} }
+
+func exprArgs(exprs []Expr) []interface{} {
+	args := make([]interface{}, 0, len(exprs))
+	for _, expr := range exprs {
+		args = append(args, expr)
+	}
+	return args
+}
+
+// OrderDirection 排序方向
+type OrderDirection string
+
+const (
+	// OrderAsc 升序
+	OrderAsc OrderDirection = "asc"
+	// OrderDesc 降序
+	OrderDesc OrderDirection = "desc"
+)
+
+type order struct {
+	field     string
+	direction OrderDirection
+}
+
+// Query 数据库操作语句构造器，生成 DatabaseQuery、DatabaseUpdate 等方法的 query 参数，如
+//
+//	query, err := tcb.Collection("books").Where(tcb.Cond{"price": tcb.Gt(10)}).OrderBy("price", tcb.OrderDesc).Limit(10).Get()
+type Query struct {
+	collection string
+	docID      string
+	where      Expr
+	orders     []order
+	skip       int
+	limit      int
+	fields     []string
+}
+
+// Collection 指定集合
+func Collection(name string) *Query {
+	return &Query{collection: name}
+}
+
+// Doc 指定记录 _id
+func (q *Query) Doc(id string) *Query {
+	q.docID = id
+	return q
+}
+
+// Where 查询条件，通常为 Cond，也可为 And、Or 组合的多个 Cond
+func (q *Query) Where(cond Expr) *Query {
+	q.where = cond
+	return q
+}
+
+// OrderBy 排序，可多次调用按多个字段排序
+func (q *Query) OrderBy(field string, direction OrderDirection) *Query {
+	q.orders = append(q.orders, order{field: field, direction: direction})
+	return q
+}
+
+// Skip 跳过的记录数
+func (q *Query) Skip(n int) *Query {
+	q.skip = n
+	return q
+}
+
+// Limit 返回的最大记录数，HTTP API 默认为 10，最大为 1000
+func (q *Query) Limit(n int) *Query {
+	q.limit = n
+	return q
+}
+
+// Field 只返回指定字段
+func (q *Query) Field(fields ...string) *Query {
+	q.fields = append(q.fields, fields...)
+	return q
+}
+
+// Get 查询语句，用于 DatabaseQuery
+func (q *Query) Get() (string, error) {
+	return q.build("get()", true)
+}
+
+// Count 统计语句，用于 DatabaseCount
+func (q *Query) Count() (string, error) {
+	return q.build("count()", false)
+}
+
+// Remove 删除语句，用于 DatabaseDelete
+func (q *Query) Remove() (string, error) {
+	return q.build("remove()", false)
+}
+
+// Update 更新语句，用于 DatabaseUpdate，data 的值可为 Inc、Set 等更新指令
+func (q *Query) Update(data Cond) (string, error) {
+	s, err := renderValue(data)
+	if err != nil {
+		return "", err
+	}
+	return q.build(fmt.Sprintf("update({data: %s})", s), false)
+}
+
+// Add 插入语句，用于 DatabaseAdd
+func (q *Query) Add(docs ...interface{}) (string, error) {
+	if len(docs) == 0 {
+		return "", errors.New("tcb: no document to add")
+	}
+	s, err := renderValue(docs)
+	if err != nil {
+		return "", err
+	}
+	return q.build(fmt.Sprintf("add({data: %s})", s), false)
+}
+
+func (q *Query) build(action string, read bool) (string, error) {
+	name, err := renderValue(q.collection)
+	if err != nil {
+		return "", err
+	}
+	var b strings.Builder
+	b.WriteString("db.collection(" + name + ")")
+	if q.docID != "" {
+		id, _ := renderValue(q.docID)
+		b.WriteString(".doc(" + id + ")")
+	}
+	if q.where != nil {
+		s, err := q.where.render()
+		if err != nil {
+			return "", err
+		}
+		b.WriteString(".where(" + s + ")")
+	}
+	if read {
+		for _, o := range q.orders {
+			field, _ := renderValue(o.field)
+			direction, _ := renderValue(string(o.direction))
+			b.WriteString(".orderBy(" + field + ", " + direction + ")")
+		}
+		if q.skip > 0 {
+			b.WriteString(".skip(" + strconv.Itoa(q.skip) + ")")
+		}
+		if q.limit > 0 {
+			b.WriteString(".limit(" + strconv.Itoa(q.limit) + ")")
+		}
+		if len(q.fields) > 0 {
+			projection := make(map[string]interface{}, len(q.fields))
+			for _, field := range q.fields {
+				projection[field] = true
+			}
+			s, _ := renderValue(projection)
+			b.WriteString(".field(" + s + ")")
+		}
+	}
+	b.WriteString("." + action)
+	return b.String(), nil
+}
+
+// renderValue 将值渲染为语句中的字面量，Expr 原样渲染，其余值按 JSON 编码，字符串中的引号等字符会被转义
+func renderValue(v interface{}) (string, error) {
+	switch value := v.(type) {
+	case Expr:
+		return value.render()
+	case time.Time:
+		return fmt.Sprintf("new Date(%d)", value.UnixNano()/int64(time.Millisecond)), nil
+	case *time.Time:
+		if value != nil {
+			return renderValue(*value)
+		}
+	case json.Marshaler:
+		return marshal(value)
+	}
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Ptr:
+		if !rv.IsNil() {
+			return renderValue(rv.Elem().Interface())
+		}
+	case reflect.Slice:
+		if rv.IsNil() || rv.Type().Elem().Kind() == reflect.Uint8 {
+			return marshal(v)
+		}
+		fallthrough
+	case reflect.Array:
+		items := make([]string, 0, rv.Len())
+		for i := 0; i < rv.Len(); i++ {
+			s, err := renderValue(rv.Index(i).Interface())
+			if err != nil {
+				return "", err
+			}
+			items = append(items, s)
+		}
+		return "[" + strings.Join(items, ", ") + "]", nil
+	case reflect.Map:
+		if rv.Type().Key().Kind() == reflect.String && !rv.IsNil() {
+			return renderMap(rv)
+		}
+	case reflect.Struct:
+		if err := checkStructField(rv, rv.Type().Name(), map[uintptr]bool{}); err != nil {
+			return "", err
+		}
+	}
+	return marshal(v)
+}
+
+var (
+	exprType      = reflect.TypeOf((*Expr)(nil)).Elem()
+	timeType      = reflect.TypeOf(time.Time{})
+	marshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+)
+
+// checkStructField 结构体按 JSON 编码，字段中的 Expr 会被编码为 {}、time.Time 会被编码为字符串，此时返回错误
+func checkStructField(rv reflect.Value, path string, seen map[uintptr]bool) error {
+	if rv.Kind() == reflect.Interface {
+		rv = rv.Elem()
+	}
+	if !rv.IsValid() {
+		return nil
+	}
+	switch t := rv.Type(); {
+	case t.Implements(exprType):
+		return fmt.Errorf("tcb: Expr in struct field %s is not supported, use Cond or map instead", path)
+	case t == timeType:
+		return fmt.Errorf("tcb: time.Time in struct field %s is not supported, use Cond or map instead", path)
+	case t.Implements(marshalerType):
+		return nil
+	}
+	switch rv.Kind() {
+	case reflect.Ptr:
+		if rv.IsNil() || seen[rv.Pointer()] {
+			return nil
+		}
+		seen[rv.Pointer()] = true
+		return checkStructField(rv.Elem(), path, seen)
+	case reflect.Struct:
+		for i := 0; i < rv.NumField(); i++ {
+			field := rv.Type().Field(i)
+			if field.PkgPath != "" && !field.Anonymous {
+				continue
+			}
+			if err := checkStructField(rv.Field(i), path+"."+field.Name, seen); err != nil {
+				return err
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < rv.Len(); i++ {
+			if err := checkStructField(rv.Index(i), fmt.Sprintf("%s[%d]", path, i), seen); err != nil {
+				return err
+			}
+		}
+	case reflect.Map:
+		iter := rv.MapRange()
+		for iter.Next() {
+			if err := checkStructField(iter.Value(), fmt.Sprintf("%s[%v]", path, iter.Key()), seen); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// renderMap 按 key 排序渲染对象，保证生成的语句稳定
+func renderMap(rv reflect.Value) (string, error) {
+	keys := make([]string, 0, rv.Len())
+	for _, key := range rv.MapKeys() {
+		keys = append(keys, key.String())
+	}
+	sort.Strings(keys)
+	items := make([]string, 0, len(keys))
+	for _, key := range keys {
+		k, err := marshal(key)
+		if err != nil {
+			return "", err
+		}
+		s, err := renderValue(rv.MapIndex(reflect.ValueOf(key).Convert(rv.Type().Key())).Interface())
+		if err != nil {
+			return "", err
+		}
+		items = append(items, k+": "+s)
+	}
+	return "{" + strings.Join(items, ", ") + "}", nil
+}
+
+func marshal(v interface{}) (string, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return "", fmt.Errorf("tcb: encode value %v failed: %w", v, err)
+	}
+	return string(b), nil
+}
+
+// Decode 将查询结果解析到 v，v 为结构体切片的指针，如 *[]Book
+func (res *DatabaseQueryRes) Decode(v interface{}) error {
+	return json.Unmarshal([]byte("["+strings.Join(res.Data, ",")+"]"), v)
+}
@@ -0,0 +1,107 @@
+package tcb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQuery(t *testing.T) {
+	tests := []struct {
+		name  string
+		build func() (string, error)
+		want  string
+	}{
+		{
+			name: "get",
+			build: Collection("books").
+				Where(Cond{"author": "Lu Xun", "price": And(Gte(10), Lt(50.5))}).
+				OrderBy("price", OrderDesc).
+				Skip(20).
+				Limit(10).
+				Field("title", "price").
+				Get,
+			want: `db.collection("books").where({"author": "Lu Xun", "price": db.command.and(db.command.gte(10), db.command.lt(50.5))}).orderBy("price", "desc").skip(20).limit(10).field({"price": true, "title": true}).get()`,
+		},
+		{
+			name:  "or",
+			build: Collection("books").Where(Or(Cond{"tags": In("go", "wechat")}, Cond{"meta.deleted": Exists(false)})).Count,
+			want:  `db.collection("books").where(db.command.or({"tags": db.command.in(["go", "wechat"])}, {"meta.deleted": db.command.exists(false)})).count()`,
+		},
+		{
+			name: "update",
+			build: func() (string, error) {
+				return Collection("books").Doc("id-1").Update(Cond{"sales": Inc(1), "tags": Push("new"), "draft": Remove(), "meta": Set(map[string]interface{}{"v": 2})})
+			},
+			want: `db.collection("books").doc("id-1").update({data: {"draft": db.command.remove(), "meta": db.command.set({"v": 2}), "sales": db.command.inc(1), "tags": db.command.push(["new"])}})`,
+		},
+		{
+			name:  "remove",
+			build: Collection("books").Where(Cond{"created_at": Lt(time.Unix(1700000000, 0))}).Remove,
+			want:  `db.collection("books").where({"created_at": db.command.lt(new Date(1700000000000))}).remove()`,
+		},
+		{
+			name: "add",
+			build: func() (string, error) {
+				type book struct {
+					Title string `json:"title"`
+				}
+				return Collection("books").Add(book{Title: "a"}, book{Title: "b"})
+			},
+			want: `db.collection("books").add({data: [{"title":"a"}, {"title":"b"}]})`,
+		},
+		{
+			name:  "escape",
+			build: Collection("books").Where(Cond{"title": `"}).remove();//`}).Get,
+			want:  `db.collection("books").where({"title": "\"}).remove();//"}).get()`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.build()
+			assert.Nil(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestQueryNestedInStruct(t *testing.T) {
+	type meta struct {
+		CreatedAt time.Time `json:"created_at"`
+	}
+	type book struct {
+		Title string      `json:"title"`
+		Sales interface{} `json:"sales"`
+		Meta  *meta       `json:"meta,omitempty"`
+	}
+	created := time.Unix(1700000000, 0)
+
+	// 结构体中的 Expr 及 time.Time 无法渲染，返回错误
+	_, err := Collection("books").Add(book{Title: "a", Sales: Inc(1)})
+	assert.EqualError(t, err, "tcb: Expr in struct field book.Sales is not supported, use Cond or map instead")
+	_, err = Collection("books").Doc("id-1").Update(Cond{"book": Set(&book{Title: "a", Meta: &meta{CreatedAt: created}})})
+	assert.EqualError(t, err, "tcb: time.Time in struct field book.Meta.CreatedAt is not supported, use Cond or map instead")
+
+	// 直接作为 Cond 的值时正常渲染
+	got, err := Collection("books").Doc("id-1").Update(Cond{"title": "a", "meta.created_at": &created})
+	assert.Nil(t, err)
+	assert.Equal(t, `db.collection("books").doc("id-1").update({data: {"meta.created_at": new Date(1700000000000), "title": "a"}})`, got)
+	got, err = Collection("books").Add(&book{Title: "a", Sales: 1})
+	assert.Nil(t, err)
+	assert.Equal(t, `db.collection("books").add({data: [{"title":"a","sales":1}]})`, got)
+}
+
+func TestDatabaseQueryResDecode(t *testing.T) {
+	res := &DatabaseQueryRes{Data: []string{`{"_id":"1","title":"a","price":10}`, `{"_id":"2","title":"b","price":20}`}}
+	var books []struct {
+		ID    string `json:"_id"`
+		Title string `json:"title"`
+		Price int    `json:"price"`
+	}
+	assert.Nil(t, res.Decode(&books))
+	if assert.Len(t, books, 2) {
+		assert.Equal(t, "2", books[1].ID)
+		assert.Equal(t, 20, books[1].Price)
+	}
+}
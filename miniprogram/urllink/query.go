@@ -39,7 +39,7 @@ func (u *URLLink) Query(urlLink string) (*ULQueryResult, error) {
 	}
 
 	uri := fmt.Sprintf("%s?access_token=%s", queryURL, accessToken)
-	response, err := util.PostJSON(uri, map[string]string{"url_link": urlLink})
+	response, err := u.PostJSON(uri, map[string]string{"url_link": urlLink})
 	if err != nil {
 		return nil, err
 	}
@@ -54,7 +54,7 @@ func (u *URLScheme) QueryScheme(querySchemeParams QueryScheme) (schemeInfo Schem
 
 	urlStr := fmt.Sprintf(querySchemeURL, accessToken)
 	var response []byte
-	response, err = util.PostJSON(urlStr, querySchemeParams)
+	response, err = u.PostJSON(urlStr, querySchemeParams)
 	if err != nil {
 		return
 	}
@@ -72,7 +72,7 @@ func (u *URLScheme) Generate(params *USParams) (string, error) {
 	}
 
 	uri := fmt.Sprintf("%s?access_token=%s", generateURL, accessToken)
-	response, err := util.PostJSON(uri, params)
+	response, err := u.PostJSON(uri, params)
 	if err != nil {
 		return "", err
 	}
@@ -0,0 +1,27 @@
+package xpay
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+// PaySig 支付签名，uri 为不带域名及参数的接口路径，如 /xpay/query_user_balance，body 为请求体原文
+//
+// pay_sig = hex(hmac_sha256(AppKey, uri + "&" + body))，AppKey 在小程序后台虚拟支付中获取，现网与沙箱环境不同
+func PaySig(appKey, uri string, body []byte) string {
+	return hmacSHA256(appKey, append([]byte(uri+"&"), body...))
+}
+
+// UserSignature 用户态签名，signature = hex(hmac_sha256(session_key, body))
+//
+// session_key 为 auth.Code2Session 返回的会话密钥
+func UserSignature(sessionKey string, body []byte) string {
+	return hmacSHA256(sessionKey, body)
+}
+
+func hmacSHA256(key string, data []byte) string {
+	h := hmac.New(sha256.New, []byte(key))
+	h.Write(data)
+	return hex.EncodeToString(h.Sum(nil))
+}
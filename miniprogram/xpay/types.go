@@ -0,0 +1,122 @@
+package xpay
+
+import "github.com/silenceper/wechat/v2/util"
+
+// DeviceType 设备类型
+type DeviceType int
+
+const (
+	// DeviceTypeAndroid 安卓
+	DeviceTypeAndroid DeviceType = 1
+	// DeviceTypeIOS iOS
+	DeviceTypeIOS DeviceType = 2
+)
+
+// QueryUserBalanceRequest 查询虚拟支付余额请求，Env 由 XPay 填充
+type QueryUserBalanceRequest struct {
+	OpenID string `json:"openid"`
+	Env    Env    `json:"env"`
+	UserIP string `json:"user_ip"` // 用户 ip，如 1.1.1.1
+}
+
+// QueryUserBalanceResponse 查询虚拟支付余额返回
+type QueryUserBalanceResponse struct {
+	util.CommonError
+	Balance        int64 `json:"balance"`         // 代币总余额，包括有价和赠送部分
+	PresentBalance int64 `json:"present_balance"` // 赠送账户的代币余额
+	SumSave        int64 `json:"sum_save"`        // 累计有价货币充值数量
+	SumPresent     int64 `json:"sum_present"`     // 累计赠送无价货币数量
+	SumBalance     int64 `json:"sum_balance"`     // 历史总增加的代币金额
+	SumCost        int64 `json:"sum_cost"`        // 历史总消耗代币金额
+	FirstSaveFlag  bool  `json:"first_save_flag"` // 是否满足首充活动标记
+}
+
+// CurrencyPayRequest 扣减代币请求，Env 由 XPay 填充
+type CurrencyPayRequest struct {
+	OpenID  string `json:"openid"`
+	Env     Env    `json:"env"`
+	UserIP  string `json:"user_ip"`
+	Amount  int64  `json:"amount"`   // 支付的代币数量
+	OrderID string `json:"order_id"` // 商户订单号，需要保证唯一性
+	PayItem string `json:"payitem"`  // 物品信息，用于查询及对账，如 [{"productid":"物品id", "unit_price": 单价, "quantity": 数量}]
+	Remark  string `json:"remark"`   // 备注
+}
+
+// CurrencyPayResponse 扣减代币返回
+type CurrencyPayResponse struct {
+	util.CommonError
+	OrderID           string `json:"order_id"`
+	Balance           int64  `json:"balance"`             // 总余额，包括有价和赠送部分
+	UsedPresentAmount int64  `json:"used_present_amount"` // 使用赠送部分的代币数量
+}
+
+// QueryOrderRequest 查询创建的订单请求，OrderID 与 WxOrderID 二选一，Env 由 XPay 填充
+type QueryOrderRequest struct {
+	OpenID    string `json:"openid"`
+	Env       Env    `json:"env"`
+	OrderID   string `json:"order_id,omitempty"`    // 商户订单号
+	WxOrderID string `json:"wx_order_id,omitempty"` // 微信内部单号
+}
+
+// Order 虚拟支付订单
+type Order struct {
+	OrderID        string `json:"order_id"`
+	CreateTime     int64  `json:"create_time"`
+	UpdateTime     int64  `json:"update_time"`
+	Status         int    `json:"status"`       // 0 订单初始化，1 订单创建成功，2 支付成功，3 发货中，4 发货成功，5 退款中，6 退款成功，7 退款失败，8 用户手动取消，9 发货失败
+	BizType        int    `json:"biz_type"`     // 0 短剧
+	OrderFee       int64  `json:"order_fee"`    // 订单金额，单位为分
+	CouponFee      int64  `json:"coupon_fee"`   // 优惠金额
+	PaidFee        int64  `json:"paid_fee"`     // 用户支付金额
+	OrderType      int    `json:"order_type"`   // 0 支付单，1 退款单
+	RefundFee      int64  `json:"refund_fee"`   // 退款单的退款金额
+	PaidTime       int64  `json:"paid_time"`    // 支付或退款时间
+	ProvideTime    int64  `json:"provide_time"` // 发货时间
+	EnvType        Env    `json:"env_type"`
+	BizMeta        string `json:"biz_meta"` // 下单时的透传数据
+	Token          string `json:"token"`    // 下单时的 token
+	LeftFee        int64  `json:"left_fee"` // 支付单剩余可退金额
+	WxOrderID      string `json:"wx_order_id"`
+	ChannelOrderID string `json:"channel_order_id"` // 渠道单号
+	WxPayOrderID   string `json:"wxpay_order_id"`   // 微信支付单号
+}
+
+// CancelCurrencyPayRequest 代币支付退款请求，Env 由 XPay 填充
+type CancelCurrencyPayRequest struct {
+	OpenID     string     `json:"openid"`
+	Env        Env        `json:"env"`
+	UserIP     string     `json:"user_ip"`
+	PayOrderID string     `json:"pay_order_id"` // CurrencyPay 的商户订单号
+	OrderID    string     `json:"order_id"`     // 本次退款的商户订单号
+	Amount     int64      `json:"amount"`       // 退款金额
+	DeviceType DeviceType `json:"device_type"`
+}
+
+// CancelCurrencyPayResponse 代币支付退款返回
+type CancelCurrencyPayResponse struct {
+	util.CommonError
+	OrderID string `json:"order_id"`
+}
+
+// NotifyProvideGoodsRequest 通知已发货请求，OrderID 与 WxOrderID 二选一，Env 由 XPay 填充
+type NotifyProvideGoodsRequest struct {
+	OrderID   string `json:"order_id,omitempty"`
+	WxOrderID string `json:"wx_order_id,omitempty"`
+	Env       Env    `json:"env"`
+}
+
+// PresentCurrencyRequest 代币赠送请求，Env 由 XPay 填充
+type PresentCurrencyRequest struct {
+	OpenID  string `json:"openid"`
+	Env     Env    `json:"env"`
+	OrderID string `json:"order_id"` // 赠送单号，需要保证唯一性
+	Amount  int64  `json:"amount"`   // 赠送的代币数量
+}
+
+// PresentCurrencyResponse 代币赠送返回
+type PresentCurrencyResponse struct {
+	util.CommonError
+	Balance        int64  `json:"balance"`
+	OrderID        string `json:"order_id"`
+	PresentBalance int64  `json:"present_balance"`
+}
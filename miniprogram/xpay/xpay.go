@@ -0,0 +1,184 @@
+package xpay
+
+import (
+	context2 "context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/silenceper/wechat/v2/miniprogram/auth"
+	"github.com/silenceper/wechat/v2/miniprogram/context"
+	"github.com/silenceper/wechat/v2/util"
+)
+
+const (
+	xpayHost = "https://api.weixin.qq.com"
+
+	queryUserBalanceURI   = "/xpay/query_user_balance"
+	currencyPayURI        = "/xpay/currency_pay"
+	queryOrderURI         = "/xpay/query_order"
+	cancelCurrencyPayURI  = "/xpay/cancel_currency_pay"
+	notifyProvideGoodsURI = "/xpay/notify_provide_goods"
+	presentCurrencyURI    = "/xpay/present_currency"
+)
+
+// Env 虚拟支付环境
+type Env int
+
+const (
+	// EnvProduction 现网环境
+	EnvProduction Env = 0
+	// EnvSandbox 沙箱环境
+	EnvSandbox Env = 1
+)
+
+// XPay 小程序虚拟支付
+//
+// 接口需额外携带支付签名 pay_sig，部分接口需携带用户态签名 signature，见 PaySig、UserSignature
+type XPay struct {
+	*context.Context
+	appKey string
+	env    Env
+}
+
+// NewXPay init，appKey 需与 env 对应
+func NewXPay(ctx *context.Context, appKey string, env Env) *XPay {
+	return &XPay{Context: ctx, appKey: appKey, env: env}
+}
+
+// GetSessionKey 通过 wx.login 获取的 code 换取用户态签名所需的 session_key
+func (xpay *XPay) GetSessionKey(jsCode string) (string, error) {
+	return xpay.GetSessionKeyContext(context2.Background(), jsCode)
+}
+
+// GetSessionKeyContext 通过 wx.login 获取的 code 换取用户态签名所需的 session_key
+func (xpay *XPay) GetSessionKeyContext(ctx context2.Context, jsCode string) (string, error) {
+	res, err := auth.NewAuth(xpay.Context).Code2SessionContext(ctx, jsCode)
+	if err != nil {
+		return "", err
+	}
+	return res.SessionKey, nil
+}
+
+// QueryUserBalance 查询虚拟支付余额
+func (xpay *XPay) QueryUserBalance(sessionKey string, in *QueryUserBalanceRequest) (*QueryUserBalanceResponse, error) {
+	return xpay.QueryUserBalanceContext(context2.Background(), sessionKey, in)
+}
+
+// QueryUserBalanceContext 查询虚拟支付余额
+func (xpay *XPay) QueryUserBalanceContext(ctx context2.Context, sessionKey string, in *QueryUserBalanceRequest) (*QueryUserBalanceResponse, error) {
+	req := *in
+	req.Env = xpay.env
+	res := &QueryUserBalanceResponse{}
+	if err := xpay.post(ctx, queryUserBalanceURI, sessionKey, &req, res, "QueryUserBalance"); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// CurrencyPay 扣减代币
+func (xpay *XPay) CurrencyPay(sessionKey string, in *CurrencyPayRequest) (*CurrencyPayResponse, error) {
+	return xpay.CurrencyPayContext(context2.Background(), sessionKey, in)
+}
+
+// CurrencyPayContext 扣减代币
+func (xpay *XPay) CurrencyPayContext(ctx context2.Context, sessionKey string, in *CurrencyPayRequest) (*CurrencyPayResponse, error) {
+	req := *in
+	req.Env = xpay.env
+	res := &CurrencyPayResponse{}
+	if err := xpay.post(ctx, currencyPayURI, sessionKey, &req, res, "CurrencyPay"); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// QueryOrder 查询创建的订单
+func (xpay *XPay) QueryOrder(in *QueryOrderRequest) (*Order, error) {
+	return xpay.QueryOrderContext(context2.Background(), in)
+}
+
+// QueryOrderContext 查询创建的订单
+func (xpay *XPay) QueryOrderContext(ctx context2.Context, in *QueryOrderRequest) (*Order, error) {
+	req := *in
+	req.Env = xpay.env
+	var res struct {
+		util.CommonError
+		Order Order `json:"order"`
+	}
+	if err := xpay.post(ctx, queryOrderURI, "", &req, &res, "QueryOrder"); err != nil {
+		return nil, err
+	}
+	return &res.Order, nil
+}
+
+// CancelCurrencyPay 代币支付退款，退还 CurrencyPay 扣减的代币
+func (xpay *XPay) CancelCurrencyPay(sessionKey string, in *CancelCurrencyPayRequest) (*CancelCurrencyPayResponse, error) {
+	return xpay.CancelCurrencyPayContext(context2.Background(), sessionKey, in)
+}
+
+// CancelCurrencyPayContext 代币支付退款
+func (xpay *XPay) CancelCurrencyPayContext(ctx context2.Context, sessionKey string, in *CancelCurrencyPayRequest) (*CancelCurrencyPayResponse, error) {
+	req := *in
+	req.Env = xpay.env
+	res := &CancelCurrencyPayResponse{}
+	if err := xpay.post(ctx, cancelCurrencyPayURI, sessionKey, &req, res, "CancelCurrencyPay"); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// NotifyProvideGoods 通知已发货，用于收到发货推送后未及时响应的订单
+func (xpay *XPay) NotifyProvideGoods(in *NotifyProvideGoodsRequest) error {
+	return xpay.NotifyProvideGoodsContext(context2.Background(), in)
+}
+
+// NotifyProvideGoodsContext 通知已发货
+func (xpay *XPay) NotifyProvideGoodsContext(ctx context2.Context, in *NotifyProvideGoodsRequest) error {
+	req := *in
+	req.Env = xpay.env
+	return xpay.post(ctx, notifyProvideGoodsURI, "", &req, nil, "NotifyProvideGoods")
+}
+
+// PresentCurrency 代币赠送
+func (xpay *XPay) PresentCurrency(in *PresentCurrencyRequest) (*PresentCurrencyResponse, error) {
+	return xpay.PresentCurrencyContext(context2.Background(), in)
+}
+
+// PresentCurrencyContext 代币赠送
+func (xpay *XPay) PresentCurrencyContext(ctx context2.Context, in *PresentCurrencyRequest) (*PresentCurrencyResponse, error) {
+	req := *in
+	req.Env = xpay.env
+	res := &PresentCurrencyResponse{}
+	if err := xpay.post(ctx, presentCurrencyURI, "", &req, res, "PresentCurrency"); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// post 请求接口，sessionKey 不为空时附加用户态签名，res 为 nil 时只解析错误码
+func (xpay *XPay) post(ctx context2.Context, uri, sessionKey string, req, res interface{}, apiName string) error {
+	accessToken, err := xpay.GetAccessTokenContext(ctx)
+	if err != nil {
+		return err
+	}
+	// 签名需使用请求体原文
+	body, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+	query := url.Values{}
+	query.Set("access_token", accessToken)
+	query.Set("pay_sig", PaySig(xpay.appKey, uri, body))
+	if sessionKey != "" {
+		query.Set("signature", UserSignature(sessionKey, body))
+	}
+	header := map[string]string{"Content-Type": "application/json;charset=utf-8"}
+	response, err := xpay.HTTPPostContext(ctx, fmt.Sprintf("%s%s?%s", xpayHost, uri, query.Encode()), body, header)
+	if err != nil {
+		return err
+	}
+	if res == nil {
+		return util.DecodeWithCommonError(response, apiName)
+	}
+	return util.DecodeWithError(response, res, apiName)
+}
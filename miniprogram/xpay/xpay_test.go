@@ -0,0 +1,146 @@
+package xpay
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+
+	"github.com/silenceper/wechat/v2/miniprogram/config"
+	"github.com/silenceper/wechat/v2/miniprogram/context"
+	"github.com/silenceper/wechat/v2/util"
+)
+
+const (
+	testBody          = `{"openid":"openid","env":0,"user_ip":"127.0.0.1"}`
+	testPaySig        = "7bd31ff6c13b349a095a42dccf2443207e03fe7e919791ca67dbfd57b31538e4"
+	testUserSignature = "f1f120daf9f075dd29de863e9c46d7f3779900622292f911df2862a787d289cc"
+)
+
+type stubAccessToken struct{}
+
+func (stubAccessToken) GetAccessToken() (string, error) {
+	return "mock-access-token", nil
+}
+
+func TestSign(t *testing.T) {
+	assert.Equal(t, testPaySig, PaySig("appkey", queryUserBalanceURI, []byte(testBody)))
+	assert.Equal(t, testUserSignature, UserSignature("sessionkey", []byte(testBody)))
+}
+
+func TestQueryUserBalance(t *testing.T) {
+	defer gock.Off()
+	gock.New("https://api.weixin.qq.com").
+		Post(queryUserBalanceURI).
+		MatchParam("access_token", "mock-access-token").
+		MatchParam("pay_sig", testPaySig).
+		MatchParam("signature", testUserSignature).
+		BodyString(testBody).
+		Reply(200).
+		JSON(map[string]interface{}{"errcode": 0, "errmsg": "ok", "balance": 100, "present_balance": 20})
+
+	xpay := NewXPay(&context.Context{Config: &config.Config{AppID: "appid"}, AccessTokenHandle: stubAccessToken{}}, "appkey", EnvProduction)
+	// Env 由 XPay 填充
+	res, err := xpay.QueryUserBalance("sessionkey", &QueryUserBalanceRequest{OpenID: "openid", Env: EnvSandbox, UserIP: "127.0.0.1"})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(100), res.Balance)
+	assert.Equal(t, int64(20), res.PresentBalance)
+	assert.True(t, gock.IsDone())
+}
+
+func TestXPayAPIs(t *testing.T) {
+	xpay := NewXPay(&context.Context{Config: &config.Config{AppID: "appid"}, AccessTokenHandle: stubAccessToken{}}, "appkey", EnvProduction)
+	tests := []struct {
+		name       string
+		uri        string
+		body       string
+		sessionKey string // 为空时请求不应携带 signature
+		reply      map[string]interface{}
+		call       func(t *testing.T)
+	}{
+		{
+			name:       "CurrencyPay",
+			uri:        currencyPayURI,
+			body:       `{"openid":"openid","env":0,"user_ip":"127.0.0.1","amount":10,"order_id":"order_1","payitem":"[]","remark":"r"}`,
+			sessionKey: "sessionkey",
+			reply:      map[string]interface{}{"errcode": 0, "order_id": "order_1", "balance": 90, "used_present_amount": 5},
+			call: func(t *testing.T) {
+				res, err := xpay.CurrencyPay("sessionkey", &CurrencyPayRequest{OpenID: "openid", UserIP: "127.0.0.1", Amount: 10, OrderID: "order_1", PayItem: "[]", Remark: "r"})
+				assert.Nil(t, err)
+				assert.Equal(t, int64(90), res.Balance)
+				assert.Equal(t, int64(5), res.UsedPresentAmount)
+			},
+		},
+		{
+			name:       "CancelCurrencyPay",
+			uri:        cancelCurrencyPayURI,
+			body:       `{"openid":"openid","env":0,"user_ip":"127.0.0.1","pay_order_id":"order_1","order_id":"refund_1","amount":10,"device_type":1}`,
+			sessionKey: "sessionkey",
+			reply:      map[string]interface{}{"errcode": 0, "order_id": "refund_1"},
+			call: func(t *testing.T) {
+				res, err := xpay.CancelCurrencyPay("sessionkey", &CancelCurrencyPayRequest{OpenID: "openid", UserIP: "127.0.0.1", PayOrderID: "order_1", OrderID: "refund_1", Amount: 10, DeviceType: DeviceTypeAndroid})
+				assert.Nil(t, err)
+				assert.Equal(t, "refund_1", res.OrderID)
+			},
+		},
+		{
+			name:  "QueryOrder",
+			uri:   queryOrderURI,
+			body:  `{"openid":"openid","env":0,"order_id":"order_1"}`,
+			reply: map[string]interface{}{"errcode": 0, "order": map[string]interface{}{"order_id": "order_1", "status": 2, "paid_fee": 100}},
+			call: func(t *testing.T) {
+				order, err := xpay.QueryOrder(&QueryOrderRequest{OpenID: "openid", OrderID: "order_1"})
+				assert.Nil(t, err)
+				assert.Equal(t, 2, order.Status)
+				assert.Equal(t, int64(100), order.PaidFee)
+			},
+		},
+		{
+			name:  "NotifyProvideGoods",
+			uri:   notifyProvideGoodsURI,
+			body:  `{"order_id":"order_1","env":0}`,
+			reply: map[string]interface{}{"errcode": 268490004, "errmsg": "duplicate operation"},
+			call: func(t *testing.T) {
+				err := xpay.NotifyProvideGoods(&NotifyProvideGoodsRequest{OrderID: "order_1"})
+				code, ok := util.ErrCodeOf(err)
+				assert.True(t, ok)
+				assert.Equal(t, int64(268490004), code)
+			},
+		},
+		{
+			name:  "PresentCurrency",
+			uri:   presentCurrencyURI,
+			body:  `{"openid":"openid","env":0,"order_id":"present_1","amount":5}`,
+			reply: map[string]interface{}{"errcode": 0, "balance": 105, "order_id": "present_1", "present_balance": 25},
+			call: func(t *testing.T) {
+				res, err := xpay.PresentCurrency(&PresentCurrencyRequest{OpenID: "openid", OrderID: "present_1", Amount: 5})
+				assert.Nil(t, err)
+				assert.Equal(t, int64(105), res.Balance)
+				assert.Equal(t, int64(25), res.PresentBalance)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer gock.Off()
+			var signature string
+			if tt.sessionKey != "" {
+				signature = UserSignature(tt.sessionKey, []byte(tt.body))
+			}
+			gock.New("https://api.weixin.qq.com").
+				Post(tt.uri).
+				MatchParam("access_token", "mock-access-token").
+				MatchParam("pay_sig", PaySig("appkey", tt.uri, []byte(tt.body))).
+				AddMatcher(func(req *http.Request, _ *gock.Request) (bool, error) {
+					return req.URL.Query().Get("signature") == signature, nil
+				}).
+				BodyString(tt.body).
+				Reply(200).
+				JSON(tt.reply)
+
+			tt.call(t)
+			assert.True(t, gock.IsDone())
+		})
+	}
+}
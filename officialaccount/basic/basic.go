@@ -1,9 +1,13 @@
 package basic
 
 import (
+	context2 "context"
 	"fmt"
+	"net/url"
 
 	"github.com/silenceper/wechat/v2/officialaccount/context"
+	"github.com/silenceper/wechat/v2/ratelimit"
+	"github.com/silenceper/wechat/v2/telemetry"
 	"github.com/silenceper/wechat/v2/util"
 )
 
@@ -15,6 +19,10 @@ var (
 
 	// 清理接口调用次数
 	clearQuotaURL = "https://api.weixin.qq.com/cgi-bin/clear_quota"
+
+	// 查询接口调用额度
+	// 文档：https://developers.weixin.qq.com/doc/offiaccount/openApi/get_api_quota.html
+	getQuotaURL = "https://api.weixin.qq.com/cgi-bin/openapi/quota/get"
 )
 
 // Basic struct
@@ -37,12 +45,17 @@ type IPListRes struct {
 
 // GetCallbackIP 获取微信callback IP地址
 func (basic *Basic) GetCallbackIP() ([]string, error) {
-	ak, err := basic.GetAccessToken()
+	return basic.GetCallbackIPContext(context2.Background())
+}
+
+// GetCallbackIPContext 获取微信callback IP地址
+func (basic *Basic) GetCallbackIPContext(ctx context2.Context) ([]string, error) {
+	ak, err := basic.GetAccessTokenContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 	url := fmt.Sprintf("%s?access_token=%s", getCallbackIPURL, ak)
-	data, err := util.HTTPGet(url)
+	data, err := basic.HTTPGetContext(ctx, url)
 	if err != nil {
 		return nil, err
 	}
@@ -53,12 +66,17 @@ func (basic *Basic) GetCallbackIP() ([]string, error) {
 
 // GetAPIDomainIP 获取微信API接口 IP地址
 func (basic *Basic) GetAPIDomainIP() ([]string, error) {
-	ak, err := basic.GetAccessToken()
+	return basic.GetAPIDomainIPContext(context2.Background())
+}
+
+// GetAPIDomainIPContext 获取微信API接口 IP地址
+func (basic *Basic) GetAPIDomainIPContext(ctx context2.Context) ([]string, error) {
+	ak, err := basic.GetAccessTokenContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 	url := fmt.Sprintf("%s?access_token=%s", getAPIDomainIPURL, ak)
-	data, err := util.HTTPGet(url)
+	data, err := basic.HTTPGetContext(ctx, url)
 	if err != nil {
 		return nil, err
 	}
@@ -69,16 +87,69 @@ func (basic *Basic) GetAPIDomainIP() ([]string, error) {
 
 // ClearQuota 清理接口调用次数
 func (basic *Basic) ClearQuota() error {
-	ak, err := basic.GetAccessToken()
+	return basic.ClearQuotaContext(context2.Background())
+}
+
+// ClearQuotaContext 清理接口调用次数
+func (basic *Basic) ClearQuotaContext(ctx context2.Context) error {
+	ak, err := basic.GetAccessTokenContext(ctx)
 	if err != nil {
 		return err
 	}
 	url := fmt.Sprintf("%s?access_token=%s", clearQuotaURL, ak)
-	data, err := util.PostJSON(url, map[string]string{
+	data, err := basic.PostJSONContext(ctx, url, map[string]string{
 		"appid": basic.AppID,
 	})
 	if err != nil {
 		return err
 	}
-	return util.DecodeWithCommonError(data, "ClearQuota")
+	if err = util.DecodeWithCommonError(data, "ClearQuota"); err != nil {
+		return err
+	}
+	if tracker, ok := basic.RateLimiter.(ratelimit.QuotaTracker); ok {
+		tracker.ResetQuota(basic.AppID)
+	}
+	return nil
+}
+
+// Quota 接口调用额度
+type Quota struct {
+	DailyLimit int64 `json:"daily_limit"` // 当天该账号可调用该接口的次数
+	Used       int64 `json:"used"`        // 当天已经调用的次数
+	Remain     int64 `json:"remain"`      // 当天剩余调用次数
+}
+
+type resQuota struct {
+	util.CommonError
+	Quota Quota `json:"quota"`
+}
+
+// GetQuota 查询接口调用额度，cgiPath 为接口路径，如 /cgi-bin/message/custom/send
+//
+// 配置的 RateLimiter 实现了 ratelimit.QuotaTracker 时，会记录剩余额度，后续调用按剩余额度放慢
+func (basic *Basic) GetQuota(cgiPath string) (*Quota, error) {
+	return basic.GetQuotaContext(context2.Background(), cgiPath)
+}
+
+// GetQuotaContext 查询接口调用额度
+func (basic *Basic) GetQuotaContext(ctx context2.Context, cgiPath string) (*Quota, error) {
+	ak, err := basic.GetAccessTokenContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	uri := fmt.Sprintf("%s?access_token=%s", getQuotaURL, ak)
+	data, err := basic.PostJSONContext(ctx, uri, map[string]string{
+		"cgi_path": cgiPath,
+	})
+	if err != nil {
+		return nil, err
+	}
+	res := &resQuota{}
+	if err = util.DecodeWithError(data, res, "GetQuota"); err != nil {
+		return nil, err
+	}
+	if tracker, ok := basic.RateLimiter.(ratelimit.QuotaTracker); ok {
+		tracker.SetQuota(basic.AppID, telemetry.APIName(&url.URL{Host: "api.weixin.qq.com", Path: cgiPath}), res.Quota.Remain)
+	}
+	return &res.Quota, nil
 }
@@ -1,6 +1,7 @@
 package basic
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"reflect"
@@ -44,13 +45,18 @@ type Ticket struct {
 
 // GetQRTicket 获取二维码 Ticket
 func (basic *Basic) GetQRTicket(tq *Request) (t *Ticket, err error) {
-	accessToken, err := basic.GetAccessToken()
+	return basic.GetQRTicketContext(context.Background(), tq)
+}
+
+// GetQRTicketContext 获取二维码 Ticket
+func (basic *Basic) GetQRTicketContext(ctx context.Context, tq *Request) (t *Ticket, err error) {
+	accessToken, err := basic.GetAccessTokenContext(ctx)
 	if err != nil {
 		return
 	}
 
 	uri := fmt.Sprintf(qrCreateURL, accessToken)
-	response, err := util.PostJSON(uri, tq)
+	response, err := basic.PostJSONContext(ctx, uri, tq)
 	if err != nil {
 		err = fmt.Errorf("get qr ticket failed, %s", err)
 		return
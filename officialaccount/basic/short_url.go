@@ -1,6 +1,7 @@
 package basic
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/silenceper/wechat/v2/util"
@@ -26,6 +27,11 @@ type (
 
 // Long2ShortURL 将一条长链接转成短链接
 func (basic *Basic) Long2ShortURL(longURL string) (shortURL string, err error) {
+	return basic.Long2ShortURLContext(context.Background(), longURL)
+}
+
+// Long2ShortURLContext 将一条长链接转成短链接
+func (basic *Basic) Long2ShortURLContext(ctx context.Context, longURL string) (shortURL string, err error) {
 	var (
 		req = &reqLong2ShortURL{
 			Action:  long2shortAction,
@@ -35,12 +41,12 @@ func (basic *Basic) Long2ShortURL(longURL string) (shortURL string, err error) {
 		ac, uri       string
 		responseBytes []byte
 	)
-	ac, err = basic.GetAccessToken()
+	ac, err = basic.GetAccessTokenContext(ctx)
 	if err != nil {
 		return
 	}
 	uri = fmt.Sprintf(long2shortURL, ac)
-	responseBytes, err = util.PostJSON(uri, req)
+	responseBytes, err = basic.PostJSONContext(ctx, uri, req)
 	if err != nil {
 		return
 	}
@@ -1,6 +1,7 @@
 package broadcast
 
 import (
+	context2 "context"
 	"fmt"
 
 	"github.com/silenceper/wechat/v2/officialaccount/context"
@@ -100,7 +101,15 @@ type Image struct {
 // &User{TagID:2} 根据tag发送
 // &User{OpenID:[]string("xxx","xxx")} 根据openid发送
 func (broadcast *Broadcast) SendText(user *User, content string) (*Result, error) {
-	ak, err := broadcast.GetAccessToken()
+	return broadcast.SendTextContext(context2.Background(), user, content)
+}
+
+// SendTextContext 群发文本
+// user 为nil，表示全员发送
+// &User{TagID:2} 根据tag发送
+// &User{OpenID:[]string("xxx","xxx")} 根据openid发送
+func (broadcast *Broadcast) SendTextContext(ctx context2.Context, user *User, content string) (*Result, error) {
+	ak, err := broadcast.GetAccessTokenContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -113,7 +122,7 @@ func (broadcast *Broadcast) SendText(user *User, content string) (*Result, error
 	}
 	req, sendURL := broadcast.chooseTagOrOpenID(user, req)
 	url := fmt.Sprintf("%s?access_token=%s", sendURL, ak)
-	data, err := util.PostJSON(url, req)
+	data, err := broadcast.PostJSONContext(ctx, url, req)
 	if err != nil {
 		return nil, err
 	}
@@ -124,7 +133,12 @@ func (broadcast *Broadcast) SendText(user *User, content string) (*Result, error
 
 // SendNews 发送图文
 func (broadcast *Broadcast) SendNews(user *User, mediaID string, ignoreReprint bool) (*Result, error) {
-	ak, err := broadcast.GetAccessToken()
+	return broadcast.SendNewsContext(context2.Background(), user, mediaID, ignoreReprint)
+}
+
+// SendNewsContext 发送图文
+func (broadcast *Broadcast) SendNewsContext(ctx context2.Context, user *User, mediaID string, ignoreReprint bool) (*Result, error) {
+	ak, err := broadcast.GetAccessTokenContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -140,7 +154,7 @@ func (broadcast *Broadcast) SendNews(user *User, mediaID string, ignoreReprint b
 	}
 	req, sendURL := broadcast.chooseTagOrOpenID(user, req)
 	url := fmt.Sprintf("%s?access_token=%s", sendURL, ak)
-	data, err := util.PostJSON(url, req)
+	data, err := broadcast.PostJSONContext(ctx, url, req)
 	if err != nil {
 		return nil, err
 	}
@@ -151,7 +165,12 @@ func (broadcast *Broadcast) SendNews(user *User, mediaID string, ignoreReprint b
 
 // SendVoice 发送语音
 func (broadcast *Broadcast) SendVoice(user *User, mediaID string) (*Result, error) {
-	ak, err := broadcast.GetAccessToken()
+	return broadcast.SendVoiceContext(context2.Background(), user, mediaID)
+}
+
+// SendVoiceContext 发送语音
+func (broadcast *Broadcast) SendVoiceContext(ctx context2.Context, user *User, mediaID string) (*Result, error) {
+	ak, err := broadcast.GetAccessTokenContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -164,7 +183,7 @@ func (broadcast *Broadcast) SendVoice(user *User, mediaID string) (*Result, erro
 	}
 	req, sendURL := broadcast.chooseTagOrOpenID(user, req)
 	url := fmt.Sprintf("%s?access_token=%s", sendURL, ak)
-	data, err := util.PostJSON(url, req)
+	data, err := broadcast.PostJSONContext(ctx, url, req)
 	if err != nil {
 		return nil, err
 	}
@@ -175,7 +194,12 @@ func (broadcast *Broadcast) SendVoice(user *User, mediaID string) (*Result, erro
 
 // SendImage 发送图片
 func (broadcast *Broadcast) SendImage(user *User, images *Image) (*Result, error) {
-	ak, err := broadcast.GetAccessToken()
+	return broadcast.SendImageContext(context2.Background(), user, images)
+}
+
+// SendImageContext 发送图片
+func (broadcast *Broadcast) SendImageContext(ctx context2.Context, user *User, images *Image) (*Result, error) {
+	ak, err := broadcast.GetAccessTokenContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -186,7 +210,7 @@ func (broadcast *Broadcast) SendImage(user *User, images *Image) (*Result, error
 	req.Images = images
 	req, sendURL := broadcast.chooseTagOrOpenID(user, req)
 	url := fmt.Sprintf("%s?access_token=%s", sendURL, ak)
-	data, err := util.PostJSON(url, req)
+	data, err := broadcast.PostJSONContext(ctx, url, req)
 	if err != nil {
 		return nil, err
 	}
@@ -197,7 +221,12 @@ func (broadcast *Broadcast) SendImage(user *User, images *Image) (*Result, error
 
 // SendVideo 发送视频
 func (broadcast *Broadcast) SendVideo(user *User, mediaID string, title, description string) (*Result, error) {
-	ak, err := broadcast.GetAccessToken()
+	return broadcast.SendVideoContext(context2.Background(), user, mediaID, title, description)
+}
+
+// SendVideoContext 发送视频
+func (broadcast *Broadcast) SendVideoContext(ctx context2.Context, user *User, mediaID string, title, description string) (*Result, error) {
+	ak, err := broadcast.GetAccessTokenContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -212,7 +241,7 @@ func (broadcast *Broadcast) SendVideo(user *User, mediaID string, title, descrip
 	}
 	req, sendURL := broadcast.chooseTagOrOpenID(user, req)
 	url := fmt.Sprintf("%s?access_token=%s", sendURL, ak)
-	data, err := util.PostJSON(url, req)
+	data, err := broadcast.PostJSONContext(ctx, url, req)
 	if err != nil {
 		return nil, err
 	}
@@ -223,7 +252,12 @@ func (broadcast *Broadcast) SendVideo(user *User, mediaID string, title, descrip
 
 // SendWxCard 发送卡券
 func (broadcast *Broadcast) SendWxCard(user *User, cardID string) (*Result, error) {
-	ak, err := broadcast.GetAccessToken()
+	return broadcast.SendWxCardContext(context2.Background(), user, cardID)
+}
+
+// SendWxCardContext 发送卡券
+func (broadcast *Broadcast) SendWxCardContext(ctx context2.Context, user *User, cardID string) (*Result, error) {
+	ak, err := broadcast.GetAccessTokenContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -236,7 +270,7 @@ func (broadcast *Broadcast) SendWxCard(user *User, cardID string) (*Result, erro
 	}
 	req, sendURL := broadcast.chooseTagOrOpenID(user, req)
 	url := fmt.Sprintf("%s?access_token=%s", sendURL, ak)
-	data, err := util.PostJSON(url, req)
+	data, err := broadcast.PostJSONContext(ctx, url, req)
 	if err != nil {
 		return nil, err
 	}
@@ -247,7 +281,12 @@ func (broadcast *Broadcast) SendWxCard(user *User, cardID string) (*Result, erro
 
 // Delete 删除群发消息
 func (broadcast *Broadcast) Delete(msgID int64, articleIDx int64) error {
-	ak, err := broadcast.GetAccessToken()
+	return broadcast.DeleteContext(context2.Background(), msgID, articleIDx)
+}
+
+// DeleteContext 删除群发消息
+func (broadcast *Broadcast) DeleteContext(ctx context2.Context, msgID int64, articleIDx int64) error {
+	ak, err := broadcast.GetAccessTokenContext(ctx)
 	if err != nil {
 		return err
 	}
@@ -256,7 +295,7 @@ func (broadcast *Broadcast) Delete(msgID int64, articleIDx int64) error {
 		"article_idx": articleIDx,
 	}
 	url := fmt.Sprintf("%s?access_token=%s", deleteSendURL, ak)
-	data, err := util.PostJSON(url, req)
+	data, err := broadcast.PostJSONContext(ctx, url, req)
 	if err != nil {
 		return err
 	}
@@ -271,7 +310,12 @@ func (broadcast *Broadcast) Preview() *Broadcast {
 
 // GetMassStatus 获取群发状态
 func (broadcast *Broadcast) GetMassStatus(msgID string) (*Result, error) {
-	ak, err := broadcast.GetAccessToken()
+	return broadcast.GetMassStatusContext(context2.Background(), msgID)
+}
+
+// GetMassStatusContext 获取群发状态
+func (broadcast *Broadcast) GetMassStatusContext(ctx context2.Context, msgID string) (*Result, error) {
+	ak, err := broadcast.GetAccessTokenContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -279,7 +323,7 @@ func (broadcast *Broadcast) GetMassStatus(msgID string) (*Result, error) {
 		"msg_id": msgID,
 	}
 	url := fmt.Sprintf("%s?access_token=%s", massStatusSendURL, ak)
-	data, err := util.PostJSON(url, req)
+	data, err := broadcast.PostJSONContext(ctx, url, req)
 	if err != nil {
 		return nil, err
 	}
@@ -290,13 +334,18 @@ func (broadcast *Broadcast) GetMassStatus(msgID string) (*Result, error) {
 
 // GetSpeed 获取群发速度
 func (broadcast *Broadcast) GetSpeed() (*SpeedResult, error) {
-	ak, err := broadcast.GetAccessToken()
+	return broadcast.GetSpeedContext(context2.Background())
+}
+
+// GetSpeedContext 获取群发速度
+func (broadcast *Broadcast) GetSpeedContext(ctx context2.Context) (*SpeedResult, error) {
+	ak, err := broadcast.GetAccessTokenContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 	req := map[string]interface{}{}
 	url := fmt.Sprintf("%s?access_token=%s", getSpeedSendURL, ak)
-	data, err := util.PostJSON(url, req)
+	data, err := broadcast.PostJSONContext(ctx, url, req)
 	if err != nil {
 		return nil, err
 	}
@@ -307,7 +356,12 @@ func (broadcast *Broadcast) GetSpeed() (*SpeedResult, error) {
 
 // SetSpeed 设置群发速度
 func (broadcast *Broadcast) SetSpeed(speed int) (*SpeedResult, error) {
-	ak, err := broadcast.GetAccessToken()
+	return broadcast.SetSpeedContext(context2.Background(), speed)
+}
+
+// SetSpeedContext 设置群发速度
+func (broadcast *Broadcast) SetSpeedContext(ctx context2.Context, speed int) (*SpeedResult, error) {
+	ak, err := broadcast.GetAccessTokenContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -315,7 +369,7 @@ func (broadcast *Broadcast) SetSpeed(speed int) (*SpeedResult, error) {
 		"speed": speed,
 	}
 	url := fmt.Sprintf("%s?access_token=%s", setSpeedSendURL, ak)
-	data, err := util.PostJSON(url, req)
+	data, err := broadcast.PostJSONContext(ctx, url, req)
 	if err != nil {
 		return nil, err
 	}
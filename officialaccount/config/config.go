@@ -2,6 +2,9 @@ package config
 
 import (
 	"github.com/silenceper/wechat/v2/cache"
+	"github.com/silenceper/wechat/v2/logger"
+	"github.com/silenceper/wechat/v2/ratelimit"
+	"github.com/silenceper/wechat/v2/util"
 )
 
 // Config .config for 微信公众号
@@ -10,5 +13,10 @@ type Config struct {
 	AppSecret      string `json:"app_secret"`       // appsecret
 	Token          string `json:"token"`            // token
 	EncodingAESKey string `json:"encoding_aes_key"` // EncodingAESKey
+	UseStableAK    bool   `json:"use_stable_ak"`    // 是否使用稳定版 access_token(/cgi-bin/stable_token)
 	Cache          cache.Cache
+	HTTPClient     util.HTTPClient    // 自定义http client，为空时使用 util.DefaultHTTPClient
+	Logger         logger.Logger      // 日志，为空时输出到 logrus 的 StandardLogger
+	Interceptors   []util.Interceptor // 请求拦截器，可用于统计接口耗时、错误码及链路追踪
+	RateLimiter    ratelimit.Limiter  // 限流，为空时不限流
 }
@@ -1,6 +1,7 @@
 package customerservice
 
 import (
+	context2 "context"
 	"fmt"
 
 	"github.com/silenceper/wechat/v2/officialaccount/context"
@@ -59,14 +60,19 @@ type resKeFuList struct {
 
 // List 获取所有客服基本信息
 func (csm *Manager) List() (customerServiceList []*KeFuInfo, err error) {
+	return csm.ListContext(context2.Background())
+}
+
+// ListContext 获取所有客服基本信息
+func (csm *Manager) ListContext(ctx context2.Context) (customerServiceList []*KeFuInfo, err error) {
 	var accessToken string
-	accessToken, err = csm.GetAccessToken()
+	accessToken, err = csm.GetAccessTokenContext(ctx)
 	if err != nil {
 		return
 	}
 	uri := fmt.Sprintf("%s?access_token=%s", customerServiceListURL, accessToken)
 	var response []byte
-	response, err = util.HTTPGet(uri)
+	response, err = csm.HTTPGetContext(ctx, uri)
 	if err != nil {
 		return
 	}
@@ -94,14 +100,19 @@ type resKeFuOnlineList struct {
 
 // OnlineList 获取在线客服列表
 func (csm *Manager) OnlineList() (customerServiceOnlineList []*KeFuOnlineInfo, err error) {
+	return csm.OnlineListContext(context2.Background())
+}
+
+// OnlineListContext 获取在线客服列表
+func (csm *Manager) OnlineListContext(ctx context2.Context) (customerServiceOnlineList []*KeFuOnlineInfo, err error) {
 	var accessToken string
-	accessToken, err = csm.GetAccessToken()
+	accessToken, err = csm.GetAccessTokenContext(ctx)
 	if err != nil {
 		return
 	}
 	uri := fmt.Sprintf("%s?access_token=%s", customerServiceOnlineListURL, accessToken)
 	var response []byte
-	response, err = util.HTTPGet(uri)
+	response, err = csm.HTTPGetContext(ctx, uri)
 	if err != nil {
 		return
 	}
@@ -116,11 +127,16 @@ func (csm *Manager) OnlineList() (customerServiceOnlineList []*KeFuOnlineInfo, e
 
 // Add 添加客服账号
 func (csm *Manager) Add(kfAccount, nickName string) (err error) {
+	return csm.AddContext(context2.Background(), kfAccount, nickName)
+}
+
+// AddContext 添加客服账号
+func (csm *Manager) AddContext(ctx context2.Context, kfAccount, nickName string) (err error) {
 	// kfAccount：完整客服帐号，格式为：帐号前缀@公众号微信号，帐号前缀最多10个字符，必须是英文、数字字符或者下划线，后缀为公众号微信号，长度不超过30个字符
 	// nickName：客服昵称，最长16个字
 	// 参数此处均不做校验
 	var accessToken string
-	accessToken, err = csm.GetAccessToken()
+	accessToken, err = csm.GetAccessTokenContext(ctx)
 	if err != nil {
 		return
 	}
@@ -133,7 +149,7 @@ func (csm *Manager) Add(kfAccount, nickName string) (err error) {
 		NickName:  nickName,
 	}
 	var response []byte
-	response, err = util.PostJSON(uri, data)
+	response, err = csm.PostJSONContext(ctx, uri, data)
 	if err != nil {
 		return
 	}
@@ -143,8 +159,13 @@ func (csm *Manager) Add(kfAccount, nickName string) (err error) {
 
 // Update 修改客服账号
 func (csm *Manager) Update(kfAccount, nickName string) (err error) {
+	return csm.UpdateContext(context2.Background(), kfAccount, nickName)
+}
+
+// UpdateContext 修改客服账号
+func (csm *Manager) UpdateContext(ctx context2.Context, kfAccount, nickName string) (err error) {
 	var accessToken string
-	accessToken, err = csm.GetAccessToken()
+	accessToken, err = csm.GetAccessTokenContext(ctx)
 	if err != nil {
 		return
 	}
@@ -157,7 +178,7 @@ func (csm *Manager) Update(kfAccount, nickName string) (err error) {
 		NickName:  nickName,
 	}
 	var response []byte
-	response, err = util.PostJSON(uri, data)
+	response, err = csm.PostJSONContext(ctx, uri, data)
 	if err != nil {
 		return
 	}
@@ -167,8 +188,13 @@ func (csm *Manager) Update(kfAccount, nickName string) (err error) {
 
 // Delete 删除客服帐号
 func (csm *Manager) Delete(kfAccount string) (err error) {
+	return csm.DeleteContext(context2.Background(), kfAccount)
+}
+
+// DeleteContext 删除客服帐号
+func (csm *Manager) DeleteContext(ctx context2.Context, kfAccount string) (err error) {
 	var accessToken string
-	accessToken, err = csm.GetAccessToken()
+	accessToken, err = csm.GetAccessTokenContext(ctx)
 	if err != nil {
 		return
 	}
@@ -179,7 +205,7 @@ func (csm *Manager) Delete(kfAccount string) (err error) {
 		KfAccount: kfAccount,
 	}
 	var response []byte
-	response, err = util.PostJSON(uri, data)
+	response, err = csm.PostJSONContext(ctx, uri, data)
 	if err != nil {
 		return
 	}
@@ -189,8 +215,13 @@ func (csm *Manager) Delete(kfAccount string) (err error) {
 
 // InviteBind 邀请绑定客服帐号和微信号
 func (csm *Manager) InviteBind(kfAccount, inviteWX string) (err error) {
+	return csm.InviteBindContext(context2.Background(), kfAccount, inviteWX)
+}
+
+// InviteBindContext 邀请绑定客服帐号和微信号
+func (csm *Manager) InviteBindContext(ctx context2.Context, kfAccount, inviteWX string) (err error) {
 	var accessToken string
-	accessToken, err = csm.GetAccessToken()
+	accessToken, err = csm.GetAccessTokenContext(ctx)
 	if err != nil {
 		return
 	}
@@ -203,7 +234,7 @@ func (csm *Manager) InviteBind(kfAccount, inviteWX string) (err error) {
 		InviteWX:  inviteWX,
 	}
 	var response []byte
-	response, err = util.PostJSON(uri, data)
+	response, err = csm.PostJSONContext(ctx, uri, data)
 	if err != nil {
 		return
 	}
@@ -213,14 +244,19 @@ func (csm *Manager) InviteBind(kfAccount, inviteWX string) (err error) {
 
 // UploadHeadImg 上传客服头像
 func (csm *Manager) UploadHeadImg(kfAccount, fileName string) (err error) {
+	return csm.UploadHeadImgContext(context2.Background(), kfAccount, fileName)
+}
+
+// UploadHeadImgContext 上传客服头像
+func (csm *Manager) UploadHeadImgContext(ctx context2.Context, kfAccount, fileName string) (err error) {
 	var accessToken string
-	accessToken, err = csm.GetAccessToken()
+	accessToken, err = csm.GetAccessTokenContext(ctx)
 	if err != nil {
 		return
 	}
 	uri := fmt.Sprintf("%s?access_token=%s&kf_account=%s", customerServiceUploadHeadImg, accessToken, kfAccount)
 	var response []byte
-	response, err = util.PostFile("media", fileName, uri)
+	response, err = csm.PostFileContext(ctx, "media", fileName, uri)
 	if err != nil {
 		return
 	}
@@ -230,8 +266,13 @@ func (csm *Manager) UploadHeadImg(kfAccount, fileName string) (err error) {
 
 // SendTypingStatus 下发客服输入状态给用户
 func (csm *Manager) SendTypingStatus(openid string, cmd TypingStatus) (err error) {
+	return csm.SendTypingStatusContext(context2.Background(), openid, cmd)
+}
+
+// SendTypingStatusContext 下发客服输入状态给用户
+func (csm *Manager) SendTypingStatusContext(ctx context2.Context, openid string, cmd TypingStatus) (err error) {
 	var accessToken string
-	accessToken, err = csm.GetAccessToken()
+	accessToken, err = csm.GetAccessTokenContext(ctx)
 	if err != nil {
 		return
 	}
@@ -244,7 +285,7 @@ func (csm *Manager) SendTypingStatus(openid string, cmd TypingStatus) (err error
 		Command: string(cmd),
 	}
 	var response []byte
-	response, err = util.PostJSON(uri, data)
+	response, err = csm.PostJSONContext(ctx, uri, data)
 	if err != nil {
 		return
 	}
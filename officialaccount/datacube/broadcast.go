@@ -1,6 +1,7 @@
 package datacube
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/silenceper/wechat/v2/util"
@@ -140,7 +141,12 @@ type ResUserShareHour struct {
 
 // GetArticleSummary 获取图文群发每日数据
 func (cube *DataCube) GetArticleSummary(s string, e string) (resArticleSummary ResArticleSummary, err error) {
-	accessToken, err := cube.GetAccessToken()
+	return cube.GetArticleSummaryContext(context.Background(), s, e)
+}
+
+// GetArticleSummaryContext 获取图文群发每日数据
+func (cube *DataCube) GetArticleSummaryContext(ctx context.Context, s string, e string) (resArticleSummary ResArticleSummary, err error) {
+	accessToken, err := cube.GetAccessTokenContext(ctx)
 	if err != nil {
 		return
 	}
@@ -151,7 +157,7 @@ func (cube *DataCube) GetArticleSummary(s string, e string) (resArticleSummary R
 		EndDate:   e,
 	}
 
-	response, err := util.PostJSON(uri, reqDate)
+	response, err := cube.PostJSONContext(ctx, uri, reqDate)
 	if err != nil {
 		return
 	}
@@ -162,7 +168,12 @@ func (cube *DataCube) GetArticleSummary(s string, e string) (resArticleSummary R
 
 // GetArticleTotal 获取图文群发总数据
 func (cube *DataCube) GetArticleTotal(s string, e string) (resArticleTotal ResArticleTotal, err error) {
-	accessToken, err := cube.GetAccessToken()
+	return cube.GetArticleTotalContext(context.Background(), s, e)
+}
+
+// GetArticleTotalContext 获取图文群发总数据
+func (cube *DataCube) GetArticleTotalContext(ctx context.Context, s string, e string) (resArticleTotal ResArticleTotal, err error) {
+	accessToken, err := cube.GetAccessTokenContext(ctx)
 	if err != nil {
 		return
 	}
@@ -173,7 +184,7 @@ func (cube *DataCube) GetArticleTotal(s string, e string) (resArticleTotal ResAr
 		EndDate:   e,
 	}
 
-	response, err := util.PostJSON(uri, reqDate)
+	response, err := cube.PostJSONContext(ctx, uri, reqDate)
 	if err != nil {
 		return
 	}
@@ -184,7 +195,12 @@ func (cube *DataCube) GetArticleTotal(s string, e string) (resArticleTotal ResAr
 
 // GetUserRead 获取图文统计数据
 func (cube *DataCube) GetUserRead(s string, e string) (resUserRead ResUserRead, err error) {
-	accessToken, err := cube.GetAccessToken()
+	return cube.GetUserReadContext(context.Background(), s, e)
+}
+
+// GetUserReadContext 获取图文统计数据
+func (cube *DataCube) GetUserReadContext(ctx context.Context, s string, e string) (resUserRead ResUserRead, err error) {
+	accessToken, err := cube.GetAccessTokenContext(ctx)
 	if err != nil {
 		return
 	}
@@ -195,7 +211,7 @@ func (cube *DataCube) GetUserRead(s string, e string) (resUserRead ResUserRead,
 		EndDate:   e,
 	}
 
-	response, err := util.PostJSON(uri, reqDate)
+	response, err := cube.PostJSONContext(ctx, uri, reqDate)
 	if err != nil {
 		return
 	}
@@ -206,7 +222,12 @@ func (cube *DataCube) GetUserRead(s string, e string) (resUserRead ResUserRead,
 
 // GetUserReadHour 获取图文统计分时数据
 func (cube *DataCube) GetUserReadHour(s string, e string) (resUserReadHour ResUserReadHour, err error) {
-	accessToken, err := cube.GetAccessToken()
+	return cube.GetUserReadHourContext(context.Background(), s, e)
+}
+
+// GetUserReadHourContext 获取图文统计分时数据
+func (cube *DataCube) GetUserReadHourContext(ctx context.Context, s string, e string) (resUserReadHour ResUserReadHour, err error) {
+	accessToken, err := cube.GetAccessTokenContext(ctx)
 	if err != nil {
 		return
 	}
@@ -217,7 +238,7 @@ func (cube *DataCube) GetUserReadHour(s string, e string) (resUserReadHour ResUs
 		EndDate:   e,
 	}
 
-	response, err := util.PostJSON(uri, reqDate)
+	response, err := cube.PostJSONContext(ctx, uri, reqDate)
 	if err != nil {
 		return
 	}
@@ -228,7 +249,12 @@ func (cube *DataCube) GetUserReadHour(s string, e string) (resUserReadHour ResUs
 
 // GetUserShare 获取图文分享转发数据
 func (cube *DataCube) GetUserShare(s string, e string) (resUserShare ResUserShare, err error) {
-	accessToken, err := cube.GetAccessToken()
+	return cube.GetUserShareContext(context.Background(), s, e)
+}
+
+// GetUserShareContext 获取图文分享转发数据
+func (cube *DataCube) GetUserShareContext(ctx context.Context, s string, e string) (resUserShare ResUserShare, err error) {
+	accessToken, err := cube.GetAccessTokenContext(ctx)
 	if err != nil {
 		return
 	}
@@ -239,7 +265,7 @@ func (cube *DataCube) GetUserShare(s string, e string) (resUserShare ResUserShar
 		EndDate:   e,
 	}
 
-	response, err := util.PostJSON(uri, reqDate)
+	response, err := cube.PostJSONContext(ctx, uri, reqDate)
 	if err != nil {
 		return
 	}
@@ -250,7 +276,12 @@ func (cube *DataCube) GetUserShare(s string, e string) (resUserShare ResUserShar
 
 // GetUserShareHour 获取图文分享转发分时数据
 func (cube *DataCube) GetUserShareHour(s string, e string) (resUserShareHour ResUserShareHour, err error) {
-	accessToken, err := cube.GetAccessToken()
+	return cube.GetUserShareHourContext(context.Background(), s, e)
+}
+
+// GetUserShareHourContext 获取图文分享转发分时数据
+func (cube *DataCube) GetUserShareHourContext(ctx context.Context, s string, e string) (resUserShareHour ResUserShareHour, err error) {
+	accessToken, err := cube.GetAccessTokenContext(ctx)
 	if err != nil {
 		return
 	}
@@ -261,7 +292,7 @@ func (cube *DataCube) GetUserShareHour(s string, e string) (resUserShareHour Res
 		EndDate:   e,
 	}
 
-	response, err := util.PostJSON(uri, reqDate)
+	response, err := cube.PostJSONContext(ctx, uri, reqDate)
 	if err != nil {
 		return
 	}
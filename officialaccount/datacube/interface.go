@@ -1,6 +1,7 @@
 package datacube
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/silenceper/wechat/v2/util"
@@ -40,7 +41,12 @@ type ResInterfaceSummaryHour struct {
 
 // GetInterfaceSummary 获取接口分析数据
 func (cube *DataCube) GetInterfaceSummary(s string, e string) (resInterfaceSummary ResInterfaceSummary, err error) {
-	accessToken, err := cube.GetAccessToken()
+	return cube.GetInterfaceSummaryContext(context.Background(), s, e)
+}
+
+// GetInterfaceSummaryContext 获取接口分析数据
+func (cube *DataCube) GetInterfaceSummaryContext(ctx context.Context, s string, e string) (resInterfaceSummary ResInterfaceSummary, err error) {
+	accessToken, err := cube.GetAccessTokenContext(ctx)
 	if err != nil {
 		return
 	}
@@ -51,7 +57,7 @@ func (cube *DataCube) GetInterfaceSummary(s string, e string) (resInterfaceSumma
 		EndDate:   e,
 	}
 
-	response, err := util.PostJSON(uri, reqDate)
+	response, err := cube.PostJSONContext(ctx, uri, reqDate)
 	if err != nil {
 		return
 	}
@@ -62,7 +68,12 @@ func (cube *DataCube) GetInterfaceSummary(s string, e string) (resInterfaceSumma
 
 // GetInterfaceSummaryHour 获取接口分析分时数据
 func (cube *DataCube) GetInterfaceSummaryHour(s string, e string) (resInterfaceSummaryHour ResInterfaceSummaryHour, err error) {
-	accessToken, err := cube.GetAccessToken()
+	return cube.GetInterfaceSummaryHourContext(context.Background(), s, e)
+}
+
+// GetInterfaceSummaryHourContext 获取接口分析分时数据
+func (cube *DataCube) GetInterfaceSummaryHourContext(ctx context.Context, s string, e string) (resInterfaceSummaryHour ResInterfaceSummaryHour, err error) {
+	accessToken, err := cube.GetAccessTokenContext(ctx)
 	if err != nil {
 		return
 	}
@@ -73,7 +84,7 @@ func (cube *DataCube) GetInterfaceSummaryHour(s string, e string) (resInterfaceS
 		EndDate:   e,
 	}
 
-	response, err := util.PostJSON(uri, reqDate)
+	response, err := cube.PostJSONContext(ctx, uri, reqDate)
 	if err != nil {
 		return
 	}
@@ -1,6 +1,7 @@
 package datacube
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/silenceper/wechat/v2/util"
@@ -100,7 +101,12 @@ type ResUpstreamMsgDistMonth struct {
 
 // GetUpstreamMsg 获取消息发送概况数据
 func (cube *DataCube) GetUpstreamMsg(s string, e string) (resUpstreamMsg ResUpstreamMsg, err error) {
-	accessToken, err := cube.GetAccessToken()
+	return cube.GetUpstreamMsgContext(context.Background(), s, e)
+}
+
+// GetUpstreamMsgContext 获取消息发送概况数据
+func (cube *DataCube) GetUpstreamMsgContext(ctx context.Context, s string, e string) (resUpstreamMsg ResUpstreamMsg, err error) {
+	accessToken, err := cube.GetAccessTokenContext(ctx)
 	if err != nil {
 		return
 	}
@@ -111,7 +117,7 @@ func (cube *DataCube) GetUpstreamMsg(s string, e string) (resUpstreamMsg ResUpst
 		EndDate:   e,
 	}
 
-	response, err := util.PostJSON(uri, reqDate)
+	response, err := cube.PostJSONContext(ctx, uri, reqDate)
 	if err != nil {
 		return
 	}
@@ -122,7 +128,12 @@ func (cube *DataCube) GetUpstreamMsg(s string, e string) (resUpstreamMsg ResUpst
 
 // GetUpstreamMsgHour 获取消息分送分时数据
 func (cube *DataCube) GetUpstreamMsgHour(s string, e string) (resUpstreamMsgHour ResUpstreamMsgHour, err error) {
-	accessToken, err := cube.GetAccessToken()
+	return cube.GetUpstreamMsgHourContext(context.Background(), s, e)
+}
+
+// GetUpstreamMsgHourContext 获取消息分送分时数据
+func (cube *DataCube) GetUpstreamMsgHourContext(ctx context.Context, s string, e string) (resUpstreamMsgHour ResUpstreamMsgHour, err error) {
+	accessToken, err := cube.GetAccessTokenContext(ctx)
 	if err != nil {
 		return
 	}
@@ -133,7 +144,7 @@ func (cube *DataCube) GetUpstreamMsgHour(s string, e string) (resUpstreamMsgHour
 		EndDate:   e,
 	}
 
-	response, err := util.PostJSON(uri, reqDate)
+	response, err := cube.PostJSONContext(ctx, uri, reqDate)
 	if err != nil {
 		return
 	}
@@ -144,7 +155,12 @@ func (cube *DataCube) GetUpstreamMsgHour(s string, e string) (resUpstreamMsgHour
 
 // GetUpstreamMsgWeek 获取消息发送周数据
 func (cube *DataCube) GetUpstreamMsgWeek(s string, e string) (resUpstreamMsgWeek ResUpstreamMsgWeek, err error) {
-	accessToken, err := cube.GetAccessToken()
+	return cube.GetUpstreamMsgWeekContext(context.Background(), s, e)
+}
+
+// GetUpstreamMsgWeekContext 获取消息发送周数据
+func (cube *DataCube) GetUpstreamMsgWeekContext(ctx context.Context, s string, e string) (resUpstreamMsgWeek ResUpstreamMsgWeek, err error) {
+	accessToken, err := cube.GetAccessTokenContext(ctx)
 	if err != nil {
 		return
 	}
@@ -155,7 +171,7 @@ func (cube *DataCube) GetUpstreamMsgWeek(s string, e string) (resUpstreamMsgWeek
 		EndDate:   e,
 	}
 
-	response, err := util.PostJSON(uri, reqDate)
+	response, err := cube.PostJSONContext(ctx, uri, reqDate)
 	if err != nil {
 		return
 	}
@@ -166,7 +182,12 @@ func (cube *DataCube) GetUpstreamMsgWeek(s string, e string) (resUpstreamMsgWeek
 
 // GetUpstreamMsgMonth 获取消息发送月数据
 func (cube *DataCube) GetUpstreamMsgMonth(s string, e string) (resUpstreamMsgMonth ResUpstreamMsgMonth, err error) {
-	accessToken, err := cube.GetAccessToken()
+	return cube.GetUpstreamMsgMonthContext(context.Background(), s, e)
+}
+
+// GetUpstreamMsgMonthContext 获取消息发送月数据
+func (cube *DataCube) GetUpstreamMsgMonthContext(ctx context.Context, s string, e string) (resUpstreamMsgMonth ResUpstreamMsgMonth, err error) {
+	accessToken, err := cube.GetAccessTokenContext(ctx)
 	if err != nil {
 		return
 	}
@@ -177,7 +198,7 @@ func (cube *DataCube) GetUpstreamMsgMonth(s string, e string) (resUpstreamMsgMon
 		EndDate:   e,
 	}
 
-	response, err := util.PostJSON(uri, reqDate)
+	response, err := cube.PostJSONContext(ctx, uri, reqDate)
 	if err != nil {
 		return
 	}
@@ -188,7 +209,12 @@ func (cube *DataCube) GetUpstreamMsgMonth(s string, e string) (resUpstreamMsgMon
 
 // GetUpstreamMsgDist 获取消息发送分布数据
 func (cube *DataCube) GetUpstreamMsgDist(s string, e string) (resUpstreamMsgDist ResUpstreamMsgDist, err error) {
-	accessToken, err := cube.GetAccessToken()
+	return cube.GetUpstreamMsgDistContext(context.Background(), s, e)
+}
+
+// GetUpstreamMsgDistContext 获取消息发送分布数据
+func (cube *DataCube) GetUpstreamMsgDistContext(ctx context.Context, s string, e string) (resUpstreamMsgDist ResUpstreamMsgDist, err error) {
+	accessToken, err := cube.GetAccessTokenContext(ctx)
 	if err != nil {
 		return
 	}
@@ -199,7 +225,7 @@ func (cube *DataCube) GetUpstreamMsgDist(s string, e string) (resUpstreamMsgDist
 		EndDate:   e,
 	}
 
-	response, err := util.PostJSON(uri, reqDate)
+	response, err := cube.PostJSONContext(ctx, uri, reqDate)
 	if err != nil {
 		return
 	}
@@ -210,7 +236,12 @@ func (cube *DataCube) GetUpstreamMsgDist(s string, e string) (resUpstreamMsgDist
 
 // GetUpstreamMsgDistWeek 获取消息发送分布周数据
 func (cube *DataCube) GetUpstreamMsgDistWeek(s string, e string) (resUpstreamMsgDistWeek ResUpstreamMsgDistWeek, err error) {
-	accessToken, err := cube.GetAccessToken()
+	return cube.GetUpstreamMsgDistWeekContext(context.Background(), s, e)
+}
+
+// GetUpstreamMsgDistWeekContext 获取消息发送分布周数据
+func (cube *DataCube) GetUpstreamMsgDistWeekContext(ctx context.Context, s string, e string) (resUpstreamMsgDistWeek ResUpstreamMsgDistWeek, err error) {
+	accessToken, err := cube.GetAccessTokenContext(ctx)
 	if err != nil {
 		return
 	}
@@ -221,7 +252,7 @@ func (cube *DataCube) GetUpstreamMsgDistWeek(s string, e string) (resUpstreamMsg
 		EndDate:   e,
 	}
 
-	response, err := util.PostJSON(uri, reqDate)
+	response, err := cube.PostJSONContext(ctx, uri, reqDate)
 	if err != nil {
 		return
 	}
@@ -232,7 +263,12 @@ func (cube *DataCube) GetUpstreamMsgDistWeek(s string, e string) (resUpstreamMsg
 
 // GetUpstreamMsgDistMonth 获取消息发送分布月数据
 func (cube *DataCube) GetUpstreamMsgDistMonth(s string, e string) (resUpstreamMsgDistMonth ResUpstreamMsgDistMonth, err error) {
-	accessToken, err := cube.GetAccessToken()
+	return cube.GetUpstreamMsgDistMonthContext(context.Background(), s, e)
+}
+
+// GetUpstreamMsgDistMonthContext 获取消息发送分布月数据
+func (cube *DataCube) GetUpstreamMsgDistMonthContext(ctx context.Context, s string, e string) (resUpstreamMsgDistMonth ResUpstreamMsgDistMonth, err error) {
+	accessToken, err := cube.GetAccessTokenContext(ctx)
 	if err != nil {
 		return
 	}
@@ -243,7 +279,7 @@ func (cube *DataCube) GetUpstreamMsgDistMonth(s string, e string) (resUpstreamMs
 		EndDate:   e,
 	}
 
-	response, err := util.PostJSON(uri, reqDate)
+	response, err := cube.PostJSONContext(ctx, uri, reqDate)
 	if err != nil {
 		return
 	}
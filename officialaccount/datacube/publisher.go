@@ -1,6 +1,7 @@
 package datacube
 
 import (
+	"context"
 	"fmt"
 	"net/url"
 	"strconv"
@@ -164,7 +165,12 @@ type ParamsPublisher struct {
 
 // fetchData 拉取统计数据
 func (cube *DataCube) fetchData(params ParamsPublisher) (response []byte, err error) {
-	accessToken, err := cube.GetAccessToken()
+	return cube.fetchDataContext(context.Background(), params)
+}
+
+// fetchDataContext 拉取统计数据
+func (cube *DataCube) fetchDataContext(ctx context.Context, params ParamsPublisher) (response []byte, err error) {
+	accessToken, err := cube.GetAccessTokenContext(ctx)
 	if err != nil {
 		return
 	}
@@ -182,7 +188,7 @@ func (cube *DataCube) fetchData(params ParamsPublisher) (response []byte, err er
 
 	uri := fmt.Sprintf("%s?%s", publisherURL, v.Encode())
 
-	response, err = util.HTTPGet(uri)
+	response, err = cube.HTTPGetContext(ctx, uri)
 	if err != nil {
 		return
 	}
@@ -191,6 +197,11 @@ func (cube *DataCube) fetchData(params ParamsPublisher) (response []byte, err er
 
 // GetPublisherAdPosGeneral 获取公众号分广告位数据
 func (cube *DataCube) GetPublisherAdPosGeneral(startDate, endDate string, page, pageSize int, adSlot AdSlot) (resPublisherAdPos ResPublisherAdPos, err error) {
+	return cube.GetPublisherAdPosGeneralContext(context.Background(), startDate, endDate, page, pageSize, adSlot)
+}
+
+// GetPublisherAdPosGeneralContext 获取公众号分广告位数据
+func (cube *DataCube) GetPublisherAdPosGeneralContext(ctx context.Context, startDate, endDate string, page, pageSize int, adSlot AdSlot) (resPublisherAdPos ResPublisherAdPos, err error) {
 	params := ParamsPublisher{
 		Action:    actionPublisherAdPosGeneral,
 		StartDate: startDate,
@@ -200,7 +211,7 @@ func (cube *DataCube) GetPublisherAdPosGeneral(startDate, endDate string, page,
 		AdSlot:    adSlot,
 	}
 
-	response, err := cube.fetchData(params)
+	response, err := cube.fetchDataContext(ctx, params)
 	if err != nil {
 		return
 	}
@@ -219,6 +230,11 @@ func (cube *DataCube) GetPublisherAdPosGeneral(startDate, endDate string, page,
 
 // GetPublisherCpsGeneral 获取公众号返佣商品数据
 func (cube *DataCube) GetPublisherCpsGeneral(startDate, endDate string, page, pageSize int) (resPublisherCps ResPublisherCps, err error) {
+	return cube.GetPublisherCpsGeneralContext(context.Background(), startDate, endDate, page, pageSize)
+}
+
+// GetPublisherCpsGeneralContext 获取公众号返佣商品数据
+func (cube *DataCube) GetPublisherCpsGeneralContext(ctx context.Context, startDate, endDate string, page, pageSize int) (resPublisherCps ResPublisherCps, err error) {
 	params := ParamsPublisher{
 		Action:    actionPublisherCpsGeneral,
 		StartDate: startDate,
@@ -227,7 +243,7 @@ func (cube *DataCube) GetPublisherCpsGeneral(startDate, endDate string, page, pa
 		PageSize:  pageSize,
 	}
 
-	response, err := cube.fetchData(params)
+	response, err := cube.fetchDataContext(ctx, params)
 	if err != nil {
 		return
 	}
@@ -246,6 +262,11 @@ func (cube *DataCube) GetPublisherCpsGeneral(startDate, endDate string, page, pa
 
 // GetPublisherSettlement 获取公众号结算收入数据及结算主体信息
 func (cube *DataCube) GetPublisherSettlement(startDate, endDate string, page, pageSize int) (resPublisherSettlement ResPublisherSettlement, err error) {
+	return cube.GetPublisherSettlementContext(context.Background(), startDate, endDate, page, pageSize)
+}
+
+// GetPublisherSettlementContext 获取公众号结算收入数据及结算主体信息
+func (cube *DataCube) GetPublisherSettlementContext(ctx context.Context, startDate, endDate string, page, pageSize int) (resPublisherSettlement ResPublisherSettlement, err error) {
 	params := ParamsPublisher{
 		Action:    actionPublisherSettlement,
 		StartDate: startDate,
@@ -254,7 +275,7 @@ func (cube *DataCube) GetPublisherSettlement(startDate, endDate string, page, pa
 		PageSize:  pageSize,
 	}
 
-	response, err := cube.fetchData(params)
+	response, err := cube.fetchDataContext(ctx, params)
 	if err != nil {
 		return
 	}
@@ -1,6 +1,7 @@
 package datacube
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/silenceper/wechat/v2/util"
@@ -35,7 +36,12 @@ type ResUserAccumulate struct {
 
 // GetUserSummary 获取用户增减数据
 func (cube *DataCube) GetUserSummary(s string, e string) (resUserSummary ResUserSummary, err error) {
-	accessToken, err := cube.GetAccessToken()
+	return cube.GetUserSummaryContext(context.Background(), s, e)
+}
+
+// GetUserSummaryContext 获取用户增减数据
+func (cube *DataCube) GetUserSummaryContext(ctx context.Context, s string, e string) (resUserSummary ResUserSummary, err error) {
+	accessToken, err := cube.GetAccessTokenContext(ctx)
 	if err != nil {
 		return
 	}
@@ -46,7 +52,7 @@ func (cube *DataCube) GetUserSummary(s string, e string) (resUserSummary ResUser
 		EndDate:   e,
 	}
 
-	response, err := util.PostJSON(uri, reqDate)
+	response, err := cube.PostJSONContext(ctx, uri, reqDate)
 	if err != nil {
 		return
 	}
@@ -57,7 +63,12 @@ func (cube *DataCube) GetUserSummary(s string, e string) (resUserSummary ResUser
 
 // GetUserAccumulate 获取累计用户数据
 func (cube *DataCube) GetUserAccumulate(s string, e string) (resUserAccumulate ResUserAccumulate, err error) {
-	accessToken, err := cube.GetAccessToken()
+	return cube.GetUserAccumulateContext(context.Background(), s, e)
+}
+
+// GetUserAccumulateContext 获取累计用户数据
+func (cube *DataCube) GetUserAccumulateContext(ctx context.Context, s string, e string) (resUserAccumulate ResUserAccumulate, err error) {
+	accessToken, err := cube.GetAccessTokenContext(ctx)
 	if err != nil {
 		return
 	}
@@ -68,7 +79,7 @@ func (cube *DataCube) GetUserAccumulate(s string, e string) (resUserAccumulate R
 		EndDate:   e,
 	}
 
-	response, err := util.PostJSON(uri, reqDate)
+	response, err := cube.PostJSONContext(ctx, uri, reqDate)
 	if err != nil {
 		return
 	}
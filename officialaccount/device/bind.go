@@ -1,6 +1,7 @@
 package device
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -19,13 +20,18 @@ type resBind struct {
 
 // Bind 设备绑定
 func (d *Device) Bind(req ReqBind) (err error) {
+	return d.BindContext(context.Background(), req)
+}
+
+// BindContext 设备绑定
+func (d *Device) BindContext(ctx context.Context, req ReqBind) (err error) {
 	var accessToken string
-	if accessToken, err = d.GetAccessToken(); err != nil {
+	if accessToken, err = d.GetAccessTokenContext(ctx); err != nil {
 		return
 	}
 	uri := fmt.Sprintf("%s?access_token=%s", uriBind, accessToken)
 	var response []byte
-	if response, err = util.PostJSON(uri, req); err != nil {
+	if response, err = d.PostJSONContext(ctx, uri, req); err != nil {
 		return
 	}
 	var result resBind
@@ -41,13 +47,18 @@ func (d *Device) Bind(req ReqBind) (err error) {
 
 // Unbind 设备解绑
 func (d *Device) Unbind(req ReqBind) (err error) {
+	return d.UnbindContext(context.Background(), req)
+}
+
+// UnbindContext 设备解绑
+func (d *Device) UnbindContext(ctx context.Context, req ReqBind) (err error) {
 	var accessToken string
-	if accessToken, err = d.GetAccessToken(); err != nil {
+	if accessToken, err = d.GetAccessTokenContext(ctx); err != nil {
 		return
 	}
 	uri := fmt.Sprintf("%s?access_token=%s", uriUnbind, accessToken)
 	var response []byte
-	if response, err = util.PostJSON(uri, req); err != nil {
+	if response, err = d.PostJSONContext(ctx, uri, req); err != nil {
 		return
 	}
 	var result resBind
@@ -63,13 +74,18 @@ func (d *Device) Unbind(req ReqBind) (err error) {
 
 // CompelBind 强制绑定用户和设备
 func (d *Device) CompelBind(req ReqBind) (err error) {
+	return d.CompelBindContext(context.Background(), req)
+}
+
+// CompelBindContext 强制绑定用户和设备
+func (d *Device) CompelBindContext(ctx context.Context, req ReqBind) (err error) {
 	var accessToken string
-	if accessToken, err = d.GetAccessToken(); err != nil {
+	if accessToken, err = d.GetAccessTokenContext(ctx); err != nil {
 		return
 	}
 	uri := fmt.Sprintf("%s?access_token=%s", uriCompelBind, accessToken)
 	var response []byte
-	if response, err = util.PostJSON(uri, req); err != nil {
+	if response, err = d.PostJSONContext(ctx, uri, req); err != nil {
 		return
 	}
 	var result resBind
@@ -85,13 +101,18 @@ func (d *Device) CompelBind(req ReqBind) (err error) {
 
 // CompelUnbind 强制解绑用户和设备
 func (d *Device) CompelUnbind(req ReqBind) (err error) {
+	return d.CompelUnbindContext(context.Background(), req)
+}
+
+// CompelUnbindContext 强制解绑用户和设备
+func (d *Device) CompelUnbindContext(ctx context.Context, req ReqBind) (err error) {
 	var accessToken string
-	if accessToken, err = d.GetAccessToken(); err != nil {
+	if accessToken, err = d.GetAccessTokenContext(ctx); err != nil {
 		return
 	}
 	uri := fmt.Sprintf("%s?access_token=%s", uriCompelUnbind, accessToken)
 	var response []byte
-	if response, err = util.PostJSON(uri, req); err != nil {
+	if response, err = d.PostJSONContext(ctx, uri, req); err != nil {
 		return
 	}
 	var result resBind
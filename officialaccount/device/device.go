@@ -1,6 +1,7 @@
 package device
 
 import (
+	context2 "context"
 	"encoding/json"
 	"fmt"
 
@@ -40,13 +41,18 @@ type ResDeviceState struct {
 
 // State 设备状态查询
 func (d *Device) State(device string) (res ResDeviceState, err error) {
+	return d.StateContext(context2.Background(), device)
+}
+
+// StateContext 设备状态查询
+func (d *Device) StateContext(ctx context2.Context, device string) (res ResDeviceState, err error) {
 	var accessToken string
-	if accessToken, err = d.GetAccessToken(); err != nil {
+	if accessToken, err = d.GetAccessTokenContext(ctx); err != nil {
 		return
 	}
 	uri := fmt.Sprintf("%s?access_token=%s&device_id=%s", uriState, accessToken, device)
 	var response []byte
-	if response, err = util.HTTPGet(uri); err != nil {
+	if response, err = d.HTTPGetContext(ctx, uri); err != nil {
 		return
 	}
 	if err = json.Unmarshal(response, &res); err != nil {
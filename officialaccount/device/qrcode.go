@@ -1,6 +1,7 @@
 package device
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -19,8 +20,13 @@ type ResCreateQRCode struct {
 
 // CreateQRCode 获取设备二维码
 func (d *Device) CreateQRCode(devices []string) (res ResCreateQRCode, err error) {
+	return d.CreateQRCodeContext(context.Background(), devices)
+}
+
+// CreateQRCodeContext 获取设备二维码
+func (d *Device) CreateQRCodeContext(ctx context.Context, devices []string) (res ResCreateQRCode, err error) {
 	var accessToken string
-	if accessToken, err = d.GetAccessToken(); err != nil {
+	if accessToken, err = d.GetAccessTokenContext(ctx); err != nil {
 		return
 	}
 	uri := fmt.Sprintf("%s?access_token=%s", uriQRCode, accessToken)
@@ -29,7 +35,7 @@ func (d *Device) CreateQRCode(devices []string) (res ResCreateQRCode, err error)
 		"device_id_list": devices,
 	}
 	var response []byte
-	if response, err = util.PostJSON(uri, req); err != nil {
+	if response, err = d.PostJSONContext(ctx, uri, req); err != nil {
 		return
 	}
 	if err = json.Unmarshal(response, &res); err != nil {
@@ -52,8 +58,13 @@ type ResVerifyQRCode struct {
 
 // VerifyQRCode 验证设备二维码
 func (d *Device) VerifyQRCode(ticket string) (res ResVerifyQRCode, err error) {
+	return d.VerifyQRCodeContext(context.Background(), ticket)
+}
+
+// VerifyQRCodeContext 验证设备二维码
+func (d *Device) VerifyQRCodeContext(ctx context.Context, ticket string) (res ResVerifyQRCode, err error) {
 	var accessToken string
-	if accessToken, err = d.GetAccessToken(); err != nil {
+	if accessToken, err = d.GetAccessTokenContext(ctx); err != nil {
 		return
 	}
 	uri := fmt.Sprintf("%s?access_token=%s", uriVerifyQRCode, accessToken)
@@ -62,7 +73,7 @@ func (d *Device) VerifyQRCode(ticket string) (res ResVerifyQRCode, err error) {
 	}
 
 	var response []byte
-	if response, err = util.PostJSON(uri, req); err != nil {
+	if response, err = d.PostJSONContext(ctx, uri, req); err != nil {
 		return
 	}
 	if err = json.Unmarshal(response, &res); err != nil {
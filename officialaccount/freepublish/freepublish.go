@@ -1,6 +1,7 @@
 package freepublish
 
 import (
+	context2 "context"
 	"fmt"
 
 	"github.com/silenceper/wechat/v2/officialaccount/context"
@@ -50,8 +51,14 @@ func NewFreePublish(ctx *context.Context) *FreePublish {
 // Publish 发布接口。需要先将图文素材以草稿的形式保存（见“草稿箱/新建草稿”，
 // 如需从已保存的草稿中选择，见“草稿箱/获取草稿列表”），选择要发布的草稿 media_id 进行发布
 func (freePublish *FreePublish) Publish(mediaID string) (publishID int64, err error) {
+	return freePublish.PublishContext(context2.Background(), mediaID)
+}
+
+// PublishContext 发布接口。需要先将图文素材以草稿的形式保存（见“草稿箱/新建草稿”，
+// 如需从已保存的草稿中选择，见“草稿箱/获取草稿列表”），选择要发布的草稿 media_id 进行发布
+func (freePublish *FreePublish) PublishContext(ctx context2.Context, mediaID string) (publishID int64, err error) {
 	var accessToken string
-	accessToken, err = freePublish.GetAccessToken()
+	accessToken, err = freePublish.GetAccessTokenContext(ctx)
 	if err != nil {
 		return
 	}
@@ -63,7 +70,7 @@ func (freePublish *FreePublish) Publish(mediaID string) (publishID int64, err er
 
 	var response []byte
 	uri := fmt.Sprintf("%s?access_token=%s", publishURL, accessToken)
-	response, err = util.PostJSON(uri, req)
+	response, err = freePublish.PostJSONContext(ctx, uri, req)
 	if err != nil {
 		return
 	}
@@ -105,7 +112,12 @@ type PublishArticleItem struct {
 
 // SelectStatus 发布状态轮询接口
 func (freePublish *FreePublish) SelectStatus(publishID int64) (list PublishStatusList, err error) {
-	accessToken, err := freePublish.GetAccessToken()
+	return freePublish.SelectStatusContext(context2.Background(), publishID)
+}
+
+// SelectStatusContext 发布状态轮询接口
+func (freePublish *FreePublish) SelectStatusContext(ctx context2.Context, publishID int64) (list PublishStatusList, err error) {
+	accessToken, err := freePublish.GetAccessTokenContext(ctx)
 	if err != nil {
 		return
 	}
@@ -117,7 +129,7 @@ func (freePublish *FreePublish) SelectStatus(publishID int64) (list PublishStatu
 
 	var response []byte
 	uri := fmt.Sprintf("%s?access_token=%s", selectStateURL, accessToken)
-	response, err = util.PostJSON(uri, req)
+	response, err = freePublish.PostJSONContext(ctx, uri, req)
 	if err != nil {
 		return
 	}
@@ -130,7 +142,14 @@ func (freePublish *FreePublish) SelectStatus(publishID int64) (list PublishStatu
 // index 要删除的文章在图文消息中的位置，第一篇编号为1，该字段不填或填0会删除全部文章
 // !!!此操作不可逆，请谨慎操作!!!删除后微信公众号后台仍然会有记录!!!
 func (freePublish *FreePublish) Delete(articleID string, index uint) (err error) {
-	accessToken, err := freePublish.GetAccessToken()
+	return freePublish.DeleteContext(context2.Background(), articleID, index)
+}
+
+// DeleteContext 删除发布。
+// index 要删除的文章在图文消息中的位置，第一篇编号为1，该字段不填或填0会删除全部文章
+// !!!此操作不可逆，请谨慎操作!!!删除后微信公众号后台仍然会有记录!!!
+func (freePublish *FreePublish) DeleteContext(ctx context2.Context, articleID string, index uint) (err error) {
+	accessToken, err := freePublish.GetAccessTokenContext(ctx)
 	if err != nil {
 		return err
 	}
@@ -144,7 +163,7 @@ func (freePublish *FreePublish) Delete(articleID string, index uint) (err error)
 
 	var response []byte
 	uri := fmt.Sprintf("%s?access_token=%s", deleteURL, accessToken)
-	response, err = util.PostJSON(uri, req)
+	response, err = freePublish.PostJSONContext(ctx, uri, req)
 	if err != nil {
 		return err
 	}
@@ -169,7 +188,12 @@ type Article struct {
 
 // First 通过 article_id 获取已发布文章
 func (freePublish *FreePublish) First(articleID string) (list []Article, err error) {
-	accessToken, err := freePublish.GetAccessToken()
+	return freePublish.FirstContext(context2.Background(), articleID)
+}
+
+// FirstContext 通过 article_id 获取已发布文章
+func (freePublish *FreePublish) FirstContext(ctx context2.Context, articleID string) (list []Article, err error) {
+	accessToken, err := freePublish.GetAccessTokenContext(ctx)
 	if err != nil {
 		return
 	}
@@ -181,7 +205,7 @@ func (freePublish *FreePublish) First(articleID string) (list []Article, err err
 
 	var response []byte
 	uri := fmt.Sprintf("%s?access_token=%s", firstArticleURL, accessToken)
-	response, err = util.PostJSON(uri, req)
+	response, err = freePublish.PostJSONContext(ctx, uri, req)
 	if err != nil {
 		return
 	}
@@ -221,8 +245,13 @@ type ArticleListContent struct {
 
 // Paginate 获取成功发布列表
 func (freePublish *FreePublish) Paginate(offset, count int64, noReturnContent bool) (list ArticleList, err error) {
+	return freePublish.PaginateContext(context2.Background(), offset, count, noReturnContent)
+}
+
+// PaginateContext 获取成功发布列表
+func (freePublish *FreePublish) PaginateContext(ctx context2.Context, offset, count int64, noReturnContent bool) (list ArticleList, err error) {
 	var accessToken string
-	accessToken, err = freePublish.GetAccessToken()
+	accessToken, err = freePublish.GetAccessTokenContext(ctx)
 	if err != nil {
 		return
 	}
@@ -238,7 +267,7 @@ func (freePublish *FreePublish) Paginate(offset, count int64, noReturnContent bo
 
 	var response []byte
 	uri := fmt.Sprintf("%s?access_token=%s", paginateURL, accessToken)
-	response, err = util.PostJSON(uri, req)
+	response, err = freePublish.PostJSONContext(ctx, uri, req)
 	if err != nil {
 		return
 	}
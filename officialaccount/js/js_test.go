@@ -0,0 +1,51 @@
+package js
+
+import (
+	context2 "context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+
+	"github.com/silenceper/wechat/v2/cache"
+	"github.com/silenceper/wechat/v2/officialaccount/config"
+	"github.com/silenceper/wechat/v2/officialaccount/context"
+)
+
+type stubAccessToken struct{}
+
+func (stubAccessToken) GetAccessToken() (string, error) {
+	return "mock-access-token", nil
+}
+
+type countLimiter struct {
+	waits int
+}
+
+func (l *countLimiter) Wait(context2.Context, string, string) error {
+	l.waits++
+	return nil
+}
+
+func (l *countLimiter) Observe(string, string, int64) {}
+
+func TestGetConfigRateLimit(t *testing.T) {
+	defer gock.Off()
+	gock.New("https://api.weixin.qq.com").
+		Get("/cgi-bin/ticket/getticket").
+		MatchParam("access_token", "mock-access-token").
+		Reply(200).
+		JSON(map[string]interface{}{"errcode": 0, "ticket": "mock-ticket", "expires_in": 7200})
+
+	limiter := &countLimiter{}
+	js := NewJs(&context.Context{
+		Config:            &config.Config{AppID: "appid", Cache: cache.NewMemory(), RateLimiter: limiter},
+		AccessTokenHandle: stubAccessToken{},
+	})
+	cfg, err := js.GetConfig("https://example.com")
+	assert.Nil(t, err)
+	assert.Equal(t, "appid", cfg.AppID)
+	// 获取 ticket 的请求经过限流
+	assert.Equal(t, 1, limiter.waits)
+	assert.True(t, gock.IsDone())
+}
@@ -1,6 +1,7 @@
 package material
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -39,15 +40,20 @@ type Media struct {
 
 // MediaUpload 临时素材上传
 func (material *Material) MediaUpload(mediaType MediaType, filename string) (media Media, err error) {
+	return material.MediaUploadContext(context.Background(), mediaType, filename)
+}
+
+// MediaUploadContext 临时素材上传
+func (material *Material) MediaUploadContext(ctx context.Context, mediaType MediaType, filename string) (media Media, err error) {
 	var accessToken string
-	accessToken, err = material.GetAccessToken()
+	accessToken, err = material.GetAccessTokenContext(ctx)
 	if err != nil {
 		return
 	}
 
 	uri := fmt.Sprintf("%s?access_token=%s&type=%s", mediaUploadURL, accessToken, mediaType)
 	var response []byte
-	response, err = util.PostFile("media", filename, uri)
+	response, err = material.PostFileContext(ctx, "media", filename, uri)
 	if err != nil {
 		return
 	}
@@ -65,8 +71,14 @@ func (material *Material) MediaUpload(mediaType MediaType, filename string) (med
 // GetMediaURL 返回临时素材的下载地址供用户自己处理
 // NOTICE: URL 不可公开，因为含access_token 需要立即另存文件
 func (material *Material) GetMediaURL(mediaID string) (mediaURL string, err error) {
+	return material.GetMediaURLContext(context.Background(), mediaID)
+}
+
+// GetMediaURLContext 返回临时素材的下载地址供用户自己处理
+// NOTICE: URL 不可公开，因为含access_token 需要立即另存文件
+func (material *Material) GetMediaURLContext(ctx context.Context, mediaID string) (mediaURL string, err error) {
 	var accessToken string
-	accessToken, err = material.GetAccessToken()
+	accessToken, err = material.GetAccessTokenContext(ctx)
 	if err != nil {
 		return
 	}
@@ -83,15 +95,20 @@ type resMediaImage struct {
 
 // ImageUpload 图片上传
 func (material *Material) ImageUpload(filename string) (url string, err error) {
+	return material.ImageUploadContext(context.Background(), filename)
+}
+
+// ImageUploadContext 图片上传
+func (material *Material) ImageUploadContext(ctx context.Context, filename string) (url string, err error) {
 	var accessToken string
-	accessToken, err = material.GetAccessToken()
+	accessToken, err = material.GetAccessTokenContext(ctx)
 	if err != nil {
 		return
 	}
 
 	uri := fmt.Sprintf("%s?access_token=%s", mediaUploadImageURL, accessToken)
 	var response []byte
-	response, err = util.PostFile("media", filename, uri)
+	response, err = material.PostFileContext(ctx, "media", filename, uri)
 	if err != nil {
 		return
 	}
@@ -1,6 +1,7 @@
 package menu
 
 import (
+	context2 "context"
 	"encoding/json"
 	"fmt"
 
@@ -120,7 +121,12 @@ func NewMenu(context *context.Context) *Menu {
 
 // SetMenu 设置按钮
 func (menu *Menu) SetMenu(buttons []*Button) error {
-	accessToken, err := menu.GetAccessToken()
+	return menu.SetMenuContext(context2.Background(), buttons)
+}
+
+// SetMenuContext 设置按钮
+func (menu *Menu) SetMenuContext(ctx context2.Context, buttons []*Button) error {
+	accessToken, err := menu.GetAccessTokenContext(ctx)
 	if err != nil {
 		return err
 	}
@@ -130,7 +136,7 @@ func (menu *Menu) SetMenu(buttons []*Button) error {
 		Button: buttons,
 	}
 
-	response, err := util.PostJSON(uri, reqMenu)
+	response, err := menu.PostJSONContext(ctx, uri, reqMenu)
 	if err != nil {
 		return err
 	}
@@ -140,14 +146,19 @@ func (menu *Menu) SetMenu(buttons []*Button) error {
 
 // SetMenuByJSON 设置按钮
 func (menu *Menu) SetMenuByJSON(jsonInfo string) error {
-	accessToken, err := menu.GetAccessToken()
+	return menu.SetMenuByJSONContext(context2.Background(), jsonInfo)
+}
+
+// SetMenuByJSONContext 设置按钮
+func (menu *Menu) SetMenuByJSONContext(ctx context2.Context, jsonInfo string) error {
+	accessToken, err := menu.GetAccessTokenContext(ctx)
 	if err != nil {
 		return err
 	}
 
 	uri := fmt.Sprintf("%s?access_token=%s", menuCreateURL, accessToken)
 
-	response, err := util.HTTPPost(uri, jsonInfo)
+	response, err := menu.HTTPPostContext(ctx, uri, []byte(jsonInfo), nil)
 	if err != nil {
 		return err
 	}
@@ -157,14 +168,19 @@ func (menu *Menu) SetMenuByJSON(jsonInfo string) error {
 
 // GetMenu 获取菜单配置
 func (menu *Menu) GetMenu() (resMenu ResMenu, err error) {
+	return menu.GetMenuContext(context2.Background())
+}
+
+// GetMenuContext 获取菜单配置
+func (menu *Menu) GetMenuContext(ctx context2.Context) (resMenu ResMenu, err error) {
 	var accessToken string
-	accessToken, err = menu.GetAccessToken()
+	accessToken, err = menu.GetAccessTokenContext(ctx)
 	if err != nil {
 		return
 	}
 	uri := fmt.Sprintf("%s?access_token=%s", menuGetURL, accessToken)
 	var response []byte
-	response, err = util.HTTPGet(uri)
+	response, err = menu.HTTPGetContext(ctx, uri)
 	if err != nil {
 		return
 	}
@@ -181,12 +197,17 @@ func (menu *Menu) GetMenu() (resMenu ResMenu, err error) {
 
 // DeleteMenu 删除菜单
 func (menu *Menu) DeleteMenu() error {
-	accessToken, err := menu.GetAccessToken()
+	return menu.DeleteMenuContext(context2.Background())
+}
+
+// DeleteMenuContext 删除菜单
+func (menu *Menu) DeleteMenuContext(ctx context2.Context) error {
+	accessToken, err := menu.GetAccessTokenContext(ctx)
 	if err != nil {
 		return err
 	}
 	uri := fmt.Sprintf("%s?access_token=%s", menuDeleteURL, accessToken)
-	response, err := util.HTTPGet(uri)
+	response, err := menu.HTTPGetContext(ctx, uri)
 	if err != nil {
 		return err
 	}
@@ -196,7 +217,12 @@ func (menu *Menu) DeleteMenu() error {
 
 // AddConditional 添加个性化菜单
 func (menu *Menu) AddConditional(buttons []*Button, matchRule *MatchRule) error {
-	accessToken, err := menu.GetAccessToken()
+	return menu.AddConditionalContext(context2.Background(), buttons, matchRule)
+}
+
+// AddConditionalContext 添加个性化菜单
+func (menu *Menu) AddConditionalContext(ctx context2.Context, buttons []*Button, matchRule *MatchRule) error {
+	accessToken, err := menu.GetAccessTokenContext(ctx)
 	if err != nil {
 		return err
 	}
@@ -207,7 +233,7 @@ func (menu *Menu) AddConditional(buttons []*Button, matchRule *MatchRule) error
 		MatchRule: matchRule,
 	}
 
-	response, err := util.PostJSON(uri, reqMenu)
+	response, err := menu.PostJSONContext(ctx, uri, reqMenu)
 	if err != nil {
 		return err
 	}
@@ -217,13 +243,18 @@ func (menu *Menu) AddConditional(buttons []*Button, matchRule *MatchRule) error
 
 // AddConditionalByJSON 添加个性化菜单
 func (menu *Menu) AddConditionalByJSON(jsonInfo string) error {
-	accessToken, err := menu.GetAccessToken()
+	return menu.AddConditionalByJSONContext(context2.Background(), jsonInfo)
+}
+
+// AddConditionalByJSONContext 添加个性化菜单
+func (menu *Menu) AddConditionalByJSONContext(ctx context2.Context, jsonInfo string) error {
+	accessToken, err := menu.GetAccessTokenContext(ctx)
 	if err != nil {
 		return err
 	}
 
 	uri := fmt.Sprintf("%s?access_token=%s", menuAddConditionalURL, accessToken)
-	response, err := util.HTTPPost(uri, jsonInfo)
+	response, err := menu.HTTPPostContext(ctx, uri, []byte(jsonInfo), nil)
 	if err != nil {
 		return err
 	}
@@ -233,7 +264,12 @@ func (menu *Menu) AddConditionalByJSON(jsonInfo string) error {
 
 // DeleteConditional 删除个性化菜单
 func (menu *Menu) DeleteConditional(menuID int64) error {
-	accessToken, err := menu.GetAccessToken()
+	return menu.DeleteConditionalContext(context2.Background(), menuID)
+}
+
+// DeleteConditionalContext 删除个性化菜单
+func (menu *Menu) DeleteConditionalContext(ctx context2.Context, menuID int64) error {
+	accessToken, err := menu.GetAccessTokenContext(ctx)
 	if err != nil {
 		return err
 	}
@@ -243,7 +279,7 @@ func (menu *Menu) DeleteConditional(menuID int64) error {
 		MenuID: menuID,
 	}
 
-	response, err := util.PostJSON(uri, reqDeleteConditional)
+	response, err := menu.PostJSONContext(ctx, uri, reqDeleteConditional)
 	if err != nil {
 		return err
 	}
@@ -253,15 +289,20 @@ func (menu *Menu) DeleteConditional(menuID int64) error {
 
 // MenuTryMatch 菜单匹配
 func (menu *Menu) MenuTryMatch(userID string) (buttons []Button, err error) {
+	return menu.MenuTryMatchContext(context2.Background(), userID)
+}
+
+// MenuTryMatchContext 菜单匹配
+func (menu *Menu) MenuTryMatchContext(ctx context2.Context, userID string) (buttons []Button, err error) {
 	var accessToken string
-	accessToken, err = menu.GetAccessToken()
+	accessToken, err = menu.GetAccessTokenContext(ctx)
 	if err != nil {
 		return
 	}
 	uri := fmt.Sprintf("%s?access_token=%s", menuTryMatchURL, accessToken)
 	reqMenuTryMatch := &reqMenuTryMatch{userID}
 	var response []byte
-	response, err = util.PostJSON(uri, reqMenuTryMatch)
+	response, err = menu.PostJSONContext(ctx, uri, reqMenuTryMatch)
 	if err != nil {
 		return
 	}
@@ -280,14 +321,19 @@ func (menu *Menu) MenuTryMatch(userID string) (buttons []Button, err error) {
 
 // GetCurrentSelfMenuInfo 获取自定义菜单配置接口
 func (menu *Menu) GetCurrentSelfMenuInfo() (resSelfMenuInfo ResSelfMenuInfo, err error) {
+	return menu.GetCurrentSelfMenuInfoContext(context2.Background())
+}
+
+// GetCurrentSelfMenuInfoContext 获取自定义菜单配置接口
+func (menu *Menu) GetCurrentSelfMenuInfoContext(ctx context2.Context) (resSelfMenuInfo ResSelfMenuInfo, err error) {
 	var accessToken string
-	accessToken, err = menu.GetAccessToken()
+	accessToken, err = menu.GetAccessTokenContext(ctx)
 	if err != nil {
 		return
 	}
 	uri := fmt.Sprintf("%s?access_token=%s", menuSelfMenuInfoURL, accessToken)
 	var response []byte
-	response, err = util.HTTPGet(uri)
+	response, err = menu.HTTPGetContext(ctx, uri)
 	if err != nil {
 		return
 	}
@@ -0,0 +1,29 @@
+package menu
+
+import (
+	context2 "context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/silenceper/wechat/v2/officialaccount/config"
+	"github.com/silenceper/wechat/v2/officialaccount/context"
+)
+
+type mockAccessToken struct{}
+
+func (mockAccessToken) GetAccessToken() (string, error) {
+	return "mock-access-token", nil
+}
+
+func TestDeleteMenuContextCanceled(t *testing.T) {
+	menu := NewMenu(&context.Context{
+		Config:            &config.Config{},
+		AccessTokenHandle: mockAccessToken{},
+	})
+	ctx, cancel := context2.WithCancel(context2.Background())
+	cancel()
+	err := menu.DeleteMenuContext(ctx)
+	assert.True(t, errors.Is(err, context2.Canceled))
+}
@@ -1,6 +1,7 @@
 package message
 
 import (
+	context2 "context"
 	"encoding/json"
 	"fmt"
 
@@ -87,6 +88,58 @@ func NewCustomerMiniprogrampageMessage(toUser, title, appID, pagePath, thumbMedi
 	}
 }
 
+// NewCustomerMessageFromReply 将被动回复消息转换为客服消息，用于超时后异步回复
+//
+// 支持文本、图片、语音、视频、音乐及图文消息，其他类型返回 ErrUnsupportReply
+func NewCustomerMessageFromReply(toUser string, reply *Reply) (*CustomerMessage, error) {
+	if reply == nil || reply.MsgData == nil {
+		return nil, ErrInvalidReply
+	}
+	msg := &CustomerMessage{ToUser: toUser}
+	switch data := reply.MsgData.(type) {
+	case *Text:
+		msg.Msgtype = MsgTypeText
+		msg.Text = &MediaText{Content: string(data.Content)}
+	case *Image:
+		msg.Msgtype = MsgTypeImage
+		msg.Image = &MediaResource{MediaID: data.Image.MediaID}
+	case *Voice:
+		msg.Msgtype = MsgTypeVoice
+		msg.Voice = &MediaResource{MediaID: data.Voice.MediaID}
+	case *Video:
+		msg.Msgtype = MsgTypeVideo
+		msg.Video = &MediaVideo{
+			MediaID:     data.Video.MediaID,
+			Title:       data.Video.Title,
+			Description: data.Video.Description,
+		}
+	case *Music:
+		msg.Msgtype = MsgTypeMusic
+		msg.Music = &MediaMusic{
+			Title:        data.Music.Title,
+			Description:  data.Music.Description,
+			Musicurl:     data.Music.MusicURL,
+			Hqmusicurl:   data.Music.HQMusicURL,
+			ThumbMediaID: data.Music.ThumbMediaID,
+		}
+	case *News:
+		msg.Msgtype = MsgTypeNews
+		articles := make([]MediaArticles, 0, len(data.Articles))
+		for _, article := range data.Articles {
+			articles = append(articles, MediaArticles{
+				Title:       article.Title,
+				Description: article.Description,
+				URL:         article.URL,
+				Picurl:      article.PicURL,
+			})
+		}
+		msg.News = &MediaNews{Articles: articles}
+	default:
+		return nil, ErrUnsupportReply
+	}
+	return msg, nil
+}
+
 // MediaText 文本消息的文字
 type MediaText struct {
 	Content string `json:"content"`
@@ -155,12 +208,17 @@ type MediaMiniprogrampage struct {
 
 // Send 发送客服消息
 func (manager *Manager) Send(msg *CustomerMessage) error {
-	accessToken, err := manager.Context.GetAccessToken()
+	return manager.SendContext(context2.Background(), msg)
+}
+
+// SendContext 发送客服消息
+func (manager *Manager) SendContext(ctx context2.Context, msg *CustomerMessage) error {
+	accessToken, err := manager.Context.GetAccessTokenContext(ctx)
 	if err != nil {
 		return err
 	}
 	uri := fmt.Sprintf("%s?access_token=%s", customerSendMessage, accessToken)
-	response, err := util.PostJSON(uri, msg)
+	response, err := manager.PostJSONContext(ctx, uri, msg)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,30 @@
+package message
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCustomerMessageFromReply(t *testing.T) {
+	msg, err := NewCustomerMessageFromReply("openid", &Reply{MsgType: MsgTypeText, MsgData: NewText("hello")})
+	assert.Nil(t, err)
+	assert.Equal(t, NewCustomerTextMessage("openid", "hello"), msg)
+
+	msg, err = NewCustomerMessageFromReply("openid", &Reply{MsgType: MsgTypeImage, MsgData: NewImage("media_id")})
+	assert.Nil(t, err)
+	assert.Equal(t, NewCustomerImgMessage("openid", "media_id"), msg)
+
+	msg, err = NewCustomerMessageFromReply("openid", &Reply{
+		MsgType: MsgTypeNews,
+		MsgData: NewNews([]*Article{NewArticle("title", "desc", "pic", "url")}),
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, MsgTypeNews, msg.Msgtype)
+	assert.Equal(t, []MediaArticles{{Title: "title", Description: "desc", URL: "url", Picurl: "pic"}}, msg.News.Articles)
+
+	_, err = NewCustomerMessageFromReply("openid", &Reply{MsgType: MsgTypeTransfer, MsgData: NewTransferCustomer("")})
+	assert.Equal(t, ErrUnsupportReply, err)
+	_, err = NewCustomerMessageFromReply("openid", nil)
+	assert.Equal(t, ErrInvalidReply, err)
+}
@@ -0,0 +1,119 @@
+package message
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	// ErrNotEvent 消息不是事件推送
+	ErrNotEvent = errors.New("消息不是事件推送")
+	// ErrUnknownEvent 未定义类型的事件
+	ErrUnknownEvent = errors.New("未知的事件类型")
+	// ErrNoRawMessage MixMessage 未保存原始消息，无法解析
+	ErrNoRawMessage = errors.New("缺少原始消息")
+)
+
+// Event 事件推送，具体类型见 ParseEvent
+type Event interface {
+	GetEvent() EventType
+	GetOpenID() string
+}
+
+// EventCommon 事件推送的公共字段
+type EventCommon struct {
+	CommonToken
+	Event EventType `xml:"Event" json:"Event"`
+}
+
+// GetEvent 返回事件类型
+func (e *EventCommon) GetEvent() EventType {
+	return e.Event
+}
+
+// eventTypes 事件类型对应的结构体，key 为小写的事件类型
+var eventTypes = map[string]func() Event{}
+
+func registerEvent(factory func() Event, events ...EventType) {
+	for _, event := range events {
+		eventTypes[strings.ToLower(string(event))] = factory
+	}
+}
+
+func init() {
+	registerEvent(func() Event { return &SubscribeEvent{} }, EventSubscribe)
+	registerEvent(func() Event { return &UnsubscribeEvent{} }, EventUnsubscribe)
+	registerEvent(func() Event { return &ScanEvent{} }, EventScan)
+	registerEvent(func() Event { return &LocationEvent{} }, EventLocation)
+	registerEvent(func() Event { return &ClickEvent{} }, EventClick)
+	registerEvent(func() Event { return &ViewEvent{} }, EventView)
+	registerEvent(func() Event { return &ScancodeEvent{} }, EventScancodePush, EventScancodeWaitmsg)
+	registerEvent(func() Event { return &PicEvent{} }, EventPicSysphoto, EventPicPhotoOrAlbum, EventPicWeixin)
+	registerEvent(func() Event { return &LocationSelectEvent{} }, EventLocationSelect)
+	registerEvent(func() Event { return &ViewMiniprogramEvent{} }, EventViewMiniprogram)
+	registerEvent(func() Event { return &TemplateSendJobFinishEvent{} }, EventTemplateSendJobFinish)
+	registerEvent(func() Event { return &MassSendJobFinishEvent{} }, EventMassSendJobFinish)
+	registerEvent(func() Event { return &SubscribeMsgPopupPush{} }, EventSubscribeMsgPopupEvent)
+	registerEvent(func() Event { return &SubscribeMsgChangePush{} }, EventSubscribeMsgChangeEvent)
+	registerEvent(func() Event { return &SubscribeMsgSentPush{} }, EventSubscribeMsgSentEvent)
+	registerEvent(func() Event { return &PublishJobFinishEvent{} }, EventPublishJobFinish)
+	registerEvent(func() Event { return &WxaMediaCheckEvent{} }, EventWxaMediaCheck)
+	registerEvent(func() Event { return &CardCheckEvent{} }, EventCardPassCheck, EventCardNotPassCheck)
+	registerEvent(func() Event { return &UserGetCardEvent{} }, EventUserGetCard)
+	registerEvent(func() Event { return &UserGiftingCardEvent{} }, EventUserGiftingCard)
+	registerEvent(func() Event { return &UserCardEvent{} }, EventUserDelCard, EventUserEnterSessionFromCard, EventSubmitMembercardUserInfo)
+	registerEvent(func() Event { return &UserConsumeCardEvent{} }, EventUserConsumeCard)
+	registerEvent(func() Event { return &UserPayFromPayCellEvent{} }, EventUserPayFromPayCell)
+	registerEvent(func() Event { return &UserViewCardEvent{} }, EventUserViewCard)
+	registerEvent(func() Event { return &UpdateMemberCardEvent{} }, EventUpdateMemberCard)
+	registerEvent(func() Event { return &CardSkuRemindEvent{} }, EventCardSkuRemind)
+	registerEvent(func() Event { return &MerchantOrderEvent{} }, EventMerchantOrder)
+	registerEvent(func() Event { return &WxaCategoryAuditEvent{} }, EventWxaCategoryAudit)
+	registerEvent(func() Event { return &UserAuthorizationEvent{} }, EventUserAuthorizationRevoke, EventUserInfoModified)
+}
+
+// ParseEvent 将原始的事件推送解析为具体类型，如 *SubscribeEvent、*ClickEvent，事件类型不区分大小写
+//
+// 消息不是事件时返回 ErrNotEvent，未定义的事件返回 ErrUnknownEvent
+func ParseEvent(raw []byte, isJSON bool) (Event, error) {
+	var head EventCommon
+	if err := unmarshalMessage(raw, isJSON, &head); err != nil {
+		return nil, err
+	}
+	if head.MsgType != MsgTypeEvent {
+		return nil, ErrNotEvent
+	}
+	factory, ok := eventTypes[strings.ToLower(string(head.Event))]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownEvent, head.Event)
+	}
+	event := factory()
+	if err := unmarshalMessage(raw, isJSON, event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// DecodeEvent 将 MixMessage 转换为具体类型的事件，msg 需由 Server 解析（保存了原始消息）
+func DecodeEvent(msg *MixMessage) (Event, error) {
+	if msg.raw == nil {
+		return nil, ErrNoRawMessage
+	}
+	return ParseEvent(msg.raw, msg.rawJSON)
+}
+
+// SetRawMessage 保存原始消息，用于 DecodeEvent
+func (s *MixMessage) SetRawMessage(raw []byte, isJSON bool) {
+	s.raw = raw
+	s.rawJSON = isJSON
+}
+
+func unmarshalMessage(raw []byte, isJSON bool, v interface{}) error {
+	if isJSON {
+		return json.Unmarshal(raw, v)
+	}
+	return xml.Unmarshal(raw, v)
+}
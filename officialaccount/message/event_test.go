@@ -0,0 +1,83 @@
+package message
+
+import (
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+// TestParseEventGolden 解析每个已注册事件在 testdata/events 下的 xml 及 json 格式，结果需与 .golden 文件一致，缺少测试数据时失败
+func TestParseEventGolden(t *testing.T) {
+	names := make([]string, 0, len(eventTypes))
+	for event := range eventTypes {
+		names = append(names, event)
+	}
+	sort.Strings(names)
+	files, err := filepath.Glob("testdata/events/*.xml")
+	assert.Nil(t, err)
+	assert.Len(t, files, len(names), "testdata/events 下存在未注册的事件")
+	for _, event := range names {
+		name := filepath.Join("testdata", "events", event)
+		t.Run(event, func(t *testing.T) {
+			golden := name + ".golden"
+			var results [][]byte
+			for _, isJSON := range []bool{false, true} {
+				ext := ".xml"
+				if isJSON {
+					ext = ".json"
+				}
+				raw, err := os.ReadFile(name + ext)
+				if !assert.Nil(t, err, "missing fixture") {
+					return
+				}
+				event, err := ParseEvent(raw, isJSON)
+				if !assert.Nil(t, err, ext) {
+					return
+				}
+				assert.Equal(t, filepath.Base(name), strings.ToLower(string(event.GetEvent())))
+				out, err := json.MarshalIndent(struct {
+					Type  string
+					Event Event
+				}{reflect.TypeOf(event).Elem().Name(), event}, "", "  ")
+				assert.Nil(t, err)
+				results = append(results, append(out, '\n'))
+			}
+			if *update {
+				assert.Nil(t, os.WriteFile(golden, results[0], 0644))
+			}
+			want, err := os.ReadFile(golden)
+			assert.Nil(t, err)
+			assert.Equal(t, string(want), string(results[0]), "xml")
+			assert.Equal(t, string(want), string(results[1]), "json")
+		})
+	}
+}
+
+func TestDecodeEvent(t *testing.T) {
+	raw := []byte(`<xml><FromUserName><![CDATA[openid]]></FromUserName><MsgType><![CDATA[event]]></MsgType><Event><![CDATA[click]]></Event><EventKey><![CDATA[menu_1]]></EventKey></xml>`)
+	msg := &MixMessage{}
+	_, err := DecodeEvent(msg)
+	assert.Equal(t, ErrNoRawMessage, err)
+
+	msg.SetRawMessage(raw, false)
+	event, err := DecodeEvent(msg)
+	assert.Nil(t, err)
+	click, ok := event.(*ClickEvent)
+	assert.True(t, ok)
+	assert.Equal(t, "menu_1", click.EventKey)
+	assert.Equal(t, "openid", click.GetOpenID())
+
+	_, err = ParseEvent([]byte(`<xml><MsgType>text</MsgType></xml>`), false)
+	assert.Equal(t, ErrNotEvent, err)
+	_, err = ParseEvent([]byte(`{"MsgType":"event","Event":"unknown"}`), true)
+	assert.ErrorIs(t, err, ErrUnknownEvent)
+}
@@ -0,0 +1,361 @@
+package message
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/silenceper/wechat/v2/officialaccount/freepublish"
+)
+
+// SubscribeEvent 关注事件，扫描带参数二维码关注时 EventKey 为 qrscene_ 前缀的场景值
+type SubscribeEvent struct {
+	EventCommon
+	EventKey string `xml:"EventKey" json:"EventKey"`
+	Ticket   string `xml:"Ticket" json:"Ticket"`
+}
+
+// UnsubscribeEvent 取消关注事件
+type UnsubscribeEvent struct {
+	EventCommon
+}
+
+// ScanEvent 已关注用户扫描带参数二维码事件
+type ScanEvent struct {
+	EventCommon
+	EventKey string `xml:"EventKey" json:"EventKey"`
+	Ticket   string `xml:"Ticket" json:"Ticket"`
+}
+
+// LocationEvent 上报地理位置事件
+type LocationEvent struct {
+	EventCommon
+	Latitude  string `xml:"Latitude" json:"Latitude"`
+	Longitude string `xml:"Longitude" json:"Longitude"`
+	Precision string `xml:"Precision" json:"Precision"`
+}
+
+// ClickEvent 点击菜单拉取消息事件
+type ClickEvent struct {
+	EventCommon
+	EventKey string `xml:"EventKey" json:"EventKey"`
+}
+
+// ViewEvent 点击菜单跳转链接事件，EventKey 为跳转的 URL
+type ViewEvent struct {
+	EventCommon
+	EventKey string `xml:"EventKey" json:"EventKey"`
+	MenuID   string `xml:"MenuId" json:"MenuId"`
+}
+
+// ScancodeEvent 扫码推事件（scancode_push、scancode_waitmsg）
+type ScancodeEvent struct {
+	EventCommon
+	EventKey     string `xml:"EventKey" json:"EventKey"`
+	ScanCodeInfo struct {
+		ScanType   string `xml:"ScanType" json:"ScanType"`
+		ScanResult string `xml:"ScanResult" json:"ScanResult"`
+	} `xml:"ScanCodeInfo" json:"ScanCodeInfo"`
+}
+
+// PicEvent 弹出拍照或相册发图事件（pic_sysphoto、pic_photo_or_album、pic_weixin）
+type PicEvent struct {
+	EventCommon
+	EventKey     string `xml:"EventKey" json:"EventKey"`
+	SendPicsInfo struct {
+		Count   int32      `xml:"Count" json:"Count"`
+		PicList []EventPic `xml:"PicList>item" json:"PicList"`
+	} `xml:"SendPicsInfo" json:"SendPicsInfo"`
+}
+
+// LocationSelectEvent 弹出地理位置选择器事件
+type LocationSelectEvent struct {
+	EventCommon
+	EventKey         string `xml:"EventKey" json:"EventKey"`
+	SendLocationInfo struct {
+		LocationX float64 `xml:"Location_X" json:"Location_X"`
+		LocationY float64 `xml:"Location_Y" json:"Location_Y"`
+		Scale     float64 `xml:"Scale" json:"Scale"`
+		Label     string  `xml:"Label" json:"Label"`
+		Poiname   string  `xml:"Poiname" json:"Poiname"`
+	} `xml:"SendLocationInfo" json:"SendLocationInfo"`
+}
+
+// ViewMiniprogramEvent 点击菜单跳转小程序事件，EventKey 为小程序路径
+type ViewMiniprogramEvent struct {
+	EventCommon
+	EventKey string `xml:"EventKey" json:"EventKey"`
+	MenuID   string `xml:"MenuId" json:"MenuId"`
+}
+
+// TemplateSendJobFinishEvent 模板消息发送结果
+type TemplateSendJobFinishEvent struct {
+	EventCommon
+	MsgID  int64  `xml:"MsgID" json:"MsgID"`
+	Status string `xml:"Status" json:"Status"` // success、failed:user block、failed: system failed
+}
+
+// MassSendJobFinishEvent 群发结果
+type MassSendJobFinishEvent struct {
+	EventCommon
+	MsgID                int64  `xml:"MsgID" json:"MsgID"`
+	Status               string `xml:"Status" json:"Status"`
+	TotalCount           int64  `xml:"TotalCount" json:"TotalCount"`
+	FilterCount          int64  `xml:"FilterCount" json:"FilterCount"`
+	SentCount            int64  `xml:"SentCount" json:"SentCount"`
+	ErrorCount           int64  `xml:"ErrorCount" json:"ErrorCount"`
+	CopyrightCheckResult struct {
+		Count      int `xml:"Count" json:"Count"`
+		ResultList []struct {
+			ArticleIdx            int    `xml:"ArticleIdx" json:"ArticleIdx"`
+			UserDeclareState      int    `xml:"UserDeclareState" json:"UserDeclareState"`
+			AuditState            int    `xml:"AuditState" json:"AuditState"`
+			OriginalArticleURL    string `xml:"OriginalArticleUrl" json:"OriginalArticleUrl"`
+			OriginalArticleType   int    `xml:"OriginalArticleType" json:"OriginalArticleType"`
+			CanReprint            int    `xml:"CanReprint" json:"CanReprint"`
+			NeedReplaceContent    int    `xml:"NeedReplaceContent" json:"NeedReplaceContent"`
+			NeedShowReprintSource int    `xml:"NeedShowReprintSource" json:"NeedShowReprintSource"`
+		} `xml:"ResultList>item" json:"ResultList"`
+		CheckState int `xml:"CheckState" json:"CheckState"`
+	} `xml:"CopyrightCheckResult" json:"CopyrightCheckResult"`
+	ArticleURLResult struct {
+		Count      int `xml:"Count" json:"Count"`
+		ResultList []struct {
+			ArticleIdx int    `xml:"ArticleIdx" json:"ArticleIdx"`
+			ArticleURL string `xml:"ArticleUrl" json:"ArticleUrl"`
+		} `xml:"ResultList>item" json:"ResultList"`
+	} `xml:"ArticleUrlResult" json:"ArticleUrlResult"`
+}
+
+// SubscribeMsgPopupPush 用户操作订阅通知弹窗事件
+type SubscribeMsgPopupPush struct {
+	EventCommon
+	List SubscribeMsgPopupEventList `xml:"SubscribeMsgPopupEvent>List" json:"List"`
+}
+
+// SubscribeMsgPopupEventList 订阅通知弹窗事件列表，json 格式中单条时为对象
+type SubscribeMsgPopupEventList []SubscribeMsgPopupEvent
+
+// UnmarshalJSON 兼容对象及数组
+func (l *SubscribeMsgPopupEventList) UnmarshalJSON(data []byte) error {
+	var list []SubscribeMsgPopupEvent
+	if err := unmarshalJSONList(data, &list); err != nil {
+		return err
+	}
+	*l = list
+	return nil
+}
+
+// SubscribeMsgChangePush 用户管理订阅通知（取消订阅）事件
+type SubscribeMsgChangePush struct {
+	EventCommon
+	List SubscribeMsgChangeEventList `xml:"SubscribeMsgChangeEvent>List" json:"List"`
+}
+
+// SubscribeMsgChangeEvent 用户管理订阅通知的消息体
+type SubscribeMsgChangeEvent struct {
+	TemplateID            string `xml:"TemplateId" json:"TemplateId"`
+	SubscribeStatusString string `xml:"SubscribeStatusString" json:"SubscribeStatusString"`
+}
+
+// SubscribeMsgChangeEventList 用户管理订阅通知事件列表，json 格式中单条时为对象
+type SubscribeMsgChangeEventList []SubscribeMsgChangeEvent
+
+// UnmarshalJSON 兼容对象及数组
+func (l *SubscribeMsgChangeEventList) UnmarshalJSON(data []byte) error {
+	var list []SubscribeMsgChangeEvent
+	if err := unmarshalJSONList(data, &list); err != nil {
+		return err
+	}
+	*l = list
+	return nil
+}
+
+// SubscribeMsgSentPush 发送订阅通知结果事件
+type SubscribeMsgSentPush struct {
+	EventCommon
+	List SubscribeMsgSentEventList `xml:"SubscribeMsgSentEvent>List" json:"List"`
+}
+
+// SubscribeMsgSentEvent 发送订阅通知结果的消息体
+type SubscribeMsgSentEvent struct {
+	TemplateID  string `xml:"TemplateId" json:"TemplateId"`
+	MsgID       string `xml:"MsgID" json:"MsgID"`
+	ErrorCode   string `xml:"ErrorCode" json:"ErrorCode"`
+	ErrorStatus string `xml:"ErrorStatus" json:"ErrorStatus"`
+}
+
+// SubscribeMsgSentEventList 发送订阅通知结果列表，json 格式中单条时为对象
+type SubscribeMsgSentEventList []SubscribeMsgSentEvent
+
+// UnmarshalJSON 兼容对象及数组
+func (l *SubscribeMsgSentEventList) UnmarshalJSON(data []byte) error {
+	var list []SubscribeMsgSentEvent
+	if err := unmarshalJSONList(data, &list); err != nil {
+		return err
+	}
+	*l = list
+	return nil
+}
+
+// unmarshalJSONList 解析 json 数组，data 为对象时作为单个元素
+func unmarshalJSONList(data []byte, list interface{}) error {
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '{' {
+		data = append(append([]byte{'['}, trimmed...), ']')
+	}
+	return json.Unmarshal(data, list)
+}
+
+// PublishJobFinishEvent 发布任务完成事件
+type PublishJobFinishEvent struct {
+	EventCommon
+	PublishEventInfo struct {
+		PublishID     int64                     `xml:"publish_id" json:"publish_id"`         // 发布任务id
+		PublishStatus freepublish.PublishStatus `xml:"publish_status" json:"publish_status"` // 发布状态
+		ArticleID     string                    `xml:"article_id" json:"article_id"`         // 发布成功时图文的 article_id
+		ArticleDetail struct {
+			Count uint `xml:"count" json:"count"` // 文章数量
+			Item  []struct {
+				Index      uint   `xml:"idx" json:"idx"`                 // 文章对应的编号
+				ArticleURL string `xml:"article_url" json:"article_url"` // 图文的永久链接
+			} `xml:"item" json:"item"`
+		} `xml:"article_detail" json:"article_detail"` // 发布成功时返回
+		FailIndex []uint `xml:"fail_idx" json:"fail_idx"` // 发布状态为2或4时，不通过的文章编号，第一篇为 1
+	} `xml:"PublishEventInfo" json:"PublishEventInfo"`
+}
+
+// WxaMediaCheckEvent 异步校验图片/音频内容安全结果
+type WxaMediaCheckEvent struct {
+	EventCommon
+	AppID   string `xml:"appid" json:"appid"`
+	TraceID string `xml:"trace_id" json:"trace_id"`
+	Version int    `xml:"version" json:"version"`
+	Result  struct {
+		Suggest string `xml:"suggest" json:"suggest"` // risky、pass、review
+		Label   int    `xml:"label" json:"label"`
+	} `xml:"result" json:"result"`
+	Detail []struct {
+		Strategy string `xml:"strategy" json:"strategy"`
+		ErrCode  int    `xml:"errcode" json:"errcode"`
+		Suggest  string `xml:"suggest" json:"suggest"`
+		Label    int    `xml:"label" json:"label"`
+		Prob     int    `xml:"prob" json:"prob"`
+	} `xml:"detail" json:"detail"`
+
+	// 1.0 版本
+	IsRisky       bool   `xml:"isrisky" json:"isrisky"`
+	ExtraInfoJSON string `xml:"extra_info_json" json:"extra_info_json"`
+	StatusCode    int    `xml:"status_code" json:"status_code"`
+}
+
+// CardCheckEvent 卡券审核事件（card_pass_check、card_not_pass_check）
+type CardCheckEvent struct {
+	EventCommon
+	CardID       string `xml:"CardId" json:"CardId"`
+	RefuseReason string `xml:"RefuseReason" json:"RefuseReason"`
+}
+
+// UserGetCardEvent 领取卡券事件
+type UserGetCardEvent struct {
+	EventCommon
+	CardID              string `xml:"CardId" json:"CardId"`
+	IsGiveByFriend      int32  `xml:"IsGiveByFriend" json:"IsGiveByFriend"`
+	UserCardCode        string `xml:"UserCardCode" json:"UserCardCode"`
+	FriendUserName      string `xml:"FriendUserName" json:"FriendUserName"`
+	OuterID             int64  `xml:"OuterId" json:"OuterId"`
+	OldUserCardCode     string `xml:"OldUserCardCode" json:"OldUserCardCode"`
+	OuterStr            string `xml:"OuterStr" json:"OuterStr"`
+	IsRestoreMemberCard int32  `xml:"IsRestoreMemberCard" json:"IsRestoreMemberCard"`
+	UnionID             string `xml:"UnionId" json:"UnionId"`
+}
+
+// UserGiftingCardEvent 转赠卡券事件
+type UserGiftingCardEvent struct {
+	EventCommon
+	CardID         string `xml:"CardId" json:"CardId"`
+	UserCardCode   string `xml:"UserCardCode" json:"UserCardCode"`
+	IsReturnBack   int32  `xml:"IsReturnBack" json:"IsReturnBack"`
+	FriendUserName string `xml:"FriendUserName" json:"FriendUserName"`
+	IsChatRoom     int32  `xml:"IsChatRoom" json:"IsChatRoom"`
+}
+
+// UserCardEvent 只包含卡券信息的事件（user_del_card、user_enter_session_from_card、submit_membercard_user_info）
+type UserCardEvent struct {
+	EventCommon
+	CardID       string `xml:"CardId" json:"CardId"`
+	UserCardCode string `xml:"UserCardCode" json:"UserCardCode"`
+}
+
+// UserConsumeCardEvent 核销卡券事件
+type UserConsumeCardEvent struct {
+	EventCommon
+	CardID        string `xml:"CardId" json:"CardId"`
+	UserCardCode  string `xml:"UserCardCode" json:"UserCardCode"`
+	ConsumeSource string `xml:"ConsumeSource" json:"ConsumeSource"`
+	LocationName  string `xml:"LocationName" json:"LocationName"`
+	StaffOpenID   string `xml:"StaffOpenId" json:"StaffOpenId"`
+	VerifyCode    string `xml:"VerifyCode" json:"VerifyCode"`
+	RemarkAmount  string `xml:"RemarkAmount" json:"RemarkAmount"`
+	OuterStr      string `xml:"OuterStr" json:"OuterStr"`
+}
+
+// UserPayFromPayCellEvent 买单事件
+type UserPayFromPayCellEvent struct {
+	EventCommon
+	CardID       string `xml:"CardId" json:"CardId"`
+	UserCardCode string `xml:"UserCardCode" json:"UserCardCode"`
+	TransID      string `xml:"TransId" json:"TransId"`
+	LocationID   int64  `xml:"LocationId" json:"LocationId"`
+	Fee          string `xml:"Fee" json:"Fee"`
+	OriginalFee  string `xml:"OriginalFee" json:"OriginalFee"`
+}
+
+// UserViewCardEvent 进入会员卡事件
+type UserViewCardEvent struct {
+	EventCommon
+	CardID       string `xml:"CardId" json:"CardId"`
+	UserCardCode string `xml:"UserCardCode" json:"UserCardCode"`
+	OuterStr     string `xml:"OuterStr" json:"OuterStr"`
+}
+
+// UpdateMemberCardEvent 会员卡内容更新事件
+type UpdateMemberCardEvent struct {
+	EventCommon
+	CardID        string `xml:"CardId" json:"CardId"`
+	UserCardCode  string `xml:"UserCardCode" json:"UserCardCode"`
+	ModifyBonus   int64  `xml:"ModifyBonus" json:"ModifyBonus"`
+	ModifyBalance int64  `xml:"ModifyBalance" json:"ModifyBalance"`
+}
+
+// CardSkuRemindEvent 卡券库存报警事件
+type CardSkuRemindEvent struct {
+	EventCommon
+	CardID string `xml:"CardId" json:"CardId"`
+	Detail string `xml:"Detail" json:"Detail"`
+}
+
+// MerchantOrderEvent 微信小店订单付款通知
+type MerchantOrderEvent struct {
+	EventCommon
+	OrderID     string `xml:"OrderId" json:"OrderId"`
+	OrderStatus int    `xml:"OrderStatus" json:"OrderStatus"`
+	ProductID   string `xml:"ProductId" json:"ProductId"`
+	SkuInfo     string `xml:"SkuInfo" json:"SkuInfo"`
+}
+
+// WxaCategoryAuditEvent 小程序类目审核结果事件
+type WxaCategoryAuditEvent struct {
+	EventCommon
+	Ret      int    `xml:"ret" json:"ret"` // 1 审核中，2 审核不通过，3 审核通过
+	Nickname string `xml:"nickname" json:"nickname"`
+	Reason   string `xml:"reason" json:"reason"`
+	First    string `xml:"first" json:"first"`   // 一级类目id
+	Second   string `xml:"second" json:"second"` // 二级类目id
+}
+
+// UserAuthorizationEvent 用户撤回授权信息（user_authorization_revoke）、用户资料变更（user_info_modified）事件
+type UserAuthorizationEvent struct {
+	EventCommon
+	OpenID     string `xml:"OpenID" json:"OpenID"`
+	AppID      string `xml:"AppID" json:"AppID"`
+	RevokeInfo string `xml:"RevokeInfo" json:"RevokeInfo"` // 用户撤回的授权信息编号，多个时以英文分号分隔
+}
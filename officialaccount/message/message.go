@@ -80,6 +80,42 @@ const (
 	EventSubscribeMsgPopupEvent EventType = "subscribe_msg_popup_event"
 	// EventPublishJobFinish 发布任务完成
 	EventPublishJobFinish EventType = "PUBLISHJOBFINISH"
+	// EventSubscribeMsgChangeEvent 用户管理订阅通知（取消订阅）事件推送
+	EventSubscribeMsgChangeEvent EventType = "subscribe_msg_change_event"
+	// EventSubscribeMsgSentEvent 发送订阅通知事件推送
+	EventSubscribeMsgSentEvent EventType = "subscribe_msg_sent_event"
+	// EventCardPassCheck 卡券审核通过
+	EventCardPassCheck EventType = "card_pass_check"
+	// EventCardNotPassCheck 卡券审核未通过
+	EventCardNotPassCheck EventType = "card_not_pass_check"
+	// EventUserGetCard 领取卡券
+	EventUserGetCard EventType = "user_get_card"
+	// EventUserGiftingCard 转赠卡券
+	EventUserGiftingCard EventType = "user_gifting_card"
+	// EventUserDelCard 删除卡券
+	EventUserDelCard EventType = "user_del_card"
+	// EventUserConsumeCard 核销卡券
+	EventUserConsumeCard EventType = "user_consume_card"
+	// EventUserPayFromPayCell 买单
+	EventUserPayFromPayCell EventType = "user_pay_from_pay_cell"
+	// EventUserViewCard 进入会员卡
+	EventUserViewCard EventType = "user_view_card"
+	// EventUserEnterSessionFromCard 从卡券进入公众号会话
+	EventUserEnterSessionFromCard EventType = "user_enter_session_from_card"
+	// EventUpdateMemberCard 会员卡内容更新
+	EventUpdateMemberCard EventType = "update_member_card"
+	// EventCardSkuRemind 卡券库存报警
+	EventCardSkuRemind EventType = "card_sku_remind"
+	// EventSubmitMembercardUserInfo 会员卡激活
+	EventSubmitMembercardUserInfo EventType = "submit_membercard_user_info"
+	// EventMerchantOrder 微信小店订单付款通知
+	EventMerchantOrder EventType = "merchant_order"
+	// EventWxaCategoryAudit 小程序类目审核结果
+	EventWxaCategoryAudit EventType = "wxa_category_audit"
+	// EventUserAuthorizationRevoke 用户撤回授权信息
+	EventUserAuthorizationRevoke EventType = "user_authorization_revoke"
+	// EventUserInfoModified 用户资料变更
+	EventUserInfoModified EventType = "user_info_modified"
 )
 
 const (
@@ -153,6 +189,9 @@ type MixMessage struct {
 
 	subscribeMsgPopupEventList []SubscribeMsgPopupEvent `json:"-"`
 
+	raw     []byte // 原始消息，用于 DecodeEvent
+	rawJSON bool
+
 	SubscribeMsgPopupEvent []struct {
 		List SubscribeMsgPopupEvent `xml:"List"`
 	} `xml:"SubscribeMsgPopupEvent"`
@@ -268,7 +307,7 @@ func (c CDATA) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 
 // CommonToken 消息中通用的结构
 type CommonToken struct {
-	XMLName      xml.Name `xml:"xml"`
+	XMLName      xml.Name `xml:"xml" json:"-"`
 	ToUserName   CDATA    `xml:"ToUserName" json:"ToUserName"`
 	FromUserName CDATA    `xml:"FromUserName" json:"FromUserName"`
 	CreateTime   int64    `xml:"CreateTime" json:"CreateTime"`
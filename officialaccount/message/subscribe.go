@@ -1,6 +1,7 @@
 package message
 
 import (
+	context2 "context"
 	"fmt"
 
 	"github.com/silenceper/wechat/v2/officialaccount/context"
@@ -48,13 +49,18 @@ type SubscribeDataItem struct {
 
 // Send 发送订阅消息
 func (tpl *Subscribe) Send(msg *SubscribeMessage) (err error) {
+	return tpl.SendContext(context2.Background(), msg)
+}
+
+// SendContext 发送订阅消息
+func (tpl *Subscribe) SendContext(ctx context2.Context, msg *SubscribeMessage) (err error) {
 	var accessToken string
-	accessToken, err = tpl.GetAccessToken()
+	accessToken, err = tpl.GetAccessTokenContext(ctx)
 	if err != nil {
 		return
 	}
 	uri := fmt.Sprintf("%s?access_token=%s", subscribeSendURL, accessToken)
-	response, err := util.PostJSON(uri, msg)
+	response, err := tpl.PostJSONContext(ctx, uri, msg)
 	if err != nil {
 		return
 	}
@@ -77,14 +83,19 @@ type resPrivateSubscribeList struct {
 
 // List 获取私有订阅消息模板列表
 func (tpl *Subscribe) List() (templateList []*PrivateSubscribeItem, err error) {
+	return tpl.ListContext(context2.Background())
+}
+
+// ListContext 获取私有订阅消息模板列表
+func (tpl *Subscribe) ListContext(ctx context2.Context) (templateList []*PrivateSubscribeItem, err error) {
 	var accessToken string
-	accessToken, err = tpl.GetAccessToken()
+	accessToken, err = tpl.GetAccessTokenContext(ctx)
 	if err != nil {
 		return
 	}
 	uri := fmt.Sprintf("%s?access_token=%s", subscribeTemplateListURL, accessToken)
 	var response []byte
-	response, err = util.HTTPGet(uri)
+	response, err = tpl.HTTPGetContext(ctx, uri)
 	if err != nil {
 		return
 	}
@@ -105,8 +116,13 @@ type resSubscribeAdd struct {
 
 // Add 添加订阅消息模板
 func (tpl *Subscribe) Add(ShortID string, kidList []int, sceneDesc string) (templateID string, err error) {
+	return tpl.AddContext(context2.Background(), ShortID, kidList, sceneDesc)
+}
+
+// AddContext 添加订阅消息模板
+func (tpl *Subscribe) AddContext(ctx context2.Context, ShortID string, kidList []int, sceneDesc string) (templateID string, err error) {
 	var accessToken string
-	accessToken, err = tpl.GetAccessToken()
+	accessToken, err = tpl.GetAccessTokenContext(ctx)
 	if err != nil {
 		return
 	}
@@ -117,7 +133,7 @@ func (tpl *Subscribe) Add(ShortID string, kidList []int, sceneDesc string) (temp
 	}{TemplateIDShort: ShortID, SceneDesc: sceneDesc, KidList: kidList}
 	uri := fmt.Sprintf("%s?access_token=%s", subscribeTemplateAddURL, accessToken)
 	var response []byte
-	response, err = util.PostJSON(uri, msg)
+	response, err = tpl.PostJSONContext(ctx, uri, msg)
 	if err != nil {
 		return
 	}
@@ -132,8 +148,13 @@ func (tpl *Subscribe) Add(ShortID string, kidList []int, sceneDesc string) (temp
 
 // Delete 删除私有模板
 func (tpl *Subscribe) Delete(templateID string) (err error) {
+	return tpl.DeleteContext(context2.Background(), templateID)
+}
+
+// DeleteContext 删除私有模板
+func (tpl *Subscribe) DeleteContext(ctx context2.Context, templateID string) (err error) {
 	var accessToken string
-	accessToken, err = tpl.GetAccessToken()
+	accessToken, err = tpl.GetAccessTokenContext(ctx)
 	if err != nil {
 		return
 	}
@@ -142,7 +163,7 @@ func (tpl *Subscribe) Delete(templateID string) (err error) {
 	}{TemplateID: templateID}
 	uri := fmt.Sprintf("%s?access_token=%s", subscribeTemplateDelURL, accessToken)
 	var response []byte
-	response, err = util.PostJSON(uri, msg)
+	response, err = tpl.PostJSONContext(ctx, uri, msg)
 	if err != nil {
 		return
 	}
@@ -162,14 +183,19 @@ type resSubscribeCategoryList struct {
 
 // GetCategory 获取公众号类目
 func (tpl *Subscribe) GetCategory() (categoryList []*PublicTemplateCategory, err error) {
+	return tpl.GetCategoryContext(context2.Background())
+}
+
+// GetCategoryContext 获取公众号类目
+func (tpl *Subscribe) GetCategoryContext(ctx context2.Context) (categoryList []*PublicTemplateCategory, err error) {
 	var accessToken string
-	accessToken, err = tpl.GetAccessToken()
+	accessToken, err = tpl.GetAccessTokenContext(ctx)
 	if err != nil {
 		return
 	}
 	uri := fmt.Sprintf("%s?access_token=%s", subscribeTemplateGetCategoryURL, accessToken)
 	var response []byte
-	response, err = util.HTTPGet(uri)
+	response, err = tpl.HTTPGetContext(ctx, uri)
 	if err != nil {
 		return
 	}
@@ -197,14 +223,19 @@ type resPublicTemplateKeyWordsList struct {
 
 // GetPubTplKeyWordsByID 获取模板中的关键词
 func (tpl *Subscribe) GetPubTplKeyWordsByID(titleID string) (keyWordsList []*PublicTemplateKeyWords, err error) {
+	return tpl.GetPubTplKeyWordsByIDContext(context2.Background(), titleID)
+}
+
+// GetPubTplKeyWordsByIDContext 获取模板中的关键词
+func (tpl *Subscribe) GetPubTplKeyWordsByIDContext(ctx context2.Context, titleID string) (keyWordsList []*PublicTemplateKeyWords, err error) {
 	var accessToken string
-	accessToken, err = tpl.GetAccessToken()
+	accessToken, err = tpl.GetAccessTokenContext(ctx)
 	if err != nil {
 		return
 	}
 	uri := fmt.Sprintf("%s?access_token=%s&tid=%s", subscribeTemplateGetPubTplKeyWorksURL, accessToken, titleID)
 	var response []byte
-	response, err = util.HTTPGet(uri)
+	response, err = tpl.HTTPGetContext(ctx, uri)
 	if err != nil {
 		return
 	}
@@ -233,14 +264,19 @@ type resPublicTemplateTitleList struct {
 
 // GetPublicTemplateTitleList 获取类目下的公共模板
 func (tpl *Subscribe) GetPublicTemplateTitleList(ids string, start int, limit int) (count int, templateTitleList []*PublicTemplateTitle, err error) {
+	return tpl.GetPublicTemplateTitleListContext(context2.Background(), ids, start, limit)
+}
+
+// GetPublicTemplateTitleListContext 获取类目下的公共模板
+func (tpl *Subscribe) GetPublicTemplateTitleListContext(ctx context2.Context, ids string, start int, limit int) (count int, templateTitleList []*PublicTemplateTitle, err error) {
 	var accessToken string
-	accessToken, err = tpl.GetAccessToken()
+	accessToken, err = tpl.GetAccessTokenContext(ctx)
 	if err != nil {
 		return
 	}
 	uri := fmt.Sprintf("%s?access_token=%s&ids=%s&start=%d&limit=%d", subscribeTemplateGetPubTplTitles, accessToken, ids, start, limit)
 	var response []byte
-	response, err = util.HTTPGet(uri)
+	response, err = tpl.HTTPGetContext(ctx, uri)
 	if err != nil {
 		return
 	}
@@ -1,6 +1,7 @@
 package message
 
 import (
+	context2 "context"
 	"encoding/json"
 	"fmt"
 
@@ -55,14 +56,19 @@ type resTemplateSend struct {
 
 // Send 发送模板消息
 func (tpl *Template) Send(msg *TemplateMessage) (msgID int64, err error) {
+	return tpl.SendContext(context2.Background(), msg)
+}
+
+// SendContext 发送模板消息
+func (tpl *Template) SendContext(ctx context2.Context, msg *TemplateMessage) (msgID int64, err error) {
 	var accessToken string
-	accessToken, err = tpl.GetAccessToken()
+	accessToken, err = tpl.GetAccessTokenContext(ctx)
 	if err != nil {
 		return
 	}
 	uri := fmt.Sprintf("%s?access_token=%s", templateSendURL, accessToken)
 	var response []byte
-	response, err = util.PostJSON(uri, msg)
+	response, err = tpl.PostJSONContext(ctx, uri, msg)
 	if err != nil {
 		return
 	}
@@ -97,14 +103,19 @@ type resTemplateList struct {
 
 // List 获取模板列表
 func (tpl *Template) List() (templateList []*TemplateItem, err error) {
+	return tpl.ListContext(context2.Background())
+}
+
+// ListContext 获取模板列表
+func (tpl *Template) ListContext(ctx context2.Context) (templateList []*TemplateItem, err error) {
 	var accessToken string
-	accessToken, err = tpl.GetAccessToken()
+	accessToken, err = tpl.GetAccessTokenContext(ctx)
 	if err != nil {
 		return
 	}
 	uri := fmt.Sprintf("%s?access_token=%s", templateListURL, accessToken)
 	var response []byte
-	response, err = util.HTTPGet(uri)
+	response, err = tpl.HTTPGetContext(ctx, uri)
 	if err != nil {
 		return
 	}
@@ -125,8 +136,13 @@ type resTemplateAdd struct {
 
 // Add 添加模板.
 func (tpl *Template) Add(shortID string) (templateID string, err error) {
+	return tpl.AddContext(context2.Background(), shortID)
+}
+
+// AddContext 添加模板.
+func (tpl *Template) AddContext(ctx context2.Context, shortID string) (templateID string, err error) {
 	var accessToken string
-	accessToken, err = tpl.GetAccessToken()
+	accessToken, err = tpl.GetAccessTokenContext(ctx)
 	if err != nil {
 		return
 	}
@@ -135,7 +151,7 @@ func (tpl *Template) Add(shortID string) (templateID string, err error) {
 	}{ShortID: shortID}
 	uri := fmt.Sprintf("%s?access_token=%s", templateAddURL, accessToken)
 	var response []byte
-	response, err = util.PostJSON(uri, msg)
+	response, err = tpl.PostJSONContext(ctx, uri, msg)
 	if err != nil {
 		return
 	}
@@ -151,8 +167,13 @@ func (tpl *Template) Add(shortID string) (templateID string, err error) {
 
 // Delete 删除私有模板.
 func (tpl *Template) Delete(templateID string) (err error) {
+	return tpl.DeleteContext(context2.Background(), templateID)
+}
+
+// DeleteContext 删除私有模板.
+func (tpl *Template) DeleteContext(ctx context2.Context, templateID string) (err error) {
 	var accessToken string
-	accessToken, err = tpl.GetAccessToken()
+	accessToken, err = tpl.GetAccessTokenContext(ctx)
 	if err != nil {
 		return
 	}
@@ -162,7 +183,7 @@ func (tpl *Template) Delete(templateID string) (err error) {
 
 	uri := fmt.Sprintf("%s?access_token=%s", templateDelURL, accessToken)
 	var response []byte
-	response, err = util.PostJSON(uri, msg)
+	response, err = tpl.PostJSONContext(ctx, uri, msg)
 	if err != nil {
 		return
 	}
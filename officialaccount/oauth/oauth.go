@@ -1,6 +1,7 @@
 package oauth
 
 import (
+	context2 "context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -71,9 +72,14 @@ type ResAccessToken struct {
 
 // GetUserAccessToken 通过网页授权的code 换取access_token(区别于context中的access_token)
 func (oauth *Oauth) GetUserAccessToken(code string) (result ResAccessToken, err error) {
+	return oauth.GetUserAccessTokenContext(context2.Background(), code)
+}
+
+// GetUserAccessTokenContext 通过网页授权的code 换取access_token(区别于context中的access_token)
+func (oauth *Oauth) GetUserAccessTokenContext(ctx context2.Context, code string) (result ResAccessToken, err error) {
 	urlStr := fmt.Sprintf(accessTokenURL, oauth.AppID, oauth.AppSecret, code)
 	var response []byte
-	response, err = util.HTTPGet(urlStr)
+	response, err = oauth.HTTPGetContext(ctx, urlStr)
 	if err != nil {
 		return
 	}
@@ -90,9 +96,14 @@ func (oauth *Oauth) GetUserAccessToken(code string) (result ResAccessToken, err
 
 // RefreshAccessToken 刷新access_token
 func (oauth *Oauth) RefreshAccessToken(refreshToken string) (result ResAccessToken, err error) {
+	return oauth.RefreshAccessTokenContext(context2.Background(), refreshToken)
+}
+
+// RefreshAccessTokenContext 刷新access_token
+func (oauth *Oauth) RefreshAccessTokenContext(ctx context2.Context, refreshToken string) (result ResAccessToken, err error) {
 	urlStr := fmt.Sprintf(refreshAccessTokenURL, oauth.AppID, refreshToken)
 	var response []byte
-	response, err = util.HTTPGet(urlStr)
+	response, err = oauth.HTTPGetContext(ctx, urlStr)
 	if err != nil {
 		return
 	}
@@ -109,9 +120,14 @@ func (oauth *Oauth) RefreshAccessToken(refreshToken string) (result ResAccessTok
 
 // CheckAccessToken 检验access_token是否有效
 func (oauth *Oauth) CheckAccessToken(accessToken, openID string) (b bool, err error) {
+	return oauth.CheckAccessTokenContext(context2.Background(), accessToken, openID)
+}
+
+// CheckAccessTokenContext 检验access_token是否有效
+func (oauth *Oauth) CheckAccessTokenContext(ctx context2.Context, accessToken, openID string) (b bool, err error) {
 	urlStr := fmt.Sprintf(checkAccessTokenURL, accessToken, openID)
 	var response []byte
-	response, err = util.HTTPGet(urlStr)
+	response, err = oauth.HTTPGetContext(ctx, urlStr)
 	if err != nil {
 		return
 	}
@@ -145,12 +161,17 @@ type UserInfo struct {
 
 // GetUserInfo 如果scope为 snsapi_userinfo 则可以通过此方法获取到用户基本信息
 func (oauth *Oauth) GetUserInfo(accessToken, openID, lang string) (result UserInfo, err error) {
+	return oauth.GetUserInfoContext(context2.Background(), accessToken, openID, lang)
+}
+
+// GetUserInfoContext 如果scope为 snsapi_userinfo 则可以通过此方法获取到用户基本信息
+func (oauth *Oauth) GetUserInfoContext(ctx context2.Context, accessToken, openID, lang string) (result UserInfo, err error) {
 	if lang == "" {
 		lang = "zh_CN"
 	}
 	urlStr := fmt.Sprintf(userInfoURL, accessToken, openID, lang)
 	var response []byte
-	response, err = util.HTTPGet(urlStr)
+	response, err = oauth.HTTPGetContext(ctx, urlStr)
 	if err != nil {
 		return
 	}
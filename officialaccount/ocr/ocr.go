@@ -1,6 +1,7 @@
 package ocr
 
 import (
+	context2 "context"
 	"fmt"
 	"net/url"
 
@@ -155,14 +156,19 @@ func NewOCR(c *context.Context) *OCR {
 
 // IDCard 身份证OCR识别接口
 func (ocr *OCR) IDCard(path string) (ResIDCard ResIDCard, err error) {
-	accessToken, err := ocr.GetAccessToken()
+	return ocr.IDCardContext(context2.Background(), path)
+}
+
+// IDCardContext 身份证OCR识别接口
+func (ocr *OCR) IDCardContext(ctx context2.Context, path string) (ResIDCard ResIDCard, err error) {
+	accessToken, err := ocr.GetAccessTokenContext(ctx)
 	if err != nil {
 		return
 	}
 
 	uri := fmt.Sprintf("%s?img_url=%s&access_token=%s", ocrIDCardURL, url.QueryEscape(path), accessToken)
 
-	response, err := util.HTTPPost(uri, "")
+	response, err := ocr.HTTPPostContext(ctx, uri, []byte(""), nil)
 	if err != nil {
 		return
 	}
@@ -174,14 +180,19 @@ func (ocr *OCR) IDCard(path string) (ResIDCard ResIDCard, err error) {
 
 // BankCard 银行卡OCR识别接口
 func (ocr *OCR) BankCard(path string) (ResBankCard ResBankCard, err error) {
-	accessToken, err := ocr.GetAccessToken()
+	return ocr.BankCardContext(context2.Background(), path)
+}
+
+// BankCardContext 银行卡OCR识别接口
+func (ocr *OCR) BankCardContext(ctx context2.Context, path string) (ResBankCard ResBankCard, err error) {
+	accessToken, err := ocr.GetAccessTokenContext(ctx)
 	if err != nil {
 		return
 	}
 
 	uri := fmt.Sprintf("%s?img_url=%s&access_token=%s", ocrBankCardURL, url.QueryEscape(path), accessToken)
 
-	response, err := util.HTTPPost(uri, "")
+	response, err := ocr.HTTPPostContext(ctx, uri, []byte(""), nil)
 	if err != nil {
 		return
 	}
@@ -193,14 +204,19 @@ func (ocr *OCR) BankCard(path string) (ResBankCard ResBankCard, err error) {
 
 // Driving 行驶证OCR识别接口
 func (ocr *OCR) Driving(path string) (ResDriving ResDriving, err error) {
-	accessToken, err := ocr.GetAccessToken()
+	return ocr.DrivingContext(context2.Background(), path)
+}
+
+// DrivingContext 行驶证OCR识别接口
+func (ocr *OCR) DrivingContext(ctx context2.Context, path string) (ResDriving ResDriving, err error) {
+	accessToken, err := ocr.GetAccessTokenContext(ctx)
 	if err != nil {
 		return
 	}
 
 	uri := fmt.Sprintf("%s?img_url=%s&access_token=%s", ocrDrivingURL, url.QueryEscape(path), accessToken)
 
-	response, err := util.HTTPPost(uri, "")
+	response, err := ocr.HTTPPostContext(ctx, uri, []byte(""), nil)
 	if err != nil {
 		return
 	}
@@ -212,14 +228,19 @@ func (ocr *OCR) Driving(path string) (ResDriving ResDriving, err error) {
 
 // DrivingLicense 驾驶证OCR识别接口
 func (ocr *OCR) DrivingLicense(path string) (ResDrivingLicense ResDrivingLicense, err error) {
-	accessToken, err := ocr.GetAccessToken()
+	return ocr.DrivingLicenseContext(context2.Background(), path)
+}
+
+// DrivingLicenseContext 驾驶证OCR识别接口
+func (ocr *OCR) DrivingLicenseContext(ctx context2.Context, path string) (ResDrivingLicense ResDrivingLicense, err error) {
+	accessToken, err := ocr.GetAccessTokenContext(ctx)
 	if err != nil {
 		return
 	}
 
 	uri := fmt.Sprintf("%s?img_url=%s&access_token=%s", ocrDrivingLicenseURL, url.QueryEscape(path), accessToken)
 
-	response, err := util.HTTPPost(uri, "")
+	response, err := ocr.HTTPPostContext(ctx, uri, []byte(""), nil)
 	if err != nil {
 		return
 	}
@@ -231,14 +252,19 @@ func (ocr *OCR) DrivingLicense(path string) (ResDrivingLicense ResDrivingLicense
 
 // BizLicense 营业执照OCR识别接口
 func (ocr *OCR) BizLicense(path string) (ResBizLicense ResBizLicense, err error) {
-	accessToken, err := ocr.GetAccessToken()
+	return ocr.BizLicenseContext(context2.Background(), path)
+}
+
+// BizLicenseContext 营业执照OCR识别接口
+func (ocr *OCR) BizLicenseContext(ctx context2.Context, path string) (ResBizLicense ResBizLicense, err error) {
+	accessToken, err := ocr.GetAccessTokenContext(ctx)
 	if err != nil {
 		return
 	}
 
 	uri := fmt.Sprintf("%s?img_url=%s&access_token=%s", ocrBizLicenseURL, url.QueryEscape(path), accessToken)
 
-	response, err := util.HTTPPost(uri, "")
+	response, err := ocr.HTTPPostContext(ctx, uri, []byte(""), nil)
 	if err != nil {
 		return
 	}
@@ -250,14 +276,19 @@ func (ocr *OCR) BizLicense(path string) (ResBizLicense ResBizLicense, err error)
 
 // Common 通用印刷体OCR识别接口
 func (ocr *OCR) Common(path string) (ResCommon ResCommon, err error) {
-	accessToken, err := ocr.GetAccessToken()
+	return ocr.CommonContext(context2.Background(), path)
+}
+
+// CommonContext 通用印刷体OCR识别接口
+func (ocr *OCR) CommonContext(ctx context2.Context, path string) (ResCommon ResCommon, err error) {
+	accessToken, err := ocr.GetAccessTokenContext(ctx)
 	if err != nil {
 		return
 	}
 
 	uri := fmt.Sprintf("%s?img_url=%s&access_token=%s", ocrCommonURL, url.QueryEscape(path), accessToken)
 
-	response, err := util.HTTPPost(uri, "")
+	response, err := ocr.HTTPPostContext(ctx, uri, []byte(""), nil)
 	if err != nil {
 		return
 	}
@@ -269,14 +300,19 @@ func (ocr *OCR) Common(path string) (ResCommon ResCommon, err error) {
 
 // PlateNumber 车牌OCR识别接口
 func (ocr *OCR) PlateNumber(path string) (ResPlateNumber ResPlateNumber, err error) {
-	accessToken, err := ocr.GetAccessToken()
+	return ocr.PlateNumberContext(context2.Background(), path)
+}
+
+// PlateNumberContext 车牌OCR识别接口
+func (ocr *OCR) PlateNumberContext(ctx context2.Context, path string) (ResPlateNumber ResPlateNumber, err error) {
+	accessToken, err := ocr.GetAccessTokenContext(ctx)
 	if err != nil {
 		return
 	}
 
 	uri := fmt.Sprintf("%s?img_url=%s&access_token=%s", ocrPlateNumberURL, url.QueryEscape(path), accessToken)
 
-	response, err := util.HTTPPost(uri, "")
+	response, err := ocr.HTTPPostContext(ctx, uri, []byte(""), nil)
 	if err != nil {
 		return
 	}
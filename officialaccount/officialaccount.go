@@ -1,6 +1,7 @@
 package officialaccount
 
 import (
+	context2 "context"
 	"net/http"
 
 	"github.com/silenceper/wechat/v2/officialaccount/draft"
@@ -47,7 +48,12 @@ type OfficialAccount struct {
 
 // NewOfficialAccount 实例化公众号API
 func NewOfficialAccount(cfg *config.Config) *OfficialAccount {
-	defaultAkHandle := credential.NewDefaultAccessToken(cfg.AppID, cfg.AppSecret, credential.CacheKeyOfficialAccountPrefix, cfg.Cache)
+	var defaultAkHandle credential.AccessTokenHandle
+	if cfg.UseStableAK {
+		defaultAkHandle = credential.NewStableAccessToken(cfg.AppID, cfg.AppSecret, credential.CacheKeyOfficialAccountPrefix, cfg.Cache)
+	} else {
+		defaultAkHandle = credential.NewDefaultAccessToken(cfg.AppID, cfg.AppSecret, credential.CacheKeyOfficialAccountPrefix, cfg.Cache)
+	}
 	ctx := &context.Context{
 		Config:            cfg,
 		AccessTokenHandle: defaultAkHandle,
@@ -89,11 +95,21 @@ func (officialAccount *OfficialAccount) GetServer(req *http.Request, writer http
 	return srv
 }
 
+// GetHandler 消息管理：返回按 router 分发消息的 http.Handler
+func (officialAccount *OfficialAccount) GetHandler(router *server.Router) *server.Handler {
+	return server.NewHandler(officialAccount.ctx, router)
+}
+
 // GetAccessToken 获取access_token
 func (officialAccount *OfficialAccount) GetAccessToken() (string, error) {
 	return officialAccount.ctx.GetAccessToken()
 }
 
+// GetAccessTokenContext 获取access_token
+func (officialAccount *OfficialAccount) GetAccessTokenContext(ctx context2.Context) (string, error) {
+	return officialAccount.ctx.GetAccessTokenContext(ctx)
+}
+
 // GetOauth oauth2网页授权
 func (officialAccount *OfficialAccount) GetOauth() *oauth.Oauth {
 	if officialAccount.oauth == nil {
@@ -0,0 +1,134 @@
+package server
+
+import (
+	context2 "context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/silenceper/wechat/v2/logger"
+	"github.com/silenceper/wechat/v2/officialaccount/context"
+	"github.com/silenceper/wechat/v2/officialaccount/message"
+)
+
+// DefaultAsyncTimeout 异步处理单条消息的默认超时时间
+const DefaultAsyncTimeout = 30 * time.Second
+
+// ErrAsyncQueueFull 异步队列已满，回复 503 由微信重试推送
+var ErrAsyncQueueFull = NewHTTPError(http.StatusServiceUnavailable, errors.New("async queue is full"))
+
+// ErrAsyncPoolClosed 异步协程池已关闭
+var ErrAsyncPoolClosed = NewHTTPError(http.StatusServiceUnavailable, errors.New("async pool is closed"))
+
+// AsyncPool 异步回复的协程池
+//
+// Server 设置 AsyncPool 后收到消息立即回复 success，消息处理方法在协程池中执行，
+// 返回的回复通过客服消息接口发送给用户（需用户 48 小时内与公众号有过互动）
+type AsyncPool struct {
+	manager *message.Manager
+	log     logger.Logger
+	timeout time.Duration
+
+	jobs chan asyncJob
+	wg   sync.WaitGroup
+
+	mu     sync.RWMutex
+	closed bool
+}
+
+type asyncJob struct {
+	handler HandlerFunc
+	msg     *message.MixMessage
+	meta    *RequestMeta
+}
+
+// NewAsyncPool init，workers 为协程数，queueSize 为等待处理的最大消息数
+func NewAsyncPool(ctx *context.Context, workers, queueSize int) *AsyncPool {
+	if workers <= 0 {
+		workers = 1
+	}
+	pool := &AsyncPool{
+		manager: message.NewMessageManager(ctx),
+		log:     logger.Redact(logger.Or(ctx.Logger)),
+		timeout: DefaultAsyncTimeout,
+		jobs:    make(chan asyncJob, queueSize),
+	}
+	pool.wg.Add(workers)
+	for i := 0; i < workers; i++ {
+		go pool.work()
+	}
+	return pool
+}
+
+// SetTimeout 设置处理单条消息（包括发送客服消息）的超时时间，需在使用前设置
+func (pool *AsyncPool) SetTimeout(timeout time.Duration) {
+	pool.timeout = timeout
+}
+
+// Close 停止接收消息，等待已接收的消息处理完成
+func (pool *AsyncPool) Close() {
+	pool.mu.Lock()
+	if pool.closed {
+		pool.mu.Unlock()
+		return
+	}
+	pool.closed = true
+	close(pool.jobs)
+	pool.mu.Unlock()
+	pool.wg.Wait()
+}
+
+// submit 提交消息，队列已满时不阻塞，返回 ErrAsyncQueueFull
+func (pool *AsyncPool) submit(handler HandlerFunc, msg *message.MixMessage, meta *RequestMeta) error {
+	pool.mu.RLock()
+	defer pool.mu.RUnlock()
+	if pool.closed {
+		return ErrAsyncPoolClosed
+	}
+	select {
+	case pool.jobs <- asyncJob{handler: handler, msg: msg, meta: meta}:
+		return nil
+	default:
+		return ErrAsyncQueueFull
+	}
+}
+
+func (pool *AsyncPool) work() {
+	defer pool.wg.Done()
+	for job := range pool.jobs {
+		pool.handle(job)
+	}
+}
+
+func (pool *AsyncPool) handle(job asyncJob) {
+	defer func() {
+		if e := recover(); e != nil {
+			pool.log.Error("async handler panic", logger.Any("panic", e), logger.String("openid", string(job.msg.FromUserName)))
+		}
+	}()
+	ctx, cancel := context2.WithTimeout(context2.Background(), pool.timeout)
+	defer cancel()
+
+	reply, err := job.handler(ctx, job.msg, job.meta)
+	if err != nil {
+		pool.log.Error("async handler failed", logger.Err(err), logger.String("openid", string(job.msg.FromUserName)))
+		return
+	}
+	if reply == nil {
+		return
+	}
+	customerMsg, err := message.NewCustomerMessageFromReply(string(job.msg.FromUserName), reply)
+	if err != nil {
+		pool.log.Error("convert async reply failed", logger.Err(err), logger.Any("msg_type", reply.MsgType))
+		return
+	}
+	if err = pool.manager.SendContext(ctx, customerMsg); err != nil {
+		pool.log.Error("send async reply failed", logger.Err(err), logger.String("openid", string(job.msg.FromUserName)))
+	}
+}
+
+// SetAsync 设置异步回复的协程池，为 nil 时同步回复
+func (srv *Server) SetAsync(pool *AsyncPool) {
+	srv.async = pool
+}
@@ -0,0 +1,108 @@
+package server
+
+import (
+	context2 "context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+
+	"github.com/silenceper/wechat/v2/officialaccount/message"
+	"github.com/silenceper/wechat/v2/util"
+)
+
+type stubAccessToken struct{}
+
+func (stubAccessToken) GetAccessToken() (string, error) {
+	return "mock-access-token", nil
+}
+
+func TestAsyncPool(t *testing.T) {
+	defer gock.Off()
+	gock.New("https://api.weixin.qq.com").
+		Post("/cgi-bin/message/custom/send").
+		MatchParam("access_token", "mock-access-token").
+		BodyString(`"touser":"openid".*"msgtype":"text".*"content":"pong"`).
+		Reply(200).
+		JSON(map[string]interface{}{"errcode": 0, "errmsg": "ok"})
+
+	ctx := newTestContext()
+	ctx.AccessTokenHandle = stubAccessToken{}
+	pool := NewAsyncPool(ctx, 2, 10)
+
+	handled := make(chan struct{})
+	srv := NewServer(ctx)
+	srv.Request = newTestRequest(`<xml><ToUserName>gh_1</ToUserName><FromUserName>openid</FromUserName><MsgType>text</MsgType><Content>ping</Content><MsgId>1</MsgId></xml>`)
+	w := httptest.NewRecorder()
+	srv.Writer = w
+	srv.SetAsync(pool)
+	srv.SetMessageHandlerContext(func(ctx context2.Context, msg *message.MixMessage, meta *RequestMeta) (*message.Reply, error) {
+		<-handled
+		return &message.Reply{MsgType: message.MsgTypeText, MsgData: message.NewText("pong")}, nil
+	})
+	// 处理方法未完成时立即回复 success
+	assert.Nil(t, srv.Serve())
+	assert.Equal(t, "success", w.Body.String())
+
+	close(handled)
+	pool.Close()
+	assert.True(t, gock.IsDone())
+
+	// 关闭后不再接收消息
+	assert.ErrorIs(t, pool.submit(nil, nil, nil), ErrAsyncPoolClosed)
+}
+
+func TestAsyncPoolSafeMode(t *testing.T) {
+	ctx := newTestContext()
+	ctx.EncodingAESKey = "abcdefghijklmnopqrstuvwxyz0123456789ABCDEFG"
+	pool := NewAsyncPool(ctx, 1, 10)
+	defer pool.Close()
+
+	raw := `<xml><ToUserName>gh_1</ToUserName><FromUserName>openid</FromUserName><MsgType>text</MsgType><Content>ping</Content><MsgId>1</MsgId></xml>`
+	encrypted, err := util.EncryptMsg([]byte("0123456789abcdef"), []byte(raw), ctx.AppID, ctx.EncodingAESKey)
+	assert.NoError(t, err)
+	query := url.Values{}
+	query.Set("timestamp", "1700000000")
+	query.Set("nonce", "nonce")
+	query.Set("signature", util.Signature(testToken, "1700000000", "nonce"))
+	query.Set("encrypt_type", "aes")
+	query.Set("msg_signature", util.Signature(testToken, "1700000000", "nonce", string(encrypted)))
+	body := `<xml><ToUserName><![CDATA[gh_1]]></ToUserName><Encrypt><![CDATA[` + string(encrypted) + `]]></Encrypt></xml>`
+
+	srv := NewServer(ctx)
+	srv.Request = httptest.NewRequest(http.MethodPost, "/wechat?"+query.Encode(), strings.NewReader(body))
+	w := httptest.NewRecorder()
+	srv.Writer = w
+	srv.SetAsync(pool)
+	srv.SetMessageHandlerContext(func(context2.Context, *message.MixMessage, *RequestMeta) (*message.Reply, error) {
+		return nil, nil
+	})
+	// 安全模式下同样直接回复 success，Send 不再追加加密的空消息
+	assert.Nil(t, srv.Serve())
+	assert.Nil(t, srv.Send())
+	assert.Equal(t, "success", w.Body.String())
+}
+
+func TestAsyncPoolQueueFull(t *testing.T) {
+	ctx := newTestContext()
+	pool := NewAsyncPool(ctx, 1, 0)
+	defer pool.Close()
+
+	block := make(chan struct{})
+	defer close(block)
+	handler := func(context2.Context, *message.MixMessage, *RequestMeta) (*message.Reply, error) {
+		<-block
+		return nil, nil
+	}
+	msg := &message.MixMessage{}
+	// 等待唯一的协程开始处理
+	assert.Eventually(t, func() bool {
+		return pool.submit(handler, msg, nil) == nil
+	}, time.Second, time.Millisecond)
+	assert.Equal(t, ErrAsyncQueueFull, pool.submit(handler, msg, nil))
+}
@@ -0,0 +1,90 @@
+package server
+
+import (
+	context2 "context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/silenceper/wechat/v2/cache"
+	"github.com/silenceper/wechat/v2/credential"
+	"github.com/silenceper/wechat/v2/logger"
+	"github.com/silenceper/wechat/v2/officialaccount/message"
+)
+
+// DefaultDedupWindow 默认去重时间窗口，微信在 15 秒内最多重试 3 次
+const DefaultDedupWindow = time.Minute
+
+// Dedup 去重中间件，丢弃微信重试推送的重复消息，重复消息回复 success
+//
+// 普通消息按 MsgId 去重，事件按 FromUserName + CreateTime 去重；处理方法返回错误时允许重试，
+// 但超时或取消时保留去重记录，避免微信重试时再次执行耗时的处理；
+// 多实例部署时 c 需为 Redis 等共享缓存，window <= 0 时使用 DefaultDedupWindow
+func Dedup(c cache.Cache, window time.Duration, opts ...DedupOption) Middleware {
+	var l logger.Logger
+	for _, opt := range opts {
+		opt(&l)
+	}
+	return dedup(c, window, logger.Redact(logger.Or(l)))
+}
+
+// DedupOption Dedup 配置项
+type DedupOption func(l *logger.Logger)
+
+// WithDedupLogger 设置缓存不可用时使用的日志，通常为 config.Config 的 Logger，为空时输出到 logrus 的 StandardLogger
+func WithDedupLogger(l logger.Logger) DedupOption {
+	return func(dst *logger.Logger) {
+		*dst = l
+	}
+}
+
+func dedup(c cache.Cache, window time.Duration, log logger.Logger) Middleware {
+	if window <= 0 {
+		window = DefaultDedupWindow
+	}
+	cc := cache.FromCache(c)
+	return func(next HandlerFunc) HandlerFunc {
+		return func(ctx context2.Context, msg *message.MixMessage, meta *RequestMeta) (*message.Reply, error) {
+			key := dedupKey(msg)
+			ok, err := cc.SetNX(ctx, key, "1", window)
+			if err != nil {
+				// 缓存不可用时不去重，避免丢失消息
+				log.Warn("dedup wechat message failed", logger.String("key", key), logger.Err(err))
+				return next(ctx, msg, meta)
+			}
+			if !ok {
+				return nil, nil
+			}
+			reply, err := next(ctx, msg, meta)
+			if retryable(ctx, err) {
+				// 使用新的 context 删除，不受处理方法的 ctx 影响
+				_ = cc.DeleteContext(context2.Background(), key)
+			}
+			return reply, err
+		}
+	}
+}
+
+// retryable 处理方法返回错误且未超时或取消时允许微信重试
+func retryable(ctx context2.Context, err error) bool {
+	if err == nil || ctx.Err() != nil {
+		return false
+	}
+	return !errors.Is(err, context2.DeadlineExceeded) && !errors.Is(err, context2.Canceled)
+}
+
+func dedupKey(msg *message.MixMessage) string {
+	if msg.MsgType != message.MsgTypeEvent && msg.MsgID != 0 {
+		return fmt.Sprintf("%sdedup_%s_msg_%d", credential.CacheKeyOfficialAccountPrefix, msg.ToUserName, msg.MsgID)
+	}
+	return fmt.Sprintf("%sdedup_%s_event_%s_%d", credential.CacheKeyOfficialAccountPrefix, msg.ToUserName, msg.FromUserName, msg.CreateTime)
+}
+
+// SetDedupWindow 设置去重时间窗口，> 0 时使用配置的 Cache 对重复消息去重，见 Dedup
+func (srv *Server) SetDedupWindow(window time.Duration) {
+	if window <= 0 {
+		srv.dedupMiddleware = nil
+		return
+	}
+	srv.dedupMiddleware = dedup(srv.Cache, window, srv.log())
+}
@@ -0,0 +1,147 @@
+package server
+
+import (
+	context2 "context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/silenceper/wechat/v2/cache"
+	"github.com/silenceper/wechat/v2/logger"
+	"github.com/silenceper/wechat/v2/officialaccount/message"
+)
+
+func TestDedup(t *testing.T) {
+	memory := cache.NewMemory()
+	defer memory.Close()
+
+	var calls int
+	fail := true
+	handler := Dedup(memory, time.Minute)(func(context2.Context, *message.MixMessage, *RequestMeta) (*message.Reply, error) {
+		calls++
+		if fail {
+			return nil, errors.New("failed")
+		}
+		return &message.Reply{MsgType: message.MsgTypeText, MsgData: message.NewText("ok")}, nil
+	})
+	handle := func(msg *message.MixMessage) (*message.Reply, error) {
+		return handler(context2.Background(), msg, &RequestMeta{})
+	}
+
+	text := &message.MixMessage{CommonToken: message.CommonToken{ToUserName: "gh_1", FromUserName: "openid", MsgType: message.MsgTypeText}, MsgID: 1}
+	// 处理失败允许重试
+	_, err := handle(text)
+	assert.Error(t, err)
+	fail = false
+	reply, err := handle(text)
+	assert.Nil(t, err)
+	assert.NotNil(t, reply)
+	reply, err = handle(text)
+	assert.Nil(t, err)
+	assert.Nil(t, reply)
+	assert.Equal(t, 2, calls)
+
+	// 事件按 FromUserName + CreateTime 去重
+	event := &message.MixMessage{CommonToken: message.CommonToken{ToUserName: "gh_1", FromUserName: "openid", CreateTime: 1700000000, MsgType: message.MsgTypeEvent}, Event: message.EventSubscribe}
+	_, _ = handle(event)
+	_, _ = handle(event)
+	other := *event
+	other.FromUserName = "openid2"
+	_, _ = handle(&other)
+	assert.Equal(t, 4, calls)
+}
+
+func TestServerDedup(t *testing.T) {
+	memory := cache.NewMemory()
+	defer memory.Close()
+	ctx := newTestContext()
+	ctx.Cache = memory
+
+	var calls int
+	for i := 0; i < 3; i++ {
+		srv := NewServer(ctx)
+		srv.Request = newTestRequest(`<xml><ToUserName>gh_1</ToUserName><FromUserName>openid</FromUserName><MsgType>text</MsgType><Content>hi</Content><MsgId>1</MsgId></xml>`)
+		w := httptest.NewRecorder()
+		srv.Writer = w
+		srv.SetDedupWindow(time.Minute)
+		srv.SetMessageHandler(func(*message.MixMessage) *message.Reply {
+			calls++
+			return nil
+		})
+		assert.Nil(t, srv.Serve())
+		assert.Equal(t, "success", w.Body.String())
+	}
+	assert.Equal(t, 1, calls)
+}
+
+func TestServerDedupTimeout(t *testing.T) {
+	memory := cache.NewMemory()
+	defer memory.Close()
+	ctx := newTestContext()
+	ctx.Cache = memory
+
+	var calls int
+	serve := func() (*httptest.ResponseRecorder, error) {
+		srv := NewServer(ctx)
+		srv.Request = newTestRequest(`<xml><ToUserName>gh_1</ToUserName><FromUserName>openid</FromUserName><MsgType>text</MsgType><Content>hi</Content><MsgId>2</MsgId></xml>`)
+		w := httptest.NewRecorder()
+		srv.Writer = w
+		srv.SetDedupWindow(time.Minute)
+		srv.SetReplyTimeout(10 * time.Millisecond)
+		srv.SetMessageHandlerContext(func(ctx context2.Context, _ *message.MixMessage, _ *RequestMeta) (*message.Reply, error) {
+			calls++
+			<-ctx.Done()
+			return nil, ctx.Err()
+		})
+		return w, srv.Serve()
+	}
+
+	// 处理超时后保留去重记录，微信重试时不再执行
+	_, err := serve()
+	var httpErr *HTTPError
+	assert.True(t, errors.As(err, &httpErr))
+	assert.Equal(t, http.StatusGatewayTimeout, httpErr.Code)
+	w, err := serve()
+	assert.Nil(t, err)
+	assert.Equal(t, "success", w.Body.String())
+	assert.Equal(t, 1, calls)
+}
+
+type failingCache struct{}
+
+func (failingCache) Get(string) interface{}                       { return nil }
+func (failingCache) Set(string, interface{}, time.Duration) error { return errors.New("unavailable") }
+func (failingCache) IsExist(string) bool                          { return false }
+func (failingCache) Delete(string) error                          { return nil }
+
+type recordLogger struct {
+	warns []string
+}
+
+func (l *recordLogger) Debug(string, ...logger.Field) {}
+func (l *recordLogger) Info(string, ...logger.Field)  {}
+func (l *recordLogger) Warn(msg string, _ ...logger.Field) {
+	l.warns = append(l.warns, msg)
+}
+func (l *recordLogger) Error(string, ...logger.Field) {}
+
+func TestDedupLogger(t *testing.T) {
+	l := &recordLogger{}
+	var calls int
+	handler := Dedup(failingCache{}, time.Minute, WithDedupLogger(l))(func(context2.Context, *message.MixMessage, *RequestMeta) (*message.Reply, error) {
+		calls++
+		return nil, nil
+	})
+	// 缓存不可用时不去重，并通过配置的日志记录
+	text := &message.MixMessage{CommonToken: message.CommonToken{ToUserName: "gh_1", MsgType: message.MsgTypeText}, MsgID: 1}
+	for i := 0; i < 2; i++ {
+		_, err := handler(context2.Background(), text, &RequestMeta{})
+		assert.Nil(t, err)
+	}
+	assert.Equal(t, 2, calls)
+	assert.Equal(t, []string{"dedup wechat message failed", "dedup wechat message failed"}, l.warns)
+}
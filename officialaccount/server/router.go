@@ -0,0 +1,151 @@
+package server
+
+import (
+	context2 "context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/silenceper/wechat/v2/logger"
+	"github.com/silenceper/wechat/v2/officialaccount/context"
+	"github.com/silenceper/wechat/v2/officialaccount/message"
+	"github.com/silenceper/wechat/v2/util"
+)
+
+// Middleware 中间件，包装 HandlerFunc，可在处理前后执行逻辑或直接返回
+type Middleware func(next HandlerFunc) HandlerFunc
+
+// Router 按消息类型、事件类型及 EventKey 分发消息
+//
+// 匹配顺序：事件类型 + EventKey、事件类型、消息类型、Fallback；事件类型不区分大小写
+type Router struct {
+	msgHandlers      map[message.MsgType]HandlerFunc
+	eventHandlers    map[string]HandlerFunc
+	eventKeyHandlers map[string]map[string]HandlerFunc
+	fallback         HandlerFunc
+	middlewares      []Middleware
+}
+
+// NewRouter init
+func NewRouter() *Router {
+	return &Router{
+		msgHandlers:      map[message.MsgType]HandlerFunc{},
+		eventHandlers:    map[string]HandlerFunc{},
+		eventKeyHandlers: map[string]map[string]HandlerFunc{},
+	}
+}
+
+// Use 添加中间件，按添加顺序由外到内执行，对所有消息（包括 Fallback）生效
+func (r *Router) Use(middlewares ...Middleware) *Router {
+	r.middlewares = append(r.middlewares, middlewares...)
+	return r
+}
+
+// Msg 注册消息类型的处理方法，如 message.MsgTypeText
+func (r *Router) Msg(msgType message.MsgType, handler HandlerFunc) *Router {
+	r.msgHandlers[msgType] = handler
+	return r
+}
+
+// Event 注册事件类型的处理方法，如 message.EventSubscribe
+func (r *Router) Event(event message.EventType, handler HandlerFunc) *Router {
+	r.eventHandlers[eventName(event)] = handler
+	return r
+}
+
+// EventKey 注册事件类型且 EventKey 完全匹配时的处理方法，如 CLICK 事件的菜单 key
+func (r *Router) EventKey(event message.EventType, key string, handler HandlerFunc) *Router {
+	name := eventName(event)
+	if r.eventKeyHandlers[name] == nil {
+		r.eventKeyHandlers[name] = map[string]HandlerFunc{}
+	}
+	r.eventKeyHandlers[name][key] = handler
+	return r
+}
+
+// Fallback 注册未匹配时的处理方法，未设置时回复 success
+func (r *Router) Fallback(handler HandlerFunc) *Router {
+	r.fallback = handler
+	return r
+}
+
+// Handle 分发消息，可直接作为 Server.SetMessageHandlerContext 的参数
+func (r *Router) Handle(ctx context2.Context, msg *message.MixMessage, meta *RequestMeta) (*message.Reply, error) {
+	handler := r.match(msg)
+	if handler == nil {
+		handler = func(context2.Context, *message.MixMessage, *RequestMeta) (*message.Reply, error) {
+			return nil, nil
+		}
+	}
+	for i := len(r.middlewares) - 1; i >= 0; i-- {
+		handler = r.middlewares[i](handler)
+	}
+	return handler(ctx, msg, meta)
+}
+
+func (r *Router) match(msg *message.MixMessage) HandlerFunc {
+	if msg.MsgType == message.MsgTypeEvent {
+		name := eventName(msg.Event)
+		if handler, ok := r.eventKeyHandlers[name][msg.EventKey]; ok {
+			return handler
+		}
+		if handler, ok := r.eventHandlers[name]; ok {
+			return handler
+		}
+	}
+	if handler, ok := r.msgHandlers[msg.MsgType]; ok {
+		return handler
+	}
+	return r.fallback
+}
+
+func eventName(event message.EventType) string {
+	return strings.ToLower(string(event))
+}
+
+// Handler 基于 Router 的 http.Handler，每个请求使用新的 Server 处理
+type Handler struct {
+	ctx    *context.Context
+	router *Router
+
+	// ErrorHandler 处理失败时调用，默认记录日志并返回 400
+	ErrorHandler func(w http.ResponseWriter, req *http.Request, err error)
+	// Async 不为空时异步处理消息，通过客服消息回复，见 AsyncPool
+	Async *AsyncPool
+	// ReplayGuard 不为空时校验时间戳及 nonce，拒绝重放的请求
+	ReplayGuard *util.ReplayGuard
+}
+
+// NewHandler init
+func NewHandler(ctx *context.Context, router *Router) *Handler {
+	return &Handler{ctx: ctx, router: router}
+}
+
+// ServeHTTP 处理微信的请求
+func (h *Handler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	srv := NewServer(h.ctx)
+	srv.Request = req
+	srv.Writer = w
+	srv.SetMessageHandlerContext(h.router.Handle)
+	srv.SetAsync(h.Async)
+	srv.SetReplayGuard(h.ReplayGuard)
+	err := srv.Serve()
+	if err == nil {
+		err = srv.Send()
+	}
+	if err == nil {
+		return
+	}
+	var httpErr *HTTPError
+	if errors.As(err, &httpErr) {
+		srv.log().Error("handle wechat message failed", logger.Err(err))
+		http.Error(w, http.StatusText(httpErr.Code), httpErr.Code)
+		return
+	}
+	if h.ErrorHandler != nil {
+		h.ErrorHandler(w, req, err)
+		return
+	}
+	srv.log().Error("serve wechat callback failed", logger.Err(err))
+	http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+}
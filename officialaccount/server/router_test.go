@@ -0,0 +1,177 @@
+package server
+
+import (
+	context2 "context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/silenceper/wechat/v2/cache"
+	"github.com/silenceper/wechat/v2/officialaccount/config"
+	"github.com/silenceper/wechat/v2/officialaccount/context"
+	"github.com/silenceper/wechat/v2/officialaccount/message"
+	"github.com/silenceper/wechat/v2/util"
+)
+
+const testToken = "token"
+
+func newTestContext() *context.Context {
+	return &context.Context{Config: &config.Config{AppID: "appid", Token: testToken}}
+}
+
+// newTestRequest 构造带签名的明文模式回调请求
+func newTestRequest(body string) *http.Request {
+	query := url.Values{}
+	query.Set("timestamp", "1700000000")
+	query.Set("nonce", "nonce")
+	query.Set("signature", util.Signature(testToken, "1700000000", "nonce"))
+	query.Set("openid", "openid")
+	return httptest.NewRequest(http.MethodPost, "/wechat?"+query.Encode(), strings.NewReader(body))
+}
+
+func textReply(content string) HandlerFunc {
+	return ReplyFunc(func(*message.MixMessage) *message.Reply {
+		return &message.Reply{MsgType: message.MsgTypeText, MsgData: message.NewText(content)}
+	})
+}
+
+func TestRouter(t *testing.T) {
+	var calls []string
+	router := NewRouter().
+		Use(func(next HandlerFunc) HandlerFunc {
+			return func(ctx context2.Context, msg *message.MixMessage, meta *RequestMeta) (*message.Reply, error) {
+				calls = append(calls, "mw:"+string(msg.MsgType))
+				return next(ctx, msg, meta)
+			}
+		}).
+		Msg(message.MsgTypeText, textReply("text")).
+		Event(message.EventSubscribe, textReply("subscribe")).
+		Event(message.EventClick, textReply("click")).
+		EventKey(message.EventClick, "menu_1", textReply("menu_1")).
+		Fallback(textReply("fallback"))
+
+	tests := []struct {
+		msg  *message.MixMessage
+		want string
+	}{
+		{&message.MixMessage{CommonToken: message.CommonToken{MsgType: message.MsgTypeText}}, "text"},
+		{&message.MixMessage{CommonToken: message.CommonToken{MsgType: message.MsgTypeEvent}, Event: message.EventSubscribe}, "subscribe"},
+		{&message.MixMessage{CommonToken: message.CommonToken{MsgType: message.MsgTypeEvent}, Event: "click", EventKey: "menu_2"}, "click"},
+		{&message.MixMessage{CommonToken: message.CommonToken{MsgType: message.MsgTypeEvent}, Event: message.EventClick, EventKey: "menu_1"}, "menu_1"},
+		{&message.MixMessage{CommonToken: message.CommonToken{MsgType: message.MsgTypeImage}}, "fallback"},
+	}
+	for _, tt := range tests {
+		reply, err := router.Handle(context2.Background(), tt.msg, &RequestMeta{})
+		assert.Nil(t, err)
+		assert.Equal(t, message.CDATA(tt.want), reply.MsgData.(*message.Text).Content)
+	}
+	assert.Len(t, calls, len(tests))
+
+	// 未设置 Fallback 时返回 nil
+	reply, err := NewRouter().Handle(context2.Background(), &message.MixMessage{}, &RequestMeta{})
+	assert.Nil(t, err)
+	assert.Nil(t, reply)
+}
+
+func TestHandler(t *testing.T) {
+	router := NewRouter().Msg(message.MsgTypeText, textReply("hello"))
+	handler := NewHandler(newTestContext(), router)
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, newTestRequest(`<xml><ToUserName>gh_1</ToUserName><FromUserName>openid</FromUserName><CreateTime>1700000000</CreateTime><MsgType>text</MsgType><Content>hi</Content><MsgId>1</MsgId></xml>`))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), "<Content><![CDATA[hello]]></Content>")
+	assert.Contains(t, w.Body.String(), "<ToUserName><![CDATA[openid]]></ToUserName>")
+
+	// 未匹配时回复 success
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, newTestRequest(`<xml><MsgType>image</MsgType></xml>`))
+	assert.Equal(t, "success", w.Body.String())
+
+	// 签名错误
+	w = httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/wechat?signature=bad", strings.NewReader(""))
+	handler.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestHandlerContext(t *testing.T) {
+	const body = `<xml><ToUserName>gh_1</ToUserName><FromUserName>openid</FromUserName><MsgType>text</MsgType><Content>hi</Content></xml>`
+	router := NewRouter().Msg(message.MsgTypeText, func(ctx context2.Context, msg *message.MixMessage, meta *RequestMeta) (*message.Reply, error) {
+		deadline, ok := ctx.Deadline()
+		assert.True(t, ok)
+		assert.True(t, time.Until(deadline) <= DefaultReplyTimeout)
+		assert.Equal(t, "openid", meta.OpenID)
+		assert.Equal(t, int64(1700000000), meta.Timestamp)
+		assert.Contains(t, string(meta.RawMsg), "<Content>"+msg.Content+"</Content>")
+		switch msg.Content {
+		case "forbidden":
+			return nil, NewHTTPError(http.StatusForbidden, errors.New("forbidden"))
+		case "timeout":
+			<-ctx.Done()
+			return nil, ctx.Err()
+		}
+		return nil, errors.New("failed")
+	})
+	handler := NewHandler(newTestContext(), router)
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, newTestRequest(body))
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, newTestRequest(strings.Replace(body, "hi", "forbidden", 1)))
+	assert.Equal(t, http.StatusForbidden, w.Code)
+
+	srv := NewServer(newTestContext())
+	srv.Request = newTestRequest(strings.Replace(body, "hi", "timeout", 1))
+	w = httptest.NewRecorder()
+	srv.Writer = w
+	srv.SetReplyTimeout(10 * time.Millisecond)
+	srv.SetMessageHandlerContext(router.Handle)
+	err := srv.Serve()
+	assert.ErrorIs(t, err, context2.DeadlineExceeded)
+	var httpErr *HTTPError
+	if assert.ErrorAs(t, err, &httpErr) {
+		assert.Equal(t, http.StatusGatewayTimeout, httpErr.Code)
+	}
+	// Serve 只返回错误，由调用方回复
+	assert.Empty(t, w.Body.String())
+}
+
+func TestHandlerReplayGuard(t *testing.T) {
+	memory := cache.NewMemory()
+	defer memory.Close()
+	var calls int
+	router := NewRouter().Fallback(ReplyFunc(func(*message.MixMessage) *message.Reply {
+		calls++
+		return nil
+	}))
+	handler := NewHandler(newTestContext(), router)
+	handler.ReplayGuard = util.NewReplayGuard(memory, "test_", time.Minute)
+
+	// 测试请求的时间戳已过期
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, newTestRequest(`<xml><MsgType>text</MsgType></xml>`))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	query := url.Values{}
+	ts := strconv.FormatInt(time.Now().Unix(), 10)
+	query.Set("timestamp", ts)
+	query.Set("nonce", "nonce")
+	query.Set("signature", util.Signature(testToken, ts, "nonce"))
+	for i := 0; i < 2; i++ {
+		w = httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/wechat?"+query.Encode(), strings.NewReader(`<xml><MsgType>text</MsgType></xml>`)))
+	}
+	// 重放的请求被拒绝
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Equal(t, 1, calls)
+}
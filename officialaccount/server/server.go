@@ -1,6 +1,7 @@
 package server
 
 import (
+	context2 "context"
 	"encoding/json"
 	"encoding/xml"
 	"errors"
@@ -11,10 +12,11 @@ import (
 	"runtime/debug"
 	"strconv"
 	"strings"
+	"time"
 
-	log "github.com/sirupsen/logrus"
 	"github.com/tidwall/gjson"
 
+	"github.com/silenceper/wechat/v2/logger"
 	"github.com/silenceper/wechat/v2/officialaccount/context"
 	"github.com/silenceper/wechat/v2/officialaccount/message"
 	"github.com/silenceper/wechat/v2/util"
@@ -30,7 +32,12 @@ type Server struct {
 
 	openID string
 
-	messageHandler func(*message.MixMessage) *message.Reply
+	messageHandler  HandlerFunc
+	replyTimeout    time.Duration
+	dedupMiddleware Middleware
+	async           *AsyncPool
+	replayGuard     *util.ReplayGuard
+	replied         bool
 
 	RequestRawXMLMsg  []byte
 	RequestMsg        *message.MixMessage
@@ -44,6 +51,64 @@ type Server struct {
 	timestamp     int64
 }
 
+// DefaultReplyTimeout 微信服务器等待被动回复的时间，超时后会重试推送
+const DefaultReplyTimeout = 5 * time.Second
+
+// RequestMeta 回调请求的元数据
+type RequestMeta struct {
+	Request   *http.Request // 异步处理时请求已结束，不可读取 Body
+	OpenID    string
+	Timestamp int64
+	Nonce     string
+	SafeMode  bool   // 是否为安全模式
+	RawMsg    []byte // 解密后的消息
+}
+
+// HandlerFunc 支持 context 的消息处理方法
+//
+// ctx 的截止时间为收到请求后 DefaultReplyTimeout（可通过 SetReplyTimeout 修改），
+// 返回 nil reply 时回复 success，返回错误时 Serve 返回 *HTTPError，Handler 按其状态码回复
+type HandlerFunc func(ctx context2.Context, msg *message.MixMessage, meta *RequestMeta) (*message.Reply, error)
+
+// ReplyFunc 将不需要 context 且不返回错误的方法转换为 HandlerFunc
+func ReplyFunc(handler func(*message.MixMessage) *message.Reply) HandlerFunc {
+	return func(_ context2.Context, msg *message.MixMessage, _ *RequestMeta) (*message.Reply, error) {
+		return handler(msg), nil
+	}
+}
+
+// HTTPError 带 HTTP 状态码的错误，消息处理方法返回该错误时 Handler 按 Code 回复；直接调用 Serve 时由调用方回复
+type HTTPError struct {
+	Code int
+	Err  error
+}
+
+// NewHTTPError init
+func NewHTTPError(code int, err error) *HTTPError {
+	return &HTTPError{Code: code, Err: err}
+}
+
+func (e *HTTPError) Error() string {
+	return fmt.Sprintf("http %d: %v", e.Code, e.Err)
+}
+
+// Unwrap 返回原始错误
+func (e *HTTPError) Unwrap() error {
+	return e.Err
+}
+
+// toHTTPError 超时回复 504，其余未指定状态码的错误回复 500
+func toHTTPError(err error) *HTTPError {
+	var httpErr *HTTPError
+	if errors.As(err, &httpErr) {
+		return httpErr
+	}
+	if errors.Is(err, context2.DeadlineExceeded) {
+		return NewHTTPError(http.StatusGatewayTimeout, err)
+	}
+	return NewHTTPError(http.StatusInternalServerError, err)
+}
+
 // NewServer init
 func NewServer(context *context.Context) *Server {
 	srv := new(Server)
@@ -51,6 +116,11 @@ func NewServer(context *context.Context) *Server {
 	return srv
 }
 
+// log 日志，字段经过脱敏
+func (srv *Server) log() logger.Logger {
+	return logger.Redact(logger.Or(srv.Logger))
+}
+
 // SkipValidate set skip validate
 func (srv *Server) SkipValidate(skip bool) {
 	srv.skipValidate = skip
@@ -59,9 +129,15 @@ func (srv *Server) SkipValidate(skip bool) {
 // Serve 处理微信的请求消息
 func (srv *Server) Serve() error {
 	if !srv.Validate() {
-		log.Error("Validate Signature Failed.")
+		srv.log().Error("validate signature failed", logger.String("timestamp", srv.Query("timestamp")), logger.String("nonce", srv.Query("nonce")))
 		return fmt.Errorf("请求校验失败")
 	}
+	if srv.replayGuard != nil {
+		if err := srv.replayGuard.Check(srv.Request.Context(), srv.Query("timestamp"), srv.Query("nonce")); err != nil {
+			srv.log().Error("check replay failed", logger.Err(err), logger.String("timestamp", srv.Query("timestamp")), logger.String("nonce", srv.Query("nonce")))
+			return err
+		}
+	}
 
 	echostr, exists := srv.GetQuery("echostr")
 	if exists {
@@ -71,20 +147,29 @@ func (srv *Server) Serve() error {
 
 	response, err := srv.handleRequest()
 	if err != nil {
+		if srv.replayGuard != nil {
+			_ = srv.replayGuard.Release(context2.Background(), srv.Query("timestamp"), srv.Query("nonce"))
+		}
 		return err
 	}
-	// 非安全模式下，请求处理方法返回为nil则直接回复success给微信服务器
-	if response == nil && !srv.isSafeMode {
+	// 非安全模式或异步处理时，请求处理方法返回为nil则直接回复success给微信服务器
+	if response == nil && (!srv.isSafeMode || srv.async != nil) {
 		srv.String("success")
+		srv.replied = true
 		return nil
 	}
 
 	// debug print request msg
-	log.Debugf("request msg =%s", string(srv.RequestRawXMLMsg))
+	srv.log().Debug("request msg", logger.Any("body", srv.RequestRawXMLMsg))
 
 	return srv.buildResponse(response)
 }
 
+// SetReplayGuard 设置防重放校验，签名校验通过后检查时间戳及 nonce，见 util.ReplayGuard
+func (srv *Server) SetReplayGuard(guard *util.ReplayGuard) {
+	srv.replayGuard = guard
+}
+
 // Validate 校验请求是否合法
 func (srv *Server) Validate() bool {
 	if srv.skipValidate {
@@ -93,7 +178,7 @@ func (srv *Server) Validate() bool {
 	timestamp := srv.Query("timestamp")
 	nonce := srv.Query("nonce")
 	signature := srv.Query("signature")
-	log.Debugf("validate signature, timestamp=%s, nonce=%s", timestamp, nonce)
+	srv.log().Debug("validate signature", logger.String("timestamp", timestamp), logger.String("nonce", nonce))
 	return signature == util.Signature(srv.Token, timestamp, nonce)
 }
 
@@ -123,10 +208,48 @@ func (srv *Server) handleRequest() (reply *message.Reply, err error) {
 		err = errors.New("消息类型转换失败")
 	}
 	srv.RequestMsg = mixMessage
-	reply = srv.messageHandler(mixMessage)
+
+	handler := srv.messageHandler
+	if srv.dedupMiddleware != nil {
+		handler = srv.dedupMiddleware(handler)
+	}
+	if srv.async != nil {
+		// 异步处理，回复 success
+		return nil, srv.async.submit(handler, mixMessage, srv.meta())
+	}
+	c, cancel := context2.WithTimeout(srv.Request.Context(), srv.getReplyTimeout())
+	defer cancel()
+	reply, err = handler(c, mixMessage, srv.meta())
+	if err != nil {
+		err = toHTTPError(err)
+	}
 	return
 }
 
+func (srv *Server) meta() *RequestMeta {
+	timestamp, _ := strconv.ParseInt(srv.Query("timestamp"), 10, 64)
+	return &RequestMeta{
+		Request:   srv.Request,
+		OpenID:    srv.openID,
+		Timestamp: timestamp,
+		Nonce:     srv.Query("nonce"),
+		SafeMode:  srv.isSafeMode,
+		RawMsg:    srv.RequestRawXMLMsg,
+	}
+}
+
+func (srv *Server) getReplyTimeout() time.Duration {
+	if srv.replyTimeout > 0 {
+		return srv.replyTimeout
+	}
+	return DefaultReplyTimeout
+}
+
+// SetReplyTimeout 设置消息处理方法 ctx 的超时时间，默认为 DefaultReplyTimeout
+func (srv *Server) SetReplyTimeout(timeout time.Duration) {
+	srv.replyTimeout = timeout
+}
+
 // GetOpenID return openID
 func (srv *Server) GetOpenID() string {
 	return srv.openID
@@ -189,6 +312,7 @@ func (srv *Server) getEncryptBody() (*message.EncryptedXMLMsg, error) {
 
 func (srv *Server) parseRequestMessage(rawXMLMsgBytes []byte) (msg *message.MixMessage, err error) {
 	msg = &message.MixMessage{}
+	msg.SetRawMessage(rawXMLMsgBytes, srv.isJSONContent)
 	if !srv.isJSONContent {
 		err = xml.Unmarshal(rawXMLMsgBytes, msg)
 		return
@@ -220,6 +344,11 @@ func (srv *Server) parseRequestMessage(rawXMLMsgBytes []byte) (msg *message.MixM
 
 // SetMessageHandler 设置用户自定义的回调方法
 func (srv *Server) SetMessageHandler(handler func(*message.MixMessage) *message.Reply) {
+	srv.messageHandler = ReplyFunc(handler)
+}
+
+// SetMessageHandlerContext 设置支持 context 的回调方法，可返回错误
+func (srv *Server) SetMessageHandlerContext(handler HandlerFunc) {
 	srv.messageHandler = handler
 }
 
@@ -275,8 +404,12 @@ func (srv *Server) buildResponse(reply *message.Reply) (err error) {
 
 // Send 将自定义的消息发送
 func (srv *Server) Send() (err error) {
+	if srv.replied {
+		// Serve 已回复 success
+		return
+	}
 	replyMsg := srv.ResponseMsg
-	log.Debugf("response msg =%+v", replyMsg)
+	srv.log().Debug("response msg", logger.Any("body", srv.ResponseRawXMLMsg))
 	if srv.isSafeMode {
 		// 安全模式下对消息进行加密
 		var encryptedMsg []byte
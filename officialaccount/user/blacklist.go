@@ -3,6 +3,7 @@
 package user
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
@@ -22,12 +23,19 @@ const (
 // 该接口每次调用最多可拉取 1000 个OpenID，当列表数较多时，可以通过多次拉取的方式来满足需求。
 // 参数 beginOpenid：当 begin_openid 为空时，默认从开头拉取。
 func (user *User) GetBlackList(beginOpenid ...string) (userlist *OpenidList, err error) {
+	return user.GetBlackListContext(context.Background(), beginOpenid...)
+}
+
+// GetBlackListContext 获取公众号的黑名单列表
+// 该接口每次调用最多可拉取 1000 个OpenID，当列表数较多时，可以通过多次拉取的方式来满足需求。
+// 参数 beginOpenid：当 begin_openid 为空时，默认从开头拉取。
+func (user *User) GetBlackListContext(ctx context.Context, beginOpenid ...string) (userlist *OpenidList, err error) {
 	if len(beginOpenid) > 1 {
 		return nil, errors.New("参数 beginOpenid 错误：请传递 1 个openID，若需要从头开始拉取列表请留空。")
 	}
 	// 获取 AccessToken
 	var accessToken string
-	if accessToken, err = user.GetAccessToken(); err != nil {
+	if accessToken, err = user.GetAccessTokenContext(ctx); err != nil {
 		return
 	}
 
@@ -40,7 +48,7 @@ func (user *User) GetBlackList(beginOpenid ...string) (userlist *OpenidList, err
 	// 调用接口
 	var resp []byte
 	url := fmt.Sprintf(getblacklistURL, accessToken)
-	if resp, err = util.PostJSON(url, &request); err != nil {
+	if resp, err = user.PostJSONContext(ctx, url, &request); err != nil {
 		return nil, err
 	}
 
@@ -55,6 +63,11 @@ func (user *User) GetBlackList(beginOpenid ...string) (userlist *OpenidList, err
 
 // GetAllBlackList 获取公众号的所有黑名单列表
 func (user *User) GetAllBlackList() (openIDList []string, err error) {
+	return user.GetAllBlackListContext(context.Background())
+}
+
+// GetAllBlackListContext 获取公众号的所有黑名单列表
+func (user *User) GetAllBlackListContext(ctx context.Context) (openIDList []string, err error) {
 	var (
 		beginOpenid string
 		count       int
@@ -63,7 +76,7 @@ func (user *User) GetAllBlackList() (openIDList []string, err error) {
 
 	for {
 		// 获取列表（每次1k条）
-		if userlist, err = user.GetBlackList(beginOpenid); err != nil {
+		if userlist, err = user.GetBlackListContext(ctx, beginOpenid); err != nil {
 			return nil, err
 		}
 		openIDList = append(openIDList, userlist.Data.OpenIDs...) // 存储本次获得的OpenIDs
@@ -80,17 +93,34 @@ func (user *User) GetAllBlackList() (openIDList []string, err error) {
 // BatchBlackList 拉黑用户
 // 参数 openidList：需要拉入黑名单的用户的openid，每次拉黑最多允许20个
 func (user *User) BatchBlackList(openidList ...string) (err error) {
-	return user.batch(batchblacklistURL, "BatchBlackList", openidList...)
+	return user.BatchBlackListContext(context.Background(), openidList...)
+}
+
+// BatchBlackListContext 拉黑用户
+// 参数 openidList：需要拉入黑名单的用户的openid，每次拉黑最多允许20个
+func (user *User) BatchBlackListContext(ctx context.Context, openidList ...string) (err error) {
+	return user.batchContext(ctx, batchblacklistURL, "BatchBlackList", openidList...)
 }
 
 // BatchUnBlackList 取消拉黑用户
 // 参数 openidList：需要取消拉入黑名单的用户的openid，每次拉黑最多允许20个
 func (user *User) BatchUnBlackList(openidList ...string) (err error) {
-	return user.batch(batchunblacklistURL, "BatchUnBlackList", openidList...)
+	return user.BatchUnBlackListContext(context.Background(), openidList...)
+}
+
+// BatchUnBlackListContext 取消拉黑用户
+// 参数 openidList：需要取消拉入黑名单的用户的openid，每次拉黑最多允许20个
+func (user *User) BatchUnBlackListContext(ctx context.Context, openidList ...string) (err error) {
+	return user.batchContext(ctx, batchunblacklistURL, "BatchUnBlackList", openidList...)
 }
 
 // batch 公共方法
 func (user *User) batch(url, apiName string, openidList ...string) (err error) {
+	return user.batchContext(context.Background(), url, apiName, openidList...)
+}
+
+// batchContext 公共方法
+func (user *User) batchContext(ctx context.Context, url, apiName string, openidList ...string) (err error) {
 	// 检查参数
 	if len(openidList) == 0 || len(openidList) > 20 {
 		return errors.New("参数 openidList 错误：每次操作黑名单用户数量为1-20个。")
@@ -98,7 +128,7 @@ func (user *User) batch(url, apiName string, openidList ...string) (err error) {
 
 	// 获取 AccessToken
 	var accessToken string
-	if accessToken, err = user.GetAccessToken(); err != nil {
+	if accessToken, err = user.GetAccessTokenContext(ctx); err != nil {
 		return
 	}
 
@@ -108,7 +138,7 @@ func (user *User) batch(url, apiName string, openidList ...string) (err error) {
 	// 调用接口
 	var resp []byte
 	url = fmt.Sprintf(url, accessToken)
-	if resp, err = util.PostJSON(url, &request); err != nil {
+	if resp, err = user.PostJSONContext(ctx, url, &request); err != nil {
 		return
 	}
 
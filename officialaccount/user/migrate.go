@@ -3,6 +3,7 @@
 package user
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
@@ -31,6 +32,14 @@ type ChangeOpenIDResultList struct {
 // openIDs 为老账号的openID，openIDs限100个以内
 // AccessToken 为新账号的AccessToken
 func (user *User) ListChangeOpenIDs(fromAppID string, openIDs ...string) (list *ChangeOpenIDResultList, err error) {
+	return user.ListChangeOpenIDsContext(context.Background(), fromAppID, openIDs...)
+}
+
+// ListChangeOpenIDsContext 返回指定OpenID变化列表
+// fromAppID 为老账号AppID
+// openIDs 为老账号的openID，openIDs限100个以内
+// AccessToken 为新账号的AccessToken
+func (user *User) ListChangeOpenIDsContext(ctx context.Context, fromAppID string, openIDs ...string) (list *ChangeOpenIDResultList, err error) {
 	list = &ChangeOpenIDResultList{}
 	// list.List = make([]ChangeOpenIDResult, 0)
 	if len(openIDs) > 100 {
@@ -43,7 +52,7 @@ func (user *User) ListChangeOpenIDs(fromAppID string, openIDs ...string) (list *
 		return
 	}
 
-	accessToken, err := user.GetAccessToken()
+	accessToken, err := user.GetAccessTokenContext(ctx)
 	if err != nil {
 		return
 	}
@@ -56,7 +65,7 @@ func (user *User) ListChangeOpenIDs(fromAppID string, openIDs ...string) (list *
 	}
 	req.FromAppID = fromAppID
 	req.OpenidList = append(req.OpenidList, openIDs...)
-	resp, err = util.PostJSON(uri, req)
+	resp, err = user.PostJSONContext(ctx, uri, req)
 	if err != nil {
 		return
 	}
@@ -74,10 +83,18 @@ func (user *User) ListChangeOpenIDs(fromAppID string, openIDs ...string) (list *
 // openIDs 为老账号的openID
 // AccessToken 为新账号的AccessToken
 func (user *User) ListAllChangeOpenIDs(fromAppID string, openIDs ...string) (list []ChangeOpenIDResult, err error) {
+	return user.ListAllChangeOpenIDsContext(context.Background(), fromAppID, openIDs...)
+}
+
+// ListAllChangeOpenIDsContext  返回所有用户OpenID列表
+// fromAppID 为老账号AppID
+// openIDs 为老账号的openID
+// AccessToken 为新账号的AccessToken
+func (user *User) ListAllChangeOpenIDsContext(ctx context.Context, fromAppID string, openIDs ...string) (list []ChangeOpenIDResult, err error) {
 	list = make([]ChangeOpenIDResult, 0)
 	chunks := util.SliceChunk(openIDs, 100)
 	for _, chunk := range chunks {
-		result, err := user.ListChangeOpenIDs(fromAppID, chunk...)
+		result, err := user.ListChangeOpenIDsContext(ctx, fromAppID, chunk...)
 		if err != nil {
 			return list, err
 		}
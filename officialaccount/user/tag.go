@@ -1,6 +1,7 @@
 package user
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -36,8 +37,13 @@ type TagOpenIDList struct {
 
 // CreateTag 创建标签
 func (user *User) CreateTag(tagName string) (tagInfo *TagInfo, err error) {
+	return user.CreateTagContext(context.Background(), tagName)
+}
+
+// CreateTagContext 创建标签
+func (user *User) CreateTagContext(ctx context.Context, tagName string) (tagInfo *TagInfo, err error) {
 	var accessToken string
-	accessToken, err = user.GetAccessToken()
+	accessToken, err = user.GetAccessTokenContext(ctx)
 	if err != nil {
 		return
 	}
@@ -49,7 +55,7 @@ func (user *User) CreateTag(tagName string) (tagInfo *TagInfo, err error) {
 		} `json:"tag"`
 	}
 	request.Tag.Name = tagName
-	response, err = util.PostJSON(uri, &request)
+	response, err = user.PostJSONContext(ctx, uri, &request)
 	if err != nil {
 		return
 	}
@@ -70,7 +76,12 @@ func (user *User) CreateTag(tagName string) (tagInfo *TagInfo, err error) {
 
 // DeleteTag  删除标签
 func (user *User) DeleteTag(tagID int32) (err error) {
-	accessToken, err := user.GetAccessToken()
+	return user.DeleteTagContext(context.Background(), tagID)
+}
+
+// DeleteTagContext  删除标签
+func (user *User) DeleteTagContext(ctx context.Context, tagID int32) (err error) {
+	accessToken, err := user.GetAccessTokenContext(ctx)
 	if err != nil {
 		return
 	}
@@ -81,7 +92,7 @@ func (user *User) DeleteTag(tagID int32) (err error) {
 		} `json:"tag"`
 	}
 	request.Tag.ID = tagID
-	resp, err := util.PostJSON(url, &request)
+	resp, err := user.PostJSONContext(ctx, url, &request)
 	if err != nil {
 		return
 	}
@@ -90,7 +101,12 @@ func (user *User) DeleteTag(tagID int32) (err error) {
 
 // UpdateTag  编辑标签
 func (user *User) UpdateTag(tagID int32, tagName string) (err error) {
-	accessToken, err := user.GetAccessToken()
+	return user.UpdateTagContext(context.Background(), tagID, tagName)
+}
+
+// UpdateTagContext  编辑标签
+func (user *User) UpdateTagContext(ctx context.Context, tagID int32, tagName string) (err error) {
+	accessToken, err := user.GetAccessTokenContext(ctx)
 	if err != nil {
 		return
 	}
@@ -103,7 +119,7 @@ func (user *User) UpdateTag(tagID int32, tagName string) (err error) {
 	}
 	request.Tag.ID = tagID
 	request.Tag.Name = tagName
-	resp, err := util.PostJSON(url, &request)
+	resp, err := user.PostJSONContext(ctx, url, &request)
 	if err != nil {
 		return
 	}
@@ -112,12 +128,17 @@ func (user *User) UpdateTag(tagID int32, tagName string) (err error) {
 
 // GetTag 获取公众号已创建的标签
 func (user *User) GetTag() (tags []*TagInfo, err error) {
-	accessToken, err := user.GetAccessToken()
+	return user.GetTagContext(context.Background())
+}
+
+// GetTagContext 获取公众号已创建的标签
+func (user *User) GetTagContext(ctx context.Context) (tags []*TagInfo, err error) {
+	accessToken, err := user.GetAccessTokenContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 	url := fmt.Sprintf(tagGetURL, accessToken)
-	response, err := util.HTTPGet(url)
+	response, err := user.HTTPGetContext(ctx, url)
 	if err != nil {
 		return
 	}
@@ -134,7 +155,12 @@ func (user *User) GetTag() (tags []*TagInfo, err error) {
 
 // OpenIDListByTag 获取标签下粉丝列表
 func (user *User) OpenIDListByTag(tagID int32, nextOpenID ...string) (userList *TagOpenIDList, err error) {
-	accessToken, err := user.GetAccessToken()
+	return user.OpenIDListByTagContext(context.Background(), tagID, nextOpenID...)
+}
+
+// OpenIDListByTagContext 获取标签下粉丝列表
+func (user *User) OpenIDListByTagContext(ctx context.Context, tagID int32, nextOpenID ...string) (userList *TagOpenIDList, err error) {
+	accessToken, err := user.GetAccessTokenContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -148,7 +174,7 @@ func (user *User) OpenIDListByTag(tagID int32, nextOpenID ...string) (userList *
 	if len(nextOpenID) > 0 {
 		request.OpenID = nextOpenID[0]
 	}
-	response, err := util.PostJSON(url, &request)
+	response, err := user.PostJSONContext(ctx, url, &request)
 	if err != nil {
 		return nil, err
 	}
@@ -162,7 +188,12 @@ func (user *User) OpenIDListByTag(tagID int32, nextOpenID ...string) (userList *
 
 // BatchTag 批量为用户打标签
 func (user *User) BatchTag(openIDList []string, tagID int32) (err error) {
-	accessToken, err := user.GetAccessToken()
+	return user.BatchTagContext(context.Background(), openIDList, tagID)
+}
+
+// BatchTagContext 批量为用户打标签
+func (user *User) BatchTagContext(ctx context.Context, openIDList []string, tagID int32) (err error) {
+	accessToken, err := user.GetAccessTokenContext(ctx)
 	if err != nil {
 		return
 	}
@@ -177,7 +208,7 @@ func (user *User) BatchTag(openIDList []string, tagID int32) (err error) {
 		TagID:      tagID,
 	}
 	url := fmt.Sprintf(tagBatchtaggingURL, accessToken)
-	resp, err := util.PostJSON(url, &request)
+	resp, err := user.PostJSONContext(ctx, url, &request)
 	if err != nil {
 		return
 	}
@@ -186,10 +217,15 @@ func (user *User) BatchTag(openIDList []string, tagID int32) (err error) {
 
 // BatchUntag 批量为用户取消标签
 func (user *User) BatchUntag(openIDList []string, tagID int32) (err error) {
+	return user.BatchUntagContext(context.Background(), openIDList, tagID)
+}
+
+// BatchUntagContext 批量为用户取消标签
+func (user *User) BatchUntagContext(ctx context.Context, openIDList []string, tagID int32) (err error) {
 	if len(openIDList) == 0 {
 		return
 	}
-	accessToken, err := user.GetAccessToken()
+	accessToken, err := user.GetAccessTokenContext(ctx)
 	if err != nil {
 		return
 	}
@@ -201,7 +237,7 @@ func (user *User) BatchUntag(openIDList []string, tagID int32) (err error) {
 		OpenIDList: openIDList,
 		TagID:      tagID,
 	}
-	resp, err := util.PostJSON(url, &request)
+	resp, err := user.PostJSONContext(ctx, url, &request)
 	if err != nil {
 		return
 	}
@@ -210,7 +246,12 @@ func (user *User) BatchUntag(openIDList []string, tagID int32) (err error) {
 
 // UserTidList 获取用户身上的标签列表
 func (user *User) UserTidList(openID string) (tagIDList []int32, err error) {
-	accessToken, err := user.GetAccessToken()
+	return user.UserTidListContext(context.Background(), openID)
+}
+
+// UserTidListContext 获取用户身上的标签列表
+func (user *User) UserTidListContext(ctx context.Context, openID string) (tagIDList []int32, err error) {
+	accessToken, err := user.GetAccessTokenContext(ctx)
 	if err != nil {
 		return
 	}
@@ -221,7 +262,7 @@ func (user *User) UserTidList(openID string) (tagIDList []int32, err error) {
 	}{
 		OpenID: openID,
 	}
-	resp, err := util.PostJSON(url, &request)
+	resp, err := user.PostJSONContext(ctx, url, &request)
 	if err != nil {
 		return
 	}
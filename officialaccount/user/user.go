@@ -1,6 +1,7 @@
 package user
 
 import (
+	context2 "context"
 	"encoding/json"
 	"fmt"
 	"net/url"
@@ -64,15 +65,20 @@ type OpenidList struct {
 
 // GetUserInfo 获取用户基本信息
 func (user *User) GetUserInfo(openID string) (userInfo *Info, err error) {
+	return user.GetUserInfoContext(context2.Background(), openID)
+}
+
+// GetUserInfoContext 获取用户基本信息
+func (user *User) GetUserInfoContext(ctx context2.Context, openID string) (userInfo *Info, err error) {
 	var accessToken string
-	accessToken, err = user.GetAccessToken()
+	accessToken, err = user.GetAccessTokenContext(ctx)
 	if err != nil {
 		return
 	}
 
 	uri := fmt.Sprintf(userInfoURL, accessToken, openID)
 	var response []byte
-	response, err = util.HTTPGet(uri)
+	response, err = user.HTTPGetContext(ctx, uri)
 	if err != nil {
 		return
 	}
@@ -90,15 +96,20 @@ func (user *User) GetUserInfo(openID string) (userInfo *Info, err error) {
 
 // UpdateRemark 设置用户备注名
 func (user *User) UpdateRemark(openID, remark string) (err error) {
+	return user.UpdateRemarkContext(context2.Background(), openID, remark)
+}
+
+// UpdateRemarkContext 设置用户备注名
+func (user *User) UpdateRemarkContext(ctx context2.Context, openID, remark string) (err error) {
 	var accessToken string
-	accessToken, err = user.GetAccessToken()
+	accessToken, err = user.GetAccessTokenContext(ctx)
 	if err != nil {
 		return
 	}
 
 	uri := fmt.Sprintf(updateRemarkURL, accessToken)
 	var response []byte
-	response, err = util.PostJSON(uri, map[string]string{"openid": openID, "remark": remark})
+	response, err = user.PostJSONContext(ctx, uri, map[string]string{"openid": openID, "remark": remark})
 	if err != nil {
 		return
 	}
@@ -108,7 +119,12 @@ func (user *User) UpdateRemark(openID, remark string) (err error) {
 
 // ListUserOpenIDs 返回用户列表
 func (user *User) ListUserOpenIDs(nextOpenid ...string) (*OpenidList, error) {
-	accessToken, err := user.GetAccessToken()
+	return user.ListUserOpenIDsContext(context2.Background(), nextOpenid...)
+}
+
+// ListUserOpenIDsContext 返回用户列表
+func (user *User) ListUserOpenIDsContext(ctx context2.Context, nextOpenid ...string) (*OpenidList, error) {
+	accessToken, err := user.GetAccessTokenContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -121,7 +137,7 @@ func (user *User) ListUserOpenIDs(nextOpenid ...string) (*OpenidList, error) {
 	}
 	uri.RawQuery = q.Encode()
 
-	response, err := util.HTTPGet(uri.String())
+	response, err := user.HTTPGetContext(ctx, uri.String())
 	if err != nil {
 		return nil, err
 	}
@@ -138,11 +154,16 @@ func (user *User) ListUserOpenIDs(nextOpenid ...string) (*OpenidList, error) {
 
 // ListAllUserOpenIDs 返回所有用户OpenID列表
 func (user *User) ListAllUserOpenIDs() ([]string, error) {
+	return user.ListAllUserOpenIDsContext(context2.Background())
+}
+
+// ListAllUserOpenIDsContext 返回所有用户OpenID列表
+func (user *User) ListAllUserOpenIDsContext(ctx context2.Context) ([]string, error) {
 	nextOpenid := ""
 	openids := make([]string, 0)
 	count := 0
 	for {
-		ul, err := user.ListUserOpenIDs(nextOpenid)
+		ul, err := user.ListUserOpenIDsContext(ctx, nextOpenid)
 		if err != nil {
 			return nil, err
 		}
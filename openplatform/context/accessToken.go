@@ -2,11 +2,14 @@
 package context
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
 	"time"
 
+	"github.com/silenceper/wechat/v2/cache"
+	"github.com/silenceper/wechat/v2/credential"
 	"github.com/silenceper/wechat/v2/util"
 )
 
@@ -22,6 +25,9 @@ const (
 	// getComponentConfigURL = "https://api.weixin.qq.com/cgi-bin/component/api_get_authorizer_option?component_access_token=%s"
 	// TODO 获取已授权的账号信息
 	// getuthorizerListURL = "POST https://api.weixin.qq.com/cgi-bin/component/api_get_authorizer_list?component_access_token=%s"
+
+	// component_verify_ticket 有效期为 12 小时
+	componentVerifyTicketTimeout = 12 * time.Hour
 )
 
 // ComponentAccessToken 第三方平台
@@ -33,22 +39,31 @@ type ComponentAccessToken struct {
 
 // GetComponentAccessToken 获取 ComponentAccessToken
 func (ctx *Context) GetComponentAccessToken() (string, error) {
-	accessTokenCacheKey := fmt.Sprintf("component_access_token_%s", ctx.AppID)
-	val := ctx.Cache.Get(accessTokenCacheKey)
-	if val == nil {
+	return ctx.GetComponentAccessTokenContext(context.Background())
+}
+
+// GetComponentAccessTokenContext 获取 ComponentAccessToken
+func (ctx *Context) GetComponentAccessTokenContext(c context.Context) (string, error) {
+	val, err := ctx.contextCache().GetString(c, ctx.componentAccessTokenCacheKey())
+	if err != nil {
 		return "", fmt.Errorf("cann't get component access token")
 	}
-	return val.(string), nil
+	return val, nil
 }
 
 // SetComponentAccessToken 通过component_verify_ticket 获取 ComponentAccessToken
 func (ctx *Context) SetComponentAccessToken(verifyTicket string) (*ComponentAccessToken, error) {
+	return ctx.SetComponentAccessTokenContext(context.Background(), verifyTicket)
+}
+
+// SetComponentAccessTokenContext 通过component_verify_ticket 获取 ComponentAccessToken
+func (ctx *Context) SetComponentAccessTokenContext(c context.Context, verifyTicket string) (*ComponentAccessToken, error) {
 	body := map[string]string{
 		"component_appid":         ctx.AppID,
 		"component_appsecret":     ctx.AppSecret,
 		"component_verify_ticket": verifyTicket,
 	}
-	respBody, err := util.PostJSON(componentAccessTokenURL, body)
+	respBody, err := ctx.PostJSONContext(c, componentAccessTokenURL, body)
 	if err != nil {
 		return nil, err
 	}
@@ -62,14 +77,52 @@ func (ctx *Context) SetComponentAccessToken(verifyTicket string) (*ComponentAcce
 		return nil, fmt.Errorf("SetComponentAccessToken Error , errcode=%d , errmsg=%s", at.ErrCode, at.ErrMsg)
 	}
 
-	accessTokenCacheKey := fmt.Sprintf("component_access_token_%s", ctx.AppID)
-	expires := at.ExpiresIn - 1500
-	if err := ctx.Cache.Set(accessTokenCacheKey, at.AccessToken, time.Duration(expires)*time.Second); err != nil {
-		return nil, nil
+	// 保存 component_verify_ticket，用于后台提前刷新 component_access_token
+	if err := ctx.contextCache().SetString(c, ctx.componentVerifyTicketCacheKey(), verifyTicket, componentVerifyTicketTimeout); err != nil {
+		return nil, err
+	}
+	if err := credential.CacheTokenContext(c, ctx.contextCache(), ctx.componentAccessTokenCacheKey(), at.AccessToken, at.ExpiresIn); err != nil {
+		return nil, err
 	}
 	return at, nil
 }
 
+// GetComponentAccessTokenMeta 获取缓存中 ComponentAccessToken 的签发与过期时间
+func (ctx *Context) GetComponentAccessTokenMeta() (credential.TokenMeta, bool) {
+	return credential.CachedTokenMetaContext(context.Background(), ctx.contextCache(), ctx.componentAccessTokenCacheKey())
+}
+
+// RenewComponentAccessToken 使用最近一次推送的 component_verify_ticket 重新获取 ComponentAccessToken
+func (ctx *Context) RenewComponentAccessToken(c context.Context) error {
+	verifyTicket, err := ctx.contextCache().GetString(c, ctx.componentVerifyTicketCacheKey())
+	if err != nil || verifyTicket == "" {
+		return fmt.Errorf("cann't get component verify ticket")
+	}
+	_, err = ctx.SetComponentAccessTokenContext(c, verifyTicket)
+	return err
+}
+
+// ComponentAccessTokenRenewable 返回可注册到 credential.Refresher 的 ComponentAccessToken
+func (ctx *Context) ComponentAccessTokenRenewable() credential.Renewable {
+	return credential.RenewableFunc{
+		MetaFunc:  ctx.GetComponentAccessTokenMeta,
+		RenewFunc: ctx.RenewComponentAccessToken,
+	}
+}
+
+// contextCache 以 cache.ContextCache 读写配置的缓存
+func (ctx *Context) contextCache() cache.ContextCache {
+	return cache.FromCache(ctx.Cache)
+}
+
+func (ctx *Context) componentAccessTokenCacheKey() string {
+	return fmt.Sprintf("component_access_token_%s", ctx.AppID)
+}
+
+func (ctx *Context) componentVerifyTicketCacheKey() string {
+	return fmt.Sprintf("component_verify_ticket_%s", ctx.AppID)
+}
+
 // GetPreCode 获取预授权码
 func (ctx *Context) GetPreCode() (string, error) {
 	cat, err := ctx.GetComponentAccessToken()
@@ -80,7 +133,7 @@ func (ctx *Context) GetPreCode() (string, error) {
 		"component_appid": ctx.AppID,
 	}
 	uri := fmt.Sprintf(getPreCodeURL, cat)
-	body, err := util.PostJSON(uri, req)
+	body, err := ctx.PostJSON(uri, req)
 	if err != nil {
 		return "", err
 	}
@@ -149,7 +202,7 @@ func (ctx *Context) QueryAuthCode(authCode string) (*AuthBaseInfo, error) {
 		"authorization_code": authCode,
 	}
 	uri := fmt.Sprintf(queryAuthURL, cat)
-	body, err := util.PostJSON(uri, req)
+	body, err := ctx.PostJSON(uri, req)
 	if err != nil {
 		return nil, err
 	}
@@ -182,7 +235,7 @@ func (ctx *Context) RefreshAuthrToken(appid, refreshToken string) (*AuthrAccessT
 		"authorizer_refresh_token": refreshToken,
 	}
 	uri := fmt.Sprintf(refreshTokenURL, cat)
-	body, err := util.PostJSON(uri, req)
+	body, err := ctx.PostJSON(uri, req)
 	if err != nil {
 		return nil, err
 	}
@@ -193,7 +246,7 @@ func (ctx *Context) RefreshAuthrToken(appid, refreshToken string) (*AuthrAccessT
 	}
 
 	authrTokenKey := "authorizer_access_token_" + appid
-	if err := ctx.Cache.Set(authrTokenKey, ret.AccessToken, time.Minute*80); err != nil {
+	if err := ctx.contextCache().SetString(context.Background(), authrTokenKey, ret.AccessToken, time.Minute*80); err != nil {
 		return nil, err
 	}
 	return ret, nil
@@ -201,12 +254,17 @@ func (ctx *Context) RefreshAuthrToken(appid, refreshToken string) (*AuthrAccessT
 
 // GetAuthrAccessToken 获取授权方AccessToken
 func (ctx *Context) GetAuthrAccessToken(appid string) (string, error) {
+	return ctx.GetAuthrAccessTokenContext(context.Background(), appid)
+}
+
+// GetAuthrAccessTokenContext 获取授权方AccessToken
+func (ctx *Context) GetAuthrAccessTokenContext(c context.Context, appid string) (string, error) {
 	authrTokenKey := "authorizer_access_token_" + appid
-	val := ctx.Cache.Get(authrTokenKey)
-	if val == nil {
+	val, err := ctx.contextCache().GetString(c, authrTokenKey)
+	if err != nil {
 		return "", fmt.Errorf("cannot get authorizer %s access token", appid)
 	}
-	return val.(string), nil
+	return val, nil
 }
 
 // AuthorizerInfo 授权方详细信息
@@ -271,7 +329,7 @@ func (ctx *Context) GetAuthrInfo(appid string) (*AuthorizerInfo, *AuthBaseInfo,
 	}
 
 	uri := fmt.Sprintf(getComponentInfoURL, cat)
-	body, err := util.PostJSON(uri, req)
+	body, err := ctx.PostJSON(uri, req)
 	if err != nil {
 		return nil, nil, err
 	}
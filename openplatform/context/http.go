@@ -0,0 +1,80 @@
+package context
+
+import (
+	"context"
+
+	"github.com/silenceper/wechat/v2/ratelimit"
+	"github.com/silenceper/wechat/v2/util"
+)
+
+// withHTTPClient 为 c 附加配置中的 http client、限流及请求拦截器，未配置 http client 时使用 util.DefaultHTTPClient
+func (ctx *Context) withHTTPClient(c context.Context) context.Context {
+	if ctx.Config == nil {
+		return c
+	}
+	c = util.ContextWithHTTPClient(c, ctx.HTTPClient)
+	if ctx.RateLimiter != nil {
+		c = util.ContextWithInterceptors(c, ratelimit.Interceptor(ctx.AppID, ctx.RateLimiter))
+	}
+	return util.ContextWithInterceptors(c, ctx.Interceptors...)
+}
+
+// HTTPGet get 请求
+func (ctx *Context) HTTPGet(uri string) ([]byte, error) {
+	return ctx.HTTPGetContext(context.Background(), uri)
+}
+
+// HTTPGetContext get 请求
+func (ctx *Context) HTTPGetContext(c context.Context, uri string) ([]byte, error) {
+	return util.HTTPGetContext(ctx.withHTTPClient(c), uri)
+}
+
+// HTTPPost post 请求
+func (ctx *Context) HTTPPost(uri string, data string) ([]byte, error) {
+	return ctx.HTTPPostContext(context.Background(), uri, []byte(data), nil)
+}
+
+// HTTPPostContext post 请求
+func (ctx *Context) HTTPPostContext(c context.Context, uri string, data []byte, header map[string]string) ([]byte, error) {
+	return util.HTTPPostContext(ctx.withHTTPClient(c), uri, data, header)
+}
+
+// PostJSON post json 数据请求
+func (ctx *Context) PostJSON(uri string, obj interface{}) ([]byte, error) {
+	return ctx.PostJSONContext(context.Background(), uri, obj)
+}
+
+// PostJSONContext post json 数据请求
+func (ctx *Context) PostJSONContext(c context.Context, uri string, obj interface{}) ([]byte, error) {
+	return util.PostJSONContext(ctx.withHTTPClient(c), uri, obj)
+}
+
+// PostJSONWithRespContentType post json数据请求，且返回数据类型
+func (ctx *Context) PostJSONWithRespContentType(uri string, obj interface{}) ([]byte, string, error) {
+	return ctx.PostJSONWithRespContentTypeContext(context.Background(), uri, obj)
+}
+
+// PostJSONWithRespContentTypeContext post json数据请求，且返回数据类型
+func (ctx *Context) PostJSONWithRespContentTypeContext(c context.Context, uri string, obj interface{}) ([]byte, string, error) {
+	return util.PostJSONWithRespContentTypeContext(ctx.withHTTPClient(c), uri, obj)
+}
+
+// PostFile 上传文件
+func (ctx *Context) PostFile(fieldName, filename, uri string) ([]byte, error) {
+	return ctx.PostFileContext(context.Background(), fieldName, filename, uri)
+}
+
+// PostFileContext 上传文件
+func (ctx *Context) PostFileContext(c context.Context, fieldName, filename, uri string) ([]byte, error) {
+	return util.PostFileContext(ctx.withHTTPClient(c), fieldName, filename, uri)
+}
+
+// PostMultipartForm 上传文件或其他多个字段
+func (ctx *Context) PostMultipartForm(fields []util.MultipartFormField, uri string) ([]byte, error) {
+	return ctx.PostMultipartFormContext(context.Background(), fields, uri)
+}
+
+// PostMultipartFormContext 上传文件或其他多个字段
+func (ctx *Context) PostMultipartFormContext(c context.Context, fields []util.MultipartFormField, uri string) ([]byte, error) {
+	return util.PostMultipartFormContext(ctx.withHTTPClient(c), fields, uri)
+}
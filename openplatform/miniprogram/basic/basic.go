@@ -36,7 +36,7 @@ func (basic *Basic) GetAccountBasicInfo() (*AccountBasicInfo, error) {
 		return nil, err
 	}
 	url := fmt.Sprintf("%s?access_token=%s", getAccountBasicInfoURL, ak)
-	data, err := util.HTTPGet(url)
+	data, err := basic.HTTPGet(url)
 	if err != nil {
 		return nil, err
 	}
@@ -39,7 +39,7 @@ func (component *Component) RegisterMiniProgram(param *RegisterMiniProgramParam)
 		return nil
 	}
 	url := fmt.Sprintf(fastregisterweappURL+"?action=create&component_access_token=%s", componentAK)
-	data, err := util.PostJSON(url, param)
+	data, err := component.PostJSON(url, param)
 	if err != nil {
 		return err
 	}
@@ -61,7 +61,7 @@ func (component *Component) GetRegistrationStatus(param *GetRegistrationStatusPa
 		return nil
 	}
 	url := fmt.Sprintf(fastregisterweappURL+"?action=search&component_access_token=%s", componentAK)
-	data, err := util.PostJSON(url, param)
+	data, err := component.PostJSON(url, param)
 	if err != nil {
 		return err
 	}
@@ -3,6 +3,7 @@ package miniprogram
 import (
 	"fmt"
 
+	miniConfig "github.com/silenceper/wechat/v2/miniprogram/config"
 	miniContext "github.com/silenceper/wechat/v2/miniprogram/context"
 	"github.com/silenceper/wechat/v2/miniprogram/urllink"
 	openContext "github.com/silenceper/wechat/v2/openplatform/context"
@@ -62,6 +63,13 @@ func (miniProgram *MiniProgram) GetBasic() *basic.Basic {
 // GetURLLink 小程序URL Link接口 调用前需确认已调用 SetAuthorizerRefreshToken 避免由于缓存中 authorizer_access_token 过期执行中断
 func (miniProgram *MiniProgram) GetURLLink() *urllink.URLLink {
 	return urllink.NewURLLink(&miniContext.Context{
+		Config: &miniConfig.Config{
+			AppID:        miniProgram.AppID,
+			HTTPClient:   miniProgram.openContext.HTTPClient,
+			Logger:       miniProgram.openContext.Logger,
+			Interceptors: miniProgram.openContext.Interceptors,
+			RateLimiter:  miniProgram.openContext.RateLimiter,
+		},
 		AccessTokenHandle: miniProgram,
 	})
 }
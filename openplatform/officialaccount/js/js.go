@@ -1,6 +1,7 @@
 package js
 
 import (
+	context2 "context"
 	"fmt"
 
 	"github.com/silenceper/wechat/v2/credential"
@@ -29,17 +30,31 @@ func (js *Js) SetJsTicketHandle(ticketHandle credential.JsTicketHandle) {
 	js.JsTicketHandle = ticketHandle
 }
 
+// getTicketContext 获取ticket，JsTicketHandle 支持 context 时会使用配置的 http client、限流及拦截器请求
+func (js *Js) getTicketContext(ctx context2.Context, accessToken string) (string, error) {
+	if handle, ok := js.JsTicketHandle.(credential.JsTicketContextHandle); ok {
+		return handle.GetTicketContext(js.WithHTTPClient(ctx), accessToken)
+	}
+	return js.GetTicket(accessToken)
+}
+
 // GetConfig 第三方平台 - 获取jssdk需要的配置参数
 // uri 为当前网页地址
 func (js *Js) GetConfig(uri, appid string) (config *officialJs.Config, err error) {
+	return js.GetConfigContext(context2.Background(), uri, appid)
+}
+
+// GetConfigContext 第三方平台 - 获取jssdk需要的配置参数
+// uri 为当前网页地址
+func (js *Js) GetConfigContext(ctx context2.Context, uri, appid string) (config *officialJs.Config, err error) {
 	config = new(officialJs.Config)
 	var accessToken string
-	accessToken, err = js.GetAccessToken()
+	accessToken, err = js.GetAccessTokenContext(ctx)
 	if err != nil {
 		return
 	}
 	var ticketStr string
-	ticketStr, err = js.GetTicket(accessToken)
+	ticketStr, err = js.getTicketContext(ctx, accessToken)
 	if err != nil {
 		return
 	}
@@ -1,6 +1,7 @@
 package oauth
 
 import (
+	context2 "context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -8,7 +9,6 @@ import (
 
 	"github.com/silenceper/wechat/v2/officialaccount/context"
 	officialOauth "github.com/silenceper/wechat/v2/officialaccount/oauth"
-	"github.com/silenceper/wechat/v2/util"
 )
 
 const (
@@ -47,9 +47,14 @@ func (oauth *Oauth) Redirect(writer http.ResponseWriter, req *http.Request, redi
 
 // GetUserAccessToken 第三方平台 - 通过网页授权的code 换取access_token(区别于context中的access_token)
 func (oauth *Oauth) GetUserAccessToken(code, appID, componentAccessToken string) (result officialOauth.ResAccessToken, err error) {
+	return oauth.GetUserAccessTokenContext(context2.Background(), code, appID, componentAccessToken)
+}
+
+// GetUserAccessTokenContext 第三方平台 - 通过网页授权的code 换取access_token(区别于context中的access_token)
+func (oauth *Oauth) GetUserAccessTokenContext(ctx context2.Context, code, appID, componentAccessToken string) (result officialOauth.ResAccessToken, err error) {
 	urlStr := fmt.Sprintf(platformAccessTokenURL, appID, code, oauth.AppID, componentAccessToken)
 	var response []byte
-	response, err = util.HTTPGet(urlStr)
+	response, err = oauth.HTTPGetContext(ctx, urlStr)
 	if err != nil {
 		return
 	}
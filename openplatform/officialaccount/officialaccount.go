@@ -24,6 +24,10 @@ func NewOfficialAccount(opCtx *opContext.Context, appID string) *OfficialAccount
 		EncodingAESKey: opCtx.EncodingAESKey,
 		Token:          opCtx.Token,
 		Cache:          opCtx.Cache,
+		HTTPClient:     opCtx.HTTPClient,
+		Logger:         opCtx.Logger,
+		Interceptors:   opCtx.Interceptors,
+		RateLimiter:    opCtx.RateLimiter,
 	})
 	// 设置获取access_token的函数
 	officialAccount.SetAccessTokenHandle(NewDefaultAuthrAccessToken(opCtx, appID))
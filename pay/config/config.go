@@ -1,9 +1,22 @@
 package config
 
+import (
+	"github.com/silenceper/wechat/v2/logger"
+	"github.com/silenceper/wechat/v2/util"
+)
+
 // Config .config for pay
 type Config struct {
-	AppID     string `json:"app_id"`
-	MchID     string `json:"mch_id"`
-	Key       string `json:"key"`
-	NotifyURL string `json:"notify_url"`
+	AppID        string             `json:"app_id"`
+	MchID        string             `json:"mch_id"`
+	Key          string             `json:"key"`
+	NotifyURL    string             `json:"notify_url"`
+	HTTPClient   util.HTTPClient    // 自定义http client，为空时使用 util.DefaultHTTPClient
+	Logger       logger.Logger      // 日志，为空时输出到 logrus 的 StandardLogger
+	Interceptors []util.Interceptor // 请求拦截器，可用于统计接口耗时、错误码及链路追踪
+}
+
+// Log 返回配置的日志，敏感字段会被脱敏
+func (cfg *Config) Log() logger.Logger {
+	return logger.Redact(logger.Or(cfg.Logger))
 }
@@ -0,0 +1,41 @@
+package config
+
+import (
+	"context"
+
+	"github.com/silenceper/wechat/v2/logger"
+	"github.com/silenceper/wechat/v2/util"
+)
+
+// PostXML 使用配置的 http client 发送 XML 请求
+func (cfg *Config) PostXML(uri string, obj interface{}) ([]byte, error) {
+	return cfg.PostXMLContext(context.Background(), uri, obj)
+}
+
+// PostXMLContext 使用配置的 http client 发送 XML 请求
+func (cfg *Config) PostXMLContext(ctx context.Context, uri string, obj interface{}) ([]byte, error) {
+	res, err := util.PostXMLContext(cfg.withHTTPClient(ctx), uri, obj)
+	if err != nil {
+		cfg.Log().Error("pay request failed", logger.String("uri", uri), logger.Err(err))
+	}
+	return res, err
+}
+
+// PostXMLWithTLS 使用证书发送 XML 请求
+func (cfg *Config) PostXMLWithTLS(uri string, obj interface{}, ca, key string) ([]byte, error) {
+	return cfg.PostXMLWithTLSContext(context.Background(), uri, obj, ca, key)
+}
+
+// PostXMLWithTLSContext 使用证书发送 XML 请求
+func (cfg *Config) PostXMLWithTLSContext(ctx context.Context, uri string, obj interface{}, ca, key string) ([]byte, error) {
+	res, err := util.PostXMLWithTLSContext(cfg.withHTTPClient(ctx), uri, obj, ca, key)
+	if err != nil {
+		cfg.Log().Error("pay request with tls failed", logger.String("uri", uri), logger.Err(err))
+	}
+	return res, err
+}
+
+// withHTTPClient 为 ctx 附加配置中的 http client 及请求拦截器
+func (cfg *Config) withHTTPClient(ctx context.Context) context.Context {
+	return util.ContextWithInterceptors(util.ContextWithHTTPClient(ctx, cfg.HTTPClient), cfg.Interceptors...)
+}
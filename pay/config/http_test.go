@@ -0,0 +1,32 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+
+	"github.com/silenceper/wechat/v2/logger"
+)
+
+type recordLogger struct {
+	errors []string
+}
+
+func (l *recordLogger) Debug(string, ...logger.Field) {}
+func (l *recordLogger) Info(string, ...logger.Field)  {}
+func (l *recordLogger) Warn(string, ...logger.Field)  {}
+func (l *recordLogger) Error(msg string, _ ...logger.Field) {
+	l.errors = append(l.errors, msg)
+}
+
+func TestPostXMLLogger(t *testing.T) {
+	defer gock.Off()
+	gock.New("https://api.mch.weixin.qq.com").Post("/pay/unifiedorder").Reply(500)
+
+	l := &recordLogger{}
+	cfg := &Config{Logger: l}
+	_, err := cfg.PostXML("https://api.mch.weixin.qq.com/pay/unifiedorder", struct{}{})
+	assert.Error(t, err)
+	assert.Equal(t, []string{"pay request failed"}, l.errors)
+}
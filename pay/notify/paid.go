@@ -9,6 +9,7 @@ import (
 	"github.com/fatih/structs"
 	"github.com/spf13/cast"
 
+	"github.com/silenceper/wechat/v2/logger"
 	"github.com/silenceper/wechat/v2/util"
 )
 
@@ -94,9 +95,15 @@ func (notify *Notify) PaidVerifySign(notifyRes PaidResult) bool {
 	}
 	sign, err := util.CalculateSign(signStrings, signType, notify.Key)
 	if err != nil {
+		notify.Log().Warn("calculate paid notify sign failed", logger.Err(err))
 		return false
 	}
 	if sign != *notifyRes.Sign {
+		var outTradeNo string
+		if notifyRes.OutTradeNo != nil {
+			outTradeNo = *notifyRes.OutTradeNo
+		}
+		notify.Log().Warn("verify paid notify sign failed", logger.String("out_trade_no", outTradeNo))
 		return false
 	}
 	return true
@@ -7,6 +7,7 @@ import (
 	"encoding/xml"
 	"errors"
 
+	"github.com/silenceper/wechat/v2/logger"
 	"github.com/silenceper/wechat/v2/util"
 )
 
@@ -65,6 +66,7 @@ func (notify *Notify) DecryptReqInfo(result *RefundedResult) (*RefundedReqInfo,
 
 	data, err := util.AesECBDecrypt(base64Decode, []byte(md5APIKey))
 	if err != nil {
+		notify.Log().Warn("decrypt refund notify failed", logger.Err(err))
 		return nil, err
 	}
 
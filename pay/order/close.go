@@ -74,7 +74,7 @@ func (o *Order) CloseOrder(p *CloseParams) (closeResult CloseResult, err error)
 		SignType:   p.SignType,
 	}
 
-	rawRet, err = util.PostXML(closeGateway, request)
+	rawRet, err = o.PostXML(closeGateway, request)
 	if err != nil {
 		return
 	}
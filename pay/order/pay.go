@@ -252,7 +252,7 @@ func (o *Order) PrePayOrder(p *Params) (payOrder PreOrder, err error) {
 		// 如果有传入交易结束时间
 		request.TimeExpire = p.TimeExpire
 	}
-	rawRet, err := util.PostXML(payGateway, request)
+	rawRet, err := o.PostXML(payGateway, request)
 	if err != nil {
 		return
 	}
@@ -58,7 +58,7 @@ func (o *Order) QueryOrder(p *QueryParams) (paidResult notify.PaidResult, err er
 		SignType:      p.SignType,
 	}
 
-	rawRet, err := util.PostXML(queryGateway, request)
+	rawRet, err := o.PostXML(queryGateway, request)
 	if err != nil {
 		return
 	}
@@ -113,7 +113,7 @@ func (transfer *Transfer) WalletTransfer(p *Params) (rsp *Response, err error) {
 		req.CheckName = "FORCE_CHECK"
 		req.ReUserName = p.ReUserName
 	}
-	rawRet, err := util.PostXMLWithTLS(walletTransferGateway, req, p.RootCa, transfer.MchID)
+	rawRet, err := transfer.PostXMLWithTLS(walletTransferGateway, req, p.RootCa, transfer.MchID)
 	if err != nil {
 		return
 	}
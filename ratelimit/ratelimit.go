@@ -0,0 +1,44 @@
+// Package ratelimit 按 appID 与接口的客户端限流，以及当日剩余调用额度的跟踪
+package ratelimit
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/silenceper/wechat/v2/telemetry"
+	"github.com/silenceper/wechat/v2/util"
+)
+
+// Limiter 限流器，按 appID 与接口名称（见 telemetry.APIName）限流
+type Limiter interface {
+	// Wait 阻塞直到可以调用接口，ctx 结束或当日额度已用完时返回错误
+	Wait(ctx context.Context, appID, api string) error
+	// Observe 根据接口返回的 errcode 调整限流，如调用频率超限时暂停到下一分钟
+	Observe(appID, api string, errCode int64)
+}
+
+// QuotaTracker 记录接口当日剩余调用额度，Limiter 实现该接口时会按剩余额度放慢调用
+type QuotaTracker interface {
+	// SetQuota 设置接口当日剩余调用额度
+	SetQuota(appID, api string, remain int64)
+	// ResetQuota 清空 appID 下记录的额度，如调用 ClearQuota 之后
+	ResetQuota(appID string)
+}
+
+// Interceptor 返回在发送请求前限流的请求拦截器
+func Interceptor(appID string, limiter Limiter) util.Interceptor {
+	return func(req *http.Request, next util.Invoker) (*http.Response, error) {
+		api := telemetry.APIName(req.URL)
+		if err := limiter.Wait(req.Context(), appID, api); err != nil {
+			return nil, err
+		}
+		resp, err := next(req)
+		if err != nil {
+			return resp, err
+		}
+		if errCode := util.PeekResponseErrCode(resp); errCode != 0 {
+			limiter.Observe(appID, api, errCode)
+		}
+		return resp, nil
+	}
+}
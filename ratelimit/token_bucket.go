@@ -0,0 +1,194 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/silenceper/wechat/v2/util"
+)
+
+// 微信接口的每日额度在北京时间零点重置
+var quotaLocation = time.FixedZone("CST", 8*3600)
+
+// Rule 令牌桶规则
+type Rule struct {
+	Rate  float64 // 每秒生成的令牌数，<=0 时不限流
+	Burst int     // 桶容量，即允许的突发请求数，<=0 时为 1
+}
+
+func (r Rule) burst() float64 {
+	if r.Burst < 1 {
+		return 1
+	}
+	return float64(r.Burst)
+}
+
+// TokenBucket 按 appID 与接口分别计算的令牌桶限流器，实现了 Limiter 和 QuotaTracker
+//
+// 记录了剩余额度的接口，会把剩余额度平摊到当日剩余时间内，额度用完时直接返回错误
+type TokenBucket struct {
+	mu          sync.Mutex
+	defaultRule Rule
+	rules       map[string]Rule
+	buckets     map[string]*bucket
+
+	now func() time.Time
+}
+
+type bucket struct {
+	appID       string
+	rule        Rule
+	tokens      float64
+	last        time.Time
+	pausedUntil time.Time
+	remain      int64 // 当日剩余额度，-1 表示未知
+	resetAt     time.Time
+}
+
+// NewTokenBucket 实例化 TokenBucket，defaultRule 用于未单独设置规则的接口
+func NewTokenBucket(defaultRule Rule) *TokenBucket {
+	return &TokenBucket{
+		defaultRule: defaultRule,
+		rules:       map[string]Rule{},
+		buckets:     map[string]*bucket{},
+		now:         time.Now,
+	}
+}
+
+// SetRule 设置接口的限流规则，api 为 telemetry.APIName 返回的接口名称，如 api.weixin.qq.com/cgi-bin/user/info
+func (l *TokenBucket) SetRule(api string, rule Rule) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.rules[api] = rule
+	for key, b := range l.buckets {
+		if strings.HasSuffix(key, "|"+api) {
+			b.rule = rule
+		}
+	}
+}
+
+// Wait 阻塞直到可以调用接口，ctx 结束或当日额度已用完时返回错误
+func (l *TokenBucket) Wait(ctx context.Context, appID, api string) error {
+	for {
+		wait, err := l.reserve(appID, api)
+		if err != nil || wait <= 0 {
+			return err
+		}
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// reserve 尝试取出一个令牌，返回还需等待的时间
+func (l *TokenBucket) reserve(appID, api string) (time.Duration, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	b := l.bucket(appID, api, now)
+	if b.remain >= 0 && !now.Before(b.resetAt) {
+		b.remain = -1
+	}
+	if b.remain == 0 {
+		return 0, fmt.Errorf("%w: appid=%s, api=%s", util.ErrAPIDailyQuotaLimit, appID, api)
+	}
+	if now.Before(b.pausedUntil) {
+		return b.pausedUntil.Sub(now), nil
+	}
+
+	rate := b.rule.Rate
+	if b.remain > 0 {
+		if quotaRate := float64(b.remain) / b.resetAt.Sub(now).Seconds(); rate <= 0 || quotaRate < rate {
+			rate = quotaRate
+		}
+	}
+	if rate <= 0 {
+		return 0, nil
+	}
+
+	b.tokens += now.Sub(b.last).Seconds() * rate
+	if burst := b.rule.burst(); b.tokens > burst {
+		b.tokens = burst
+	}
+	b.last = now
+	if b.tokens < 1 {
+		return time.Duration((1 - b.tokens) / rate * float64(time.Second)), nil
+	}
+	b.tokens--
+	if b.remain > 0 {
+		b.remain--
+	}
+	return 0, nil
+}
+
+// Observe 调用频率超限（45011）时暂停到下一分钟，当日额度用完（45009）时在重置前直接返回错误
+func (l *TokenBucket) Observe(appID, api string, errCode int64) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	b := l.bucket(appID, api, now)
+	switch util.ErrCode(errCode) {
+	case util.ErrAPIMinuteQuotaLimit:
+		b.pausedUntil = now.Truncate(time.Minute).Add(time.Minute)
+		b.tokens = 0
+	case util.ErrAPIDailyQuotaLimit:
+		b.remain = 0
+		b.resetAt = nextQuotaReset(now)
+	}
+}
+
+// SetQuota 设置接口当日剩余调用额度，可使用 basic.GetQuota 查询
+func (l *TokenBucket) SetQuota(appID, api string, remain int64) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	b := l.bucket(appID, api, now)
+	if remain < 0 {
+		remain = 0
+	}
+	b.remain = remain
+	b.resetAt = nextQuotaReset(now)
+}
+
+// ResetQuota 清空 appID 下记录的额度及暂停状态
+func (l *TokenBucket) ResetQuota(appID string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for _, b := range l.buckets {
+		if b.appID == appID {
+			b.remain = -1
+			b.pausedUntil = time.Time{}
+		}
+	}
+}
+
+func (l *TokenBucket) bucket(appID, api string, now time.Time) *bucket {
+	key := appID + "|" + api
+	b, ok := l.buckets[key]
+	if !ok {
+		rule, ok := l.rules[api]
+		if !ok {
+			rule = l.defaultRule
+		}
+		b = &bucket{appID: appID, rule: rule, tokens: rule.burst(), last: now, remain: -1}
+		l.buckets[key] = b
+	}
+	return b
+}
+
+// nextQuotaReset 下一次额度重置的时间
+func nextQuotaReset(now time.Time) time.Time {
+	t := now.In(quotaLocation)
+	return time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, quotaLocation)
+}
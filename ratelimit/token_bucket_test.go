@@ -0,0 +1,115 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/silenceper/wechat/v2/util"
+)
+
+const testAPI = "api.weixin.qq.com/cgi-bin/user/info"
+
+func newTestBucket(rule Rule) (*TokenBucket, *time.Time) {
+	now := time.Date(2022, 7, 1, 12, 0, 0, 0, quotaLocation)
+	l := NewTokenBucket(rule)
+	l.now = func() time.Time { return now }
+	return l, &now
+}
+
+func TestTokenBucketReserve(t *testing.T) {
+	l, now := newTestBucket(Rule{Rate: 2, Burst: 2})
+
+	for i := 0; i < 2; i++ {
+		wait, err := l.reserve("appid", testAPI)
+		assert.Nil(t, err)
+		assert.Zero(t, wait)
+	}
+	wait, err := l.reserve("appid", testAPI)
+	assert.Nil(t, err)
+	assert.Equal(t, 500*time.Millisecond, wait)
+
+	// 不同 appID 互不影响
+	wait, _ = l.reserve("appid2", testAPI)
+	assert.Zero(t, wait)
+
+	*now = now.Add(500 * time.Millisecond)
+	wait, _ = l.reserve("appid", testAPI)
+	assert.Zero(t, wait)
+
+	// 未设置规则且默认不限流的接口不等待
+	unlimited, _ := newTestBucket(Rule{})
+	for i := 0; i < 10; i++ {
+		wait, _ = unlimited.reserve("appid", testAPI)
+		assert.Zero(t, wait)
+	}
+}
+
+func TestTokenBucketObserve(t *testing.T) {
+	l, now := newTestBucket(Rule{})
+
+	l.Observe("appid", testAPI, int64(util.ErrAPIMinuteQuotaLimit))
+	wait, err := l.reserve("appid", testAPI)
+	assert.Nil(t, err)
+	assert.Equal(t, time.Minute, wait)
+
+	*now = now.Add(time.Minute)
+	l.Observe("appid", testAPI, int64(util.ErrAPIDailyQuotaLimit))
+	_, err = l.reserve("appid", testAPI)
+	assert.True(t, errors.Is(err, util.ErrAPIDailyQuotaLimit))
+
+	// 额度在次日零点重置
+	*now = time.Date(2022, 7, 2, 0, 0, 0, 0, quotaLocation)
+	_, err = l.reserve("appid", testAPI)
+	assert.Nil(t, err)
+}
+
+func TestTokenBucketQuota(t *testing.T) {
+	l, _ := newTestBucket(Rule{})
+
+	// 剩余 12 小时，额度 12 次，平均每小时一次
+	l.SetQuota("appid", testAPI, 12)
+	wait, err := l.reserve("appid", testAPI)
+	assert.Nil(t, err)
+	assert.Zero(t, wait)
+	wait, _ = l.reserve("appid", testAPI)
+	assert.InDelta(t, float64(time.Hour*12/11), float64(wait), float64(time.Second))
+
+	l.ResetQuota("appid")
+	wait, _ = l.reserve("appid", testAPI)
+	assert.Zero(t, wait)
+}
+
+type errCodeClient struct {
+	calls int
+}
+
+func (c *errCodeClient) Do(*http.Request) (*http.Response, error) {
+	c.calls++
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(`{"errcode":45009,"errmsg":"reach max api daily quota limit"}`)),
+	}, nil
+}
+
+func TestInterceptor(t *testing.T) {
+	l := NewTokenBucket(Rule{})
+	client := &errCodeClient{}
+	ctx := util.ContextWithInterceptors(util.ContextWithHTTPClient(context.Background(), client), Interceptor("appid", l))
+
+	body, err := util.HTTPGetContext(ctx, "https://api.weixin.qq.com/cgi-bin/user/info?access_token=ACCESS_TOKEN")
+	assert.Nil(t, err)
+	assert.Contains(t, string(body), "45009")
+
+	// 额度用完后不再发送请求
+	_, err = util.HTTPGetContext(ctx, "https://api.weixin.qq.com/cgi-bin/user/info?access_token=ACCESS_TOKEN")
+	assert.True(t, errors.Is(err, util.ErrAPIDailyQuotaLimit))
+	assert.Equal(t, 1, client.calls)
+}
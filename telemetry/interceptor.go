@@ -0,0 +1,51 @@
+package telemetry
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/silenceper/wechat/v2/util"
+)
+
+// Interceptor 返回记录接口调用统计与 span 的请求拦截器，collector、tracer 为空时不做对应处理
+func Interceptor(collector Collector, tracer Tracer) util.Interceptor {
+	if collector == nil {
+		collector = NopCollector
+	}
+	if tracer == nil {
+		tracer = NopTracer
+	}
+	return func(req *http.Request, next util.Invoker) (*http.Response, error) {
+		api := APIName(req.URL)
+		ctx, span := tracer.Start(req.Context(), api)
+		defer span.End()
+		span.SetAttributes(
+			Attribute{Key: "wechat.api", Value: api},
+			Attribute{Key: "http.method", Value: req.Method},
+		)
+
+		start := time.Now()
+		resp, err := next(req.WithContext(ctx))
+		call := Call{
+			API:      api,
+			Method:   req.Method,
+			Duration: time.Since(start),
+			Err:      err,
+		}
+		if resp != nil {
+			call.StatusCode = resp.StatusCode
+			call.ErrCode = util.PeekResponseErrCode(resp)
+			span.SetAttributes(
+				Attribute{Key: "http.status_code", Value: call.StatusCode},
+				Attribute{Key: "wechat.errcode", Value: call.ErrCode},
+			)
+		}
+		if err != nil {
+			span.RecordError(err)
+		} else if call.ErrCode != 0 {
+			span.RecordError(util.NewCommonError(api, call.ErrCode, ""))
+		}
+		collector.ObserveAPICall(call)
+		return resp, err
+	}
+}
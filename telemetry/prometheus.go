@@ -0,0 +1,161 @@
+package telemetry
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// DefaultBuckets 默认的耗时分布区间，单位秒
+var DefaultBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+type requestKey struct {
+	api        string
+	statusCode int
+	errCode    int64
+}
+
+type histogram struct {
+	counts []uint64 // 与 buckets 对应的非累计计数
+	sum    float64
+	count  uint64
+}
+
+// PrometheusCollector 按 Prometheus 文本格式输出的接口调用统计，实现了 http.Handler，可直接挂载到 /metrics
+//
+// 输出的指标：
+//
+//	<namespace>_api_requests_total{api,status,errcode} 调用次数
+//	<namespace>_api_request_duration_seconds{api} 耗时分布
+type PrometheusCollector struct {
+	namespace string
+	buckets   []float64
+
+	mu        sync.Mutex
+	requests  map[requestKey]uint64
+	durations map[string]*histogram
+}
+
+// NewPrometheusCollector 实例化 PrometheusCollector，namespace 为空时使用 wechat，buckets 为空时使用 DefaultBuckets
+func NewPrometheusCollector(namespace string, buckets []float64) *PrometheusCollector {
+	if namespace == "" {
+		namespace = "wechat"
+	}
+	if len(buckets) == 0 {
+		buckets = DefaultBuckets
+	}
+	buckets = append([]float64(nil), buckets...)
+	sort.Float64s(buckets)
+	return &PrometheusCollector{
+		namespace: namespace,
+		buckets:   buckets,
+		requests:  map[requestKey]uint64{},
+		durations: map[string]*histogram{},
+	}
+}
+
+// ObserveAPICall 记录一次接口调用
+func (p *PrometheusCollector) ObserveAPICall(call Call) {
+	seconds := call.Duration.Seconds()
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.requests[requestKey{api: call.API, statusCode: call.StatusCode, errCode: call.ErrCode}]++
+	h, ok := p.durations[call.API]
+	if !ok {
+		h = &histogram{counts: make([]uint64, len(p.buckets))}
+		p.durations[call.API] = h
+	}
+	for i, bound := range p.buckets {
+		if seconds <= bound {
+			h.counts[i]++
+			break
+		}
+	}
+	h.sum += seconds
+	h.count++
+}
+
+// ServeHTTP 输出 Prometheus 文本格式的指标
+func (p *PrometheusCollector) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	_, _ = p.WriteTo(w)
+}
+
+// WriteTo 将指标以 Prometheus 文本格式写入 w
+func (p *PrometheusCollector) WriteTo(w io.Writer) (int64, error) {
+	cw := &countWriter{w: bufio.NewWriter(w)}
+
+	p.mu.Lock()
+	keys := make([]requestKey, 0, len(p.requests))
+	for key := range p.requests {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].api != keys[j].api {
+			return keys[i].api < keys[j].api
+		}
+		if keys[i].statusCode != keys[j].statusCode {
+			return keys[i].statusCode < keys[j].statusCode
+		}
+		return keys[i].errCode < keys[j].errCode
+	})
+	name := p.namespace + "_api_requests_total"
+	fmt.Fprintf(cw, "# HELP %s Total number of WeChat API calls.\n# TYPE %s counter\n", name, name)
+	for _, key := range keys {
+		fmt.Fprintf(cw, "%s{api=\"%s\",status=\"%d\",errcode=\"%d\"} %d\n", name, escapeLabel(key.api), key.statusCode, key.errCode, p.requests[key])
+	}
+
+	apis := make([]string, 0, len(p.durations))
+	for api := range p.durations {
+		apis = append(apis, api)
+	}
+	sort.Strings(apis)
+	name = p.namespace + "_api_request_duration_seconds"
+	fmt.Fprintf(cw, "# HELP %s Duration of WeChat API calls in seconds.\n# TYPE %s histogram\n", name, name)
+	for _, api := range apis {
+		h := p.durations[api]
+		label := escapeLabel(api)
+		var cumulative uint64
+		for i, bound := range p.buckets {
+			cumulative += h.counts[i]
+			fmt.Fprintf(cw, "%s_bucket{api=\"%s\",le=\"%s\"} %d\n", name, label, strconv.FormatFloat(bound, 'g', -1, 64), cumulative)
+		}
+		fmt.Fprintf(cw, "%s_bucket{api=\"%s\",le=\"+Inf\"} %d\n", name, label, h.count)
+		fmt.Fprintf(cw, "%s_sum{api=\"%s\"} %s\n", name, label, strconv.FormatFloat(h.sum, 'g', -1, 64))
+		fmt.Fprintf(cw, "%s_count{api=\"%s\"} %d\n", name, label, h.count)
+	}
+	p.mu.Unlock()
+
+	if err := cw.w.Flush(); err != nil && cw.err == nil {
+		cw.err = err
+	}
+	return cw.n, cw.err
+}
+
+var labelReplacer = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+func escapeLabel(s string) string {
+	return labelReplacer.Replace(s)
+}
+
+type countWriter struct {
+	w   *bufio.Writer
+	n   int64
+	err error
+}
+
+func (c *countWriter) Write(p []byte) (int, error) {
+	if c.err != nil {
+		return 0, c.err
+	}
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	c.err = err
+	return n, err
+}
@@ -0,0 +1,38 @@
+// Package telemetry 出站请求的统计与链路追踪，通过 Interceptor 接入各模块 config 的 Interceptors 或 Wechat.Use
+package telemetry
+
+import (
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Call 一次接口调用的统计信息
+type Call struct {
+	API        string        // 接口名称，见 APIName
+	Method     string        // http method
+	StatusCode int           // http 状态码，请求失败时为 0
+	ErrCode    int64         // 响应中的 errcode，非json响应为 0
+	Duration   time.Duration // 耗时
+	Err        error         // 请求错误
+}
+
+// Collector 接口调用统计
+type Collector interface {
+	ObserveAPICall(call Call)
+}
+
+// NopCollector 不做任何统计
+var NopCollector Collector = nopCollector{}
+
+type nopCollector struct{}
+
+func (nopCollector) ObserveAPICall(Call) {}
+
+// APIName 接口名称，由域名与路径组成，如 api.weixin.qq.com/cgi-bin/user/info，不包含 access_token 等参数
+func APIName(u *url.URL) string {
+	if u == nil {
+		return ""
+	}
+	return u.Host + "/" + strings.Trim(u.Path, "/")
+}
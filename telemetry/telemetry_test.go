@@ -0,0 +1,79 @@
+package telemetry
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/silenceper/wechat/v2/util"
+)
+
+type stubClient struct{}
+
+func (stubClient) Do(req *http.Request) (*http.Response, error) {
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"application/json; encoding=utf-8"}},
+		Body:       io.NopCloser(strings.NewReader(`{"errcode":45009,"errmsg":"reach max api daily quota limit"}`)),
+	}, nil
+}
+
+type recordTracer struct {
+	spans []*recordSpan
+}
+
+func (t *recordTracer) Start(ctx context.Context, name string) (context.Context, Span) {
+	span := &recordSpan{name: name, attrs: map[string]interface{}{}}
+	t.spans = append(t.spans, span)
+	return ctx, span
+}
+
+type recordSpan struct {
+	name  string
+	attrs map[string]interface{}
+	err   error
+	ended bool
+}
+
+func (s *recordSpan) SetAttributes(attrs ...Attribute) {
+	for _, attr := range attrs {
+		s.attrs[attr.Key] = attr.Value
+	}
+}
+
+func (s *recordSpan) RecordError(err error) { s.err = err }
+
+func (s *recordSpan) End() { s.ended = true }
+
+func TestInterceptor(t *testing.T) {
+	collector := NewPrometheusCollector("", nil)
+	tracer := &recordTracer{}
+	ctx := util.ContextWithInterceptors(util.ContextWithHTTPClient(context.Background(), stubClient{}), Interceptor(collector, tracer))
+
+	body, err := util.HTTPGetContext(ctx, "https://api.weixin.qq.com/cgi-bin/user/info?access_token=ACCESS_TOKEN&openid=OPENID")
+	assert.Nil(t, err)
+	// 解析 errcode 后响应内容不受影响
+	assert.Equal(t, `{"errcode":45009,"errmsg":"reach max api daily quota limit"}`, string(body))
+
+	assert.Len(t, tracer.spans, 1)
+	span := tracer.spans[0]
+	assert.Equal(t, "api.weixin.qq.com/cgi-bin/user/info", span.name)
+	assert.Equal(t, int64(45009), span.attrs["wechat.errcode"])
+	assert.Equal(t, http.StatusOK, span.attrs["http.status_code"])
+	assert.ErrorIs(t, span.err, util.ErrRateLimited)
+	assert.True(t, span.ended)
+
+	buf := new(bytes.Buffer)
+	_, err = collector.WriteTo(buf)
+	assert.Nil(t, err)
+	out := buf.String()
+	assert.Contains(t, out, `wechat_api_requests_total{api="api.weixin.qq.com/cgi-bin/user/info",status="200",errcode="45009"} 1`)
+	assert.Contains(t, out, `wechat_api_request_duration_seconds_bucket{api="api.weixin.qq.com/cgi-bin/user/info",le="+Inf"} 1`)
+	assert.Contains(t, out, `wechat_api_request_duration_seconds_count{api="api.weixin.qq.com/cgi-bin/user/info"} 1`)
+	assert.NotContains(t, out, "ACCESS_TOKEN")
+}
@@ -0,0 +1,37 @@
+package telemetry
+
+import "context"
+
+// Attribute span 属性
+type Attribute struct {
+	Key   string
+	Value interface{}
+}
+
+// Tracer 链路追踪，可适配 OpenTelemetry 等实现
+type Tracer interface {
+	// Start 开始一个 span，返回的 context 用于继续发送请求
+	Start(ctx context.Context, name string) (context.Context, Span)
+}
+
+// Span 一次接口调用的 span
+type Span interface {
+	SetAttributes(attrs ...Attribute)
+	RecordError(err error)
+	End()
+}
+
+// NopTracer 不做任何追踪
+var NopTracer Tracer = nopTracer{}
+
+type nopTracer struct{}
+
+func (nopTracer) Start(ctx context.Context, _ string) (context.Context, Span) {
+	return ctx, nopSpan{}
+}
+
+type nopSpan struct{}
+
+func (nopSpan) SetAttributes(...Attribute) {}
+func (nopSpan) RecordError(error)          {}
+func (nopSpan) End()                       {}
@@ -0,0 +1,52 @@
+// Code generated by gen_errcode.go; DO NOT EDIT.
+
+package util
+
+// 常见错误码，可作为 errors.Is 的目标
+const (
+	ErrSystemError         ErrCode = -1    // 系统繁忙，此时请开发者稍候再试
+	ErrInvalidCredential   ErrCode = 40001 // 获取 access_token 时 AppSecret 错误，或者 access_token 无效
+	ErrInvalidGrantType    ErrCode = 40002 // 不合法的凭证类型
+	ErrInvalidOpenID       ErrCode = 40003 // 不合法的 OpenID
+	ErrInvalidAppID        ErrCode = 40013 // 不合法的 AppID
+	ErrInvalidAccessToken  ErrCode = 40014 // 不合法的 access_token
+	ErrInvalidCode         ErrCode = 40029 // 无效的 oauth_code
+	ErrInvalidAppSecret    ErrCode = 40125 // 不合法的 AppSecret
+	ErrCodeBeenUsed        ErrCode = 40163 // oauth_code 已使用
+	ErrIPNotInWhitelist    ErrCode = 40164 // 调用接口的 IP 地址不在白名单中
+	ErrAccessTokenMissing  ErrCode = 41001 // 缺少 access_token 参数
+	ErrAccessTokenExpired  ErrCode = 42001 // access_token 超时
+	ErrUserRefuseMsg       ErrCode = 43101 // 用户拒绝接受消息
+	ErrAPIDailyQuotaLimit  ErrCode = 45009 // 接口调用超过每日限额
+	ErrAPIMinuteQuotaLimit ErrCode = 45011 // API 调用太频繁，请稍候再试
+	ErrAPIFreqLimit        ErrCode = 45033 // 接口并发调用超过限制
+	ErrCustomerMsgLimit    ErrCode = 45047 // 客服接口下行条数超过上限
+	ErrAPIUnauthorized     ErrCode = 48001 // api 功能未授权
+	ErrAPIForbidden        ErrCode = 48002 // api 禁止调用
+	ErrRefreshTokenInvalid ErrCode = 61023 // refresh_token 无效
+	ErrRiskyContent        ErrCode = 87014 // 内容含有违法违规内容
+)
+
+var errCodeCatalogue = map[ErrCode]errCodeEntry{
+	ErrSystemError:         {kind: ErrSystemBusy, message: "系统繁忙，此时请开发者稍候再试"},
+	ErrInvalidCredential:   {kind: ErrAccessTokenInvalid, message: "获取 access_token 时 AppSecret 错误，或者 access_token 无效"},
+	ErrInvalidGrantType:    {kind: ErrInvalidParameter, message: "不合法的凭证类型"},
+	ErrInvalidOpenID:       {kind: ErrInvalidParameter, message: "不合法的 OpenID"},
+	ErrInvalidAppID:        {kind: ErrInvalidParameter, message: "不合法的 AppID"},
+	ErrInvalidAccessToken:  {kind: ErrAccessTokenInvalid, message: "不合法的 access_token"},
+	ErrInvalidCode:         {kind: ErrInvalidParameter, message: "无效的 oauth_code"},
+	ErrInvalidAppSecret:    {kind: ErrInvalidParameter, message: "不合法的 AppSecret"},
+	ErrCodeBeenUsed:        {kind: ErrInvalidParameter, message: "oauth_code 已使用"},
+	ErrIPNotInWhitelist:    {kind: ErrPermissionDenied, message: "调用接口的 IP 地址不在白名单中"},
+	ErrAccessTokenMissing:  {kind: ErrAccessTokenInvalid, message: "缺少 access_token 参数"},
+	ErrAccessTokenExpired:  {kind: ErrAccessTokenInvalid, message: "access_token 超时"},
+	ErrUserRefuseMsg:       {kind: ErrUserRefused, message: "用户拒绝接受消息"},
+	ErrAPIDailyQuotaLimit:  {kind: ErrRateLimited, message: "接口调用超过每日限额"},
+	ErrAPIMinuteQuotaLimit: {kind: ErrRateLimited, message: "API 调用太频繁，请稍候再试"},
+	ErrAPIFreqLimit:        {kind: ErrRateLimited, message: "接口并发调用超过限制"},
+	ErrCustomerMsgLimit:    {kind: ErrRateLimited, message: "客服接口下行条数超过上限"},
+	ErrAPIUnauthorized:     {kind: ErrPermissionDenied, message: "api 功能未授权"},
+	ErrAPIForbidden:        {kind: ErrPermissionDenied, message: "api 禁止调用"},
+	ErrRefreshTokenInvalid: {kind: ErrAccessTokenInvalid, message: "refresh_token 无效"},
+	ErrRiskyContent:        {kind: ErrContentRisky, message: "内容含有违法违规内容"},
+}
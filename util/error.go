@@ -2,10 +2,92 @@ package util
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"reflect"
+	"regexp"
 )
 
+//go:generate go run gen_errcode.go
+
+// access_token 失效相关的错误码
+const (
+	ErrCodeInvalidCredential  = int64(ErrInvalidCredential)
+	ErrCodeInvalidAccessToken = int64(ErrInvalidAccessToken)
+	ErrCodeAccessTokenExpired = int64(ErrAccessTokenExpired)
+)
+
+// 错误分类，同一分类下可能有多个错误码，可作为 errors.Is 的目标
+var (
+	ErrSystemBusy         = errors.New("wechat: system busy")
+	ErrAccessTokenInvalid = errors.New("wechat: access_token invalid")
+	ErrInvalidParameter   = errors.New("wechat: invalid parameter")
+	ErrPermissionDenied   = errors.New("wechat: permission denied")
+	ErrRateLimited        = errors.New("wechat: rate limited")
+	ErrUserRefused        = errors.New("wechat: user refused")
+	ErrContentRisky       = errors.New("wechat: content risky")
+)
+
+// ErrCode 微信接口错误码
+//
+// 常见错误码见 errcode.csv，使用 errors.Is(err, util.ErrAPIDailyQuotaLimit) 判断具体错误码，
+// 使用 errors.Is(err, util.ErrRateLimited) 判断错误分类
+type ErrCode int64
+
+type errCodeEntry struct {
+	kind    error
+	message string
+}
+
+func (c ErrCode) Error() string {
+	return fmt.Sprintf("errcode=%d , errmsg=%s", int64(c), c.Message())
+}
+
+// Message 错误码说明，不在目录中的错误码返回空字符串
+func (c ErrCode) Message() string {
+	return errCodeCatalogue[c].message
+}
+
+// Kind 错误码所属分类，不在目录中的错误码返回 nil
+func (c ErrCode) Kind() error {
+	return errCodeCatalogue[c].kind
+}
+
+// Is 同一错误码或所属分类相同时返回 true
+func (c ErrCode) Is(target error) bool {
+	if code, ok := target.(ErrCode); ok {
+		return c == code
+	}
+	kind := c.Kind()
+	return kind != nil && kind == target
+}
+
+// ErrCodeOf 提取错误中的微信错误码，支持 *CommonError、ErrCode 及实现了 Code() int64 的错误
+func ErrCodeOf(err error) (int64, bool) {
+	var commonError *CommonError
+	if errors.As(err, &commonError) {
+		return commonError.ErrCode, true
+	}
+	var coder interface{ Code() int64 }
+	if errors.As(err, &coder) {
+		return coder.Code(), true
+	}
+	var code ErrCode
+	if errors.As(err, &code) {
+		return int64(code), true
+	}
+	return 0, false
+}
+
+// IsAccessTokenInvalid 判断错误码是否表示 access_token 已失效，需要重新获取
+func IsAccessTokenInvalid(errCode int64) bool {
+	switch errCode {
+	case ErrCodeInvalidCredential, ErrCodeInvalidAccessToken, ErrCodeAccessTokenExpired:
+		return true
+	}
+	return false
+}
+
 // CommonError 微信返回的通用错误json
 type CommonError struct {
 	apiName string
@@ -17,6 +99,31 @@ func (c *CommonError) Error() string {
 	return fmt.Sprintf("%s Error , errcode=%d , errmsg=%s", c.apiName, c.ErrCode, c.ErrMsg)
 }
 
+// Is 支持 errors.Is 与 ErrCode 及错误分类比较
+func (c *CommonError) Is(target error) bool {
+	return ErrCode(c.ErrCode).Is(target)
+}
+
+// APIName 出错的接口名称
+func (c *CommonError) APIName() string {
+	return c.apiName
+}
+
+// 公众号等接口在 errmsg 中返回 rid，企业微信接口返回 hint
+var requestIDRegexp = regexp.MustCompile(`(?:rid: ?([0-9a-zA-Z-]+))|(?:hint: ?\[([^\]]+)\])`)
+
+// RequestID 从 errmsg 中提取微信返回的请求 ID，可用于向微信反馈问题，没有时返回空字符串
+func (c *CommonError) RequestID() string {
+	match := requestIDRegexp.FindStringSubmatch(c.ErrMsg)
+	if match == nil {
+		return ""
+	}
+	if match[1] != "" {
+		return match[1]
+	}
+	return match[2]
+}
+
 // NewCommonError 新建CommonError错误，对于无errcode和errmsg的返回也可以返回该通用错误
 func NewCommonError(apiName string, code int64, msg string) *CommonError {
 	return &CommonError{
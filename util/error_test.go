@@ -1,6 +1,10 @@
 package util
 
-import "testing"
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
 
 var okErrData string = `{"errcode": 0}`
 var errData string = `{"errcode": 43101, "errmsg": "user refuse to accept the msg"}`
@@ -53,3 +57,39 @@ func TestDecodeWithError(t *testing.T) {
 		return
 	}
 }
+
+func TestCommonErrorIs(t *testing.T) {
+	err := DecodeWithCommonError([]byte(`{"errcode": 45009, "errmsg": "reach max api daily quota limit rid: 6350e2b2-1d2a1b7c-3f3e0e5a"}`), "Send")
+	if !errors.Is(err, ErrAPIDailyQuotaLimit) || !errors.Is(err, ErrRateLimited) {
+		t.Error("errors.Is should match errcode and kind")
+	}
+	if errors.Is(err, ErrAccessTokenInvalid) || errors.Is(err, ErrAPIMinuteQuotaLimit) {
+		t.Error("errors.Is should not match other errcode or kind")
+	}
+
+	wrapped := fmt.Errorf("wrapped: %w", err)
+	var cErr *CommonError
+	if !errors.As(wrapped, &cErr) {
+		t.Error("errors.As should return *CommonError")
+		return
+	}
+	if cErr.APIName() != "Send" || cErr.RequestID() != "6350e2b2-1d2a1b7c-3f3e0e5a" {
+		t.Errorf("bad api name or request id: %s, %s", cErr.APIName(), cErr.RequestID())
+	}
+	if code, ok := ErrCodeOf(wrapped); !ok || code != 45009 {
+		t.Errorf("ErrCodeOf should return 45009 but %d", code)
+	}
+}
+
+func TestCommonErrorRequestID(t *testing.T) {
+	cErr := NewCommonError("GetUser", 40014, "invalid access_token, hint: [1655436420_43_d2b7c8e8], from ip: 1.1.1.1, more info at https://open.work.weixin.qq.com/devtool/query?e=40014")
+	if cErr.RequestID() != "1655436420_43_d2b7c8e8" {
+		t.Errorf("RequestID should parse hint but %s", cErr.RequestID())
+	}
+	if !errors.Is(cErr, ErrAccessTokenInvalid) {
+		t.Error("errors.Is should match access_token invalid")
+	}
+	if NewCommonError("GetUser", 40014, "invalid access_token").RequestID() != "" {
+		t.Error("RequestID should be empty")
+	}
+}
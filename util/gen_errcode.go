@@ -0,0 +1,53 @@
+//go:build ignore
+// +build ignore
+
+// 根据 errcode.csv 生成 errcode.go
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"strconv"
+)
+
+func main() {
+	f, err := os.Open("errcode.csv")
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer f.Close()
+	r := csv.NewReader(f)
+	r.Comment = '#'
+	r.FieldsPerRecord = 4
+	records, err := r.ReadAll()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by gen_errcode.go; DO NOT EDIT.\n\npackage util\n\n")
+	buf.WriteString("// 常见错误码，可作为 errors.Is 的目标\nconst (\n")
+	for _, rec := range records {
+		if _, err := strconv.ParseInt(rec[0], 10, 64); err != nil {
+			log.Fatalf("invalid errcode %q: %v", rec[0], err)
+		}
+		fmt.Fprintf(&buf, "\tErr%s ErrCode = %s // %s\n", rec[1], rec[0], rec[3])
+	}
+	buf.WriteString(")\n\nvar errCodeCatalogue = map[ErrCode]errCodeEntry{\n")
+	for _, rec := range records {
+		fmt.Fprintf(&buf, "\tErr%s: {kind: %s, message: %q},\n", rec[1], rec[2], rec[3])
+	}
+	buf.WriteString("}\n")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile("errcode.go", src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -12,11 +12,98 @@ import (
 	"log"
 	"mime/multipart"
 	"net/http"
+	"net/url"
 	"os"
+	"strings"
 
 	"golang.org/x/crypto/pkcs12"
 )
 
+// HTTPClient 发送http请求的客户端，*http.Client 实现了该接口
+//
+// 可用于设置超时、代理、连接池，或在测试中替换为录制/桩实现
+type HTTPClient interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// DefaultHTTPClient 默认的http client，未单独配置时所有请求都使用它
+var DefaultHTTPClient HTTPClient = http.DefaultClient
+
+// SetHTTPClient 设置默认的http client
+func SetHTTPClient(client HTTPClient) {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	DefaultHTTPClient = client
+}
+
+type httpClientCtxKey struct{}
+
+// ContextWithHTTPClient 返回携带指定http client的context，使用该context发起的请求都会使用此client
+//
+// client 为 nil 时直接返回原 ctx
+func ContextWithHTTPClient(ctx context.Context, client HTTPClient) context.Context {
+	if client == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, httpClientCtxKey{}, client)
+}
+
+// HTTPClientFromContext 获取ctx中携带的http client，没有则返回 DefaultHTTPClient；ctx 携带拦截器时返回经过拦截器的 client
+func HTTPClientFromContext(ctx context.Context) HTTPClient {
+	client := DefaultHTTPClient
+	if ctx != nil {
+		if c, ok := ctx.Value(httpClientCtxKey{}).(HTTPClient); ok {
+			client = c
+		}
+	}
+	return ChainHTTPClient(client, InterceptorsFromContext(ctx)...)
+}
+
+// AccessTokenRefreshFunc 使 staleToken 失效并返回新的 access_token
+type AccessTokenRefreshFunc func(ctx context.Context, staleToken string) (string, error)
+
+// DoWithAccessTokenRetry 使用 uri 调用 do 发送请求，响应的 errcode 表示 access_token 已失效时，
+// 通过 refresh 获取新的 access_token 替换 uri 中的参数后重放一次
+//
+// uri 不包含 access_token 参数、refresh 为 nil 或刷新失败时，返回首次请求的结果；
+// 网页授权（/sns/）接口使用的是用户的 access_token，不会重试
+func DoWithAccessTokenRetry(ctx context.Context, uri string, refresh AccessTokenRefreshFunc, do func(uri string) ([]byte, error)) ([]byte, error) {
+	response, err := do(uri)
+	if err != nil || refresh == nil || !IsAccessTokenInvalid(ResponseErrCode(response)) {
+		return response, err
+	}
+	u, e := url.Parse(uri)
+	if e != nil || strings.HasPrefix(u.Path, "/sns/") {
+		return response, err
+	}
+	query := u.Query()
+	staleToken := query.Get("access_token")
+	if staleToken == "" {
+		return response, err
+	}
+	accessToken, e := refresh(ctx, staleToken)
+	if e != nil || accessToken == "" || accessToken == staleToken {
+		return response, err
+	}
+	query.Set("access_token", accessToken)
+	u.RawQuery = query.Encode()
+	return do(u.String())
+}
+
+// ResponseErrCode 解析响应中的 errcode，非json响应（如图片）返回 0
+func ResponseErrCode(response []byte) int64 {
+	response = bytes.TrimSpace(response)
+	if len(response) == 0 || response[0] != '{' {
+		return 0
+	}
+	var commError CommonError
+	if err := json.Unmarshal(response, &commError); err != nil {
+		return 0
+	}
+	return commError.ErrCode
+}
+
 // HTTPGet get 请求
 func HTTPGet(uri string) ([]byte, error) {
 	return HTTPGetContext(context.Background(), uri)
@@ -28,7 +115,7 @@ func HTTPGetContext(ctx context.Context, uri string) ([]byte, error) {
 	if err != nil {
 		return nil, err
 	}
-	response, err := http.DefaultClient.Do(request)
+	response, err := HTTPClientFromContext(ctx).Do(request)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +144,7 @@ func HTTPPostContext(ctx context.Context, uri string, data []byte, header map[st
 		request.Header.Set(key, value)
 	}
 
-	response, err := http.DefaultClient.Do(request)
+	response, err := HTTPClientFromContext(ctx).Do(request)
 	if err != nil {
 		return nil, err
 	}
@@ -71,51 +158,22 @@ func HTTPPostContext(ctx context.Context, uri string, data []byte, header map[st
 
 // PostJSON post json 数据请求
 func PostJSON(uri string, obj interface{}) ([]byte, error) {
-	jsonBuf := new(bytes.Buffer)
-	enc := json.NewEncoder(jsonBuf)
-	enc.SetEscapeHTML(false)
-	err := enc.Encode(obj)
-	if err != nil {
-		return nil, err
-	}
-	response, err := http.Post(uri, "application/json;charset=utf-8", jsonBuf)
-	if err != nil {
-		return nil, err
-	}
-	defer response.Body.Close()
-
-	if response.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("http get error : uri=%v , statusCode=%v", uri, response.StatusCode)
-	}
-	return io.ReadAll(response.Body)
+	return PostJSONContext(context.Background(), uri, obj)
 }
 
+// PostJSONContext post json 数据请求
 func PostJSONContext(ctx context.Context, uri string, obj interface{}) ([]byte, error) {
-	jsonBuf := new(bytes.Buffer)
-	enc := json.NewEncoder(jsonBuf)
-	enc.SetEscapeHTML(false)
-	err := enc.Encode(obj)
-	if err != nil {
-		return nil, err
-	}
-	request, err := http.NewRequestWithContext(ctx, http.MethodPost, uri, jsonBuf)
-	if err != nil {
-		return nil, err
-	}
-	request.Header.Set("Content-Type", "application/json;charset=utf-8")
-	response, err := http.DefaultClient.Do(request)
-	if err != nil {
-		return nil, err
-	}
-	defer response.Body.Close()
-	if response.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("http get error : uri=%v , statusCode=%v", uri, response.StatusCode)
-	}
-	return io.ReadAll(response.Body)
+	responseData, _, err := PostJSONWithRespContentTypeContext(ctx, uri, obj)
+	return responseData, err
 }
 
 // PostJSONWithRespContentType post json数据请求，且返回数据类型
 func PostJSONWithRespContentType(uri string, obj interface{}) ([]byte, string, error) {
+	return PostJSONWithRespContentTypeContext(context.Background(), uri, obj)
+}
+
+// PostJSONWithRespContentTypeContext post json数据请求，且返回数据类型
+func PostJSONWithRespContentTypeContext(ctx context.Context, uri string, obj interface{}) ([]byte, string, error) {
 	jsonBuf := new(bytes.Buffer)
 	enc := json.NewEncoder(jsonBuf)
 	enc.SetEscapeHTML(false)
@@ -123,8 +181,12 @@ func PostJSONWithRespContentType(uri string, obj interface{}) ([]byte, string, e
 	if err != nil {
 		return nil, "", err
 	}
-
-	response, err := http.Post(uri, "application/json;charset=utf-8", jsonBuf)
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, uri, jsonBuf)
+	if err != nil {
+		return nil, "", err
+	}
+	request.Header.Set("Content-Type", "application/json;charset=utf-8")
+	response, err := HTTPClientFromContext(ctx).Do(request)
 	if err != nil {
 		return nil, "", err
 	}
@@ -140,6 +202,11 @@ func PostJSONWithRespContentType(uri string, obj interface{}) ([]byte, string, e
 
 // PostFile 上传文件
 func PostFile(fieldName, filename, uri string) ([]byte, error) {
+	return PostFileContext(context.Background(), fieldName, filename, uri)
+}
+
+// PostFileContext 上传文件
+func PostFileContext(ctx context.Context, fieldName, filename, uri string) ([]byte, error) {
 	fields := []MultipartFormField{
 		{
 			IsFile:    true,
@@ -147,7 +214,7 @@ func PostFile(fieldName, filename, uri string) ([]byte, error) {
 			Filename:  filename,
 		},
 	}
-	return PostMultipartForm(fields, uri)
+	return PostMultipartFormContext(ctx, fields, uri)
 }
 
 // MultipartFormField 保存文件或其他字段信息
@@ -160,6 +227,11 @@ type MultipartFormField struct {
 
 // PostMultipartForm 上传文件或其他多个字段
 func PostMultipartForm(fields []MultipartFormField, uri string) (respBody []byte, err error) {
+	return PostMultipartFormContext(context.Background(), fields, uri)
+}
+
+// PostMultipartFormContext 上传文件或其他多个字段
+func PostMultipartFormContext(ctx context.Context, fields []MultipartFormField, uri string) (respBody []byte, err error) {
 	bodyBuf := &bytes.Buffer{}
 	bodyWriter := multipart.NewWriter(bodyBuf)
 
@@ -197,7 +269,13 @@ func PostMultipartForm(fields []MultipartFormField, uri string) (respBody []byte
 	contentType := bodyWriter.FormDataContentType()
 	bodyWriter.Close()
 
-	resp, e := http.Post(uri, contentType, bodyBuf)
+	request, e := http.NewRequestWithContext(ctx, http.MethodPost, uri, bodyBuf)
+	if e != nil {
+		err = e
+		return
+	}
+	request.Header.Set("Content-Type", contentType)
+	resp, e := HTTPClientFromContext(ctx).Do(request)
 	if e != nil {
 		err = e
 		return
@@ -212,13 +290,23 @@ func PostMultipartForm(fields []MultipartFormField, uri string) (respBody []byte
 
 // PostXML perform a HTTP/POST request with XML body
 func PostXML(uri string, obj interface{}) ([]byte, error) {
+	return PostXMLContext(context.Background(), uri, obj)
+}
+
+// PostXMLContext perform a HTTP/POST request with XML body
+func PostXMLContext(ctx context.Context, uri string, obj interface{}) ([]byte, error) {
 	xmlData, err := xml.Marshal(obj)
 	if err != nil {
 		return nil, err
 	}
 
 	body := bytes.NewBuffer(xmlData)
-	response, err := http.Post(uri, "application/xml;charset=utf-8", body)
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, uri, body)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Content-Type", "application/xml;charset=utf-8")
+	response, err := HTTPClientFromContext(ctx).Do(request)
 	if err != nil {
 		return nil, err
 	}
@@ -231,21 +319,34 @@ func PostXML(uri string, obj interface{}) ([]byte, error) {
 }
 
 // httpWithTLS CA证书
-func httpWithTLS(rootCa, key string) (*http.Client, error) {
-	var client *http.Client
+//
+// ctx 中携带 *http.Client 时沿用其超时、代理等设置，Transport 为 *http.Transport（或为空）时复制后加入证书
+func httpWithTLS(ctx context.Context, rootCa, key string) (*http.Client, error) {
 	certData, err := os.ReadFile(rootCa)
 	if err != nil {
 		return nil, fmt.Errorf("unable to find cert path=%s, error=%v", rootCa, err)
 	}
 	cert := pkcs12ToPem(certData, key)
-	config := &tls.Config{
-		Certificates: []tls.Certificate{cert},
+
+	client := &http.Client{}
+	tr := &http.Transport{DisableCompression: true}
+	if c, ok := ctx.Value(httpClientCtxKey{}).(*http.Client); ok {
+		*client = *c
+		rt := c.Transport
+		if rt == nil {
+			rt = http.DefaultTransport
+		}
+		if t, ok := rt.(*http.Transport); ok {
+			tr = t.Clone()
+		}
 	}
-	tr := &http.Transport{
-		TLSClientConfig:    config,
-		DisableCompression: true,
+	config := &tls.Config{}
+	if tr.TLSClientConfig != nil {
+		config = tr.TLSClientConfig.Clone()
 	}
-	client = &http.Client{Transport: tr}
+	config.Certificates = []tls.Certificate{cert}
+	tr.TLSClientConfig = config
+	client.Transport = tr
 	return client, nil
 }
 
@@ -273,17 +374,29 @@ func pkcs12ToPem(p12 []byte, password string) tls.Certificate {
 
 // PostXMLWithTLS perform a HTTP/POST request with XML body and TLS
 func PostXMLWithTLS(uri string, obj interface{}, ca, key string) ([]byte, error) {
+	return PostXMLWithTLSContext(context.Background(), uri, obj, ca, key)
+}
+
+// PostXMLWithTLSContext perform a HTTP/POST request with XML body and TLS
+//
+// 证书请求需要单独的 Transport，若ctx中携带的是 *http.Client，则复制其设置并加入证书；ctx 中的拦截器同样生效
+func PostXMLWithTLSContext(ctx context.Context, uri string, obj interface{}, ca, key string) ([]byte, error) {
 	xmlData, err := xml.Marshal(obj)
 	if err != nil {
 		return nil, err
 	}
 
 	body := bytes.NewBuffer(xmlData)
-	client, err := httpWithTLS(ca, key)
+	client, err := httpWithTLS(ctx, ca, key)
+	if err != nil {
+		return nil, err
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, uri, body)
 	if err != nil {
 		return nil, err
 	}
-	response, err := client.Post(uri, "application/xml;charset=utf-8", body)
+	request.Header.Set("Content-Type", "application/xml;charset=utf-8")
+	response, err := ChainHTTPClient(client, InterceptorsFromContext(ctx)...).Do(request)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,136 @@
+package util
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/xml"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type recordClient struct {
+	requests []*http.Request
+}
+
+func (c *recordClient) Do(req *http.Request) (*http.Response, error) {
+	c.requests = append(c.requests, req)
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(`{"errcode":0}`)),
+	}, nil
+}
+
+func TestContextWithHTTPClient(t *testing.T) {
+	client := &recordClient{}
+	ctx := ContextWithHTTPClient(context.Background(), client)
+	assert.Equal(t, HTTPClient(client), HTTPClientFromContext(ctx))
+	assert.Equal(t, DefaultHTTPClient, HTTPClientFromContext(context.Background()))
+	assert.Equal(t, context.Background(), ContextWithHTTPClient(context.Background(), nil))
+
+	body, err := PostJSONContext(ctx, "https://api.weixin.qq.com/cgi-bin/test", map[string]string{"a": "b"})
+	assert.Nil(t, err)
+	assert.Equal(t, `{"errcode":0}`, string(body))
+
+	_, err = HTTPGetContext(ctx, "https://api.weixin.qq.com/cgi-bin/test")
+	assert.Nil(t, err)
+
+	assert.Len(t, client.requests, 2)
+	assert.Equal(t, http.MethodPost, client.requests[0].Method)
+	assert.Equal(t, "application/json;charset=utf-8", client.requests[0].Header.Get("Content-Type"))
+	assert.Equal(t, http.MethodGet, client.requests[1].Method)
+}
+
+type xmlRequest struct {
+	A string `xml:"a"`
+}
+
+func TestSetHTTPClient(t *testing.T) {
+	defer SetHTTPClient(nil)
+	client := &recordClient{}
+	SetHTTPClient(client)
+	_, err := PostXML("https://api.mch.weixin.qq.com/pay/test", &xmlRequest{A: "b"})
+	assert.Nil(t, err)
+	assert.Len(t, client.requests, 1)
+	assert.Equal(t, "application/xml;charset=utf-8", client.requests[0].Header.Get("Content-Type"))
+}
+
+func TestDoWithAccessTokenRetry(t *testing.T) {
+	var uris []string
+	do := func(uri string) ([]byte, error) {
+		uris = append(uris, uri)
+		if len(uris) == 1 {
+			return []byte(`{"errcode":40001,"errmsg":"invalid credential"}`), nil
+		}
+		return []byte(`{"errcode":0}`), nil
+	}
+	refresh := func(ctx context.Context, staleToken string) (string, error) {
+		assert.Equal(t, "stale", staleToken)
+		return "fresh", nil
+	}
+	response, err := DoWithAccessTokenRetry(context.Background(), "https://api.weixin.qq.com/cgi-bin/user/info?access_token=stale&openid=o1", refresh, do)
+	assert.Nil(t, err)
+	assert.Equal(t, `{"errcode":0}`, string(response))
+	assert.Equal(t, []string{
+		"https://api.weixin.qq.com/cgi-bin/user/info?access_token=stale&openid=o1",
+		"https://api.weixin.qq.com/cgi-bin/user/info?access_token=fresh&openid=o1",
+	}, uris)
+
+	// 网页授权接口不重试
+	uris = nil
+	response, err = DoWithAccessTokenRetry(context.Background(), "https://api.weixin.qq.com/sns/userinfo?access_token=stale", refresh, do)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(ErrCodeInvalidCredential), ResponseErrCode(response))
+	assert.Len(t, uris, 1)
+}
+
+func TestContextWithInterceptors(t *testing.T) {
+	var order []string
+	interceptor := func(name string) Interceptor {
+		return func(req *http.Request, next Invoker) (*http.Response, error) {
+			order = append(order, name)
+			return next(req)
+		}
+	}
+	client := &recordClient{}
+	ctx := ContextWithInterceptors(ContextWithHTTPClient(context.Background(), client), interceptor("a"))
+	ctx = ContextWithInterceptors(ctx, interceptor("b"))
+
+	_, err := HTTPGetContext(ctx, "https://api.weixin.qq.com/cgi-bin/test")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"a", "b"}, order)
+	assert.Len(t, client.requests, 1)
+}
+
+func TestPostXMLWithTLSContext(t *testing.T) {
+	ts := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if len(r.TLS.PeerCertificates) == 0 {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		_, _ = io.WriteString(w, "<xml><return_code>SUCCESS</return_code></xml>")
+	}))
+	ts.TLS = &tls.Config{ClientAuth: tls.RequireAnyClientCert}
+	ts.StartTLS()
+	defer ts.Close()
+
+	// ts.Client() 信任测试服务的证书，请求成功说明复制了 ctx 中 client 的 Transport 并加入了商户证书
+	var intercepted int
+	ctx := ContextWithHTTPClient(context.Background(), ts.Client())
+	ctx = ContextWithInterceptors(ctx, func(req *http.Request, next Invoker) (*http.Response, error) {
+		intercepted++
+		return next(req)
+	})
+	req := struct {
+		XMLName xml.Name `xml:"xml"`
+	}{}
+	body, err := PostXMLWithTLSContext(ctx, ts.URL, req, "testdata/apiclient_cert.p12", "1900000109")
+	assert.Nil(t, err)
+	assert.Contains(t, string(body), "SUCCESS")
+	assert.Equal(t, 1, intercepted)
+}
@@ -0,0 +1,92 @@
+package util
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// Invoker 发送请求
+type Invoker func(req *http.Request) (*http.Response, error)
+
+// Interceptor 请求拦截器，在调用 next 前后可记录耗时、错误码或链路信息
+type Interceptor func(req *http.Request, next Invoker) (*http.Response, error)
+
+type chainClient struct {
+	client       HTTPClient
+	interceptors []Interceptor
+}
+
+// ChainHTTPClient 返回依次经过 interceptors 再由 client 发送请求的 http client，client 为空时使用 DefaultHTTPClient
+func ChainHTTPClient(client HTTPClient, interceptors ...Interceptor) HTTPClient {
+	if len(interceptors) == 0 && client != nil {
+		return client
+	}
+	return &chainClient{client: client, interceptors: interceptors}
+}
+
+// Do 发送请求
+func (c *chainClient) Do(req *http.Request) (*http.Response, error) {
+	client := c.client
+	if client == nil {
+		client = DefaultHTTPClient
+	}
+	invoker := client.Do
+	for i := len(c.interceptors) - 1; i >= 0; i-- {
+		interceptor, next := c.interceptors[i], invoker
+		invoker = func(req *http.Request) (*http.Response, error) {
+			return interceptor(req, next)
+		}
+	}
+	return invoker(req)
+}
+
+type interceptorsCtxKey struct{}
+
+// ContextWithInterceptors 返回携带请求拦截器的context，使用该context发起的请求都会经过这些拦截器
+//
+// 已携带的拦截器在前，interceptors 为空时直接返回原 ctx
+func ContextWithInterceptors(ctx context.Context, interceptors ...Interceptor) context.Context {
+	if len(interceptors) == 0 {
+		return ctx
+	}
+	exists := InterceptorsFromContext(ctx)
+	chain := make([]Interceptor, 0, len(exists)+len(interceptors))
+	chain = append(append(chain, exists...), interceptors...)
+	return context.WithValue(ctx, interceptorsCtxKey{}, chain)
+}
+
+// InterceptorsFromContext 获取ctx中携带的请求拦截器
+func InterceptorsFromContext(ctx context.Context) []Interceptor {
+	if ctx == nil {
+		return nil
+	}
+	interceptors, _ := ctx.Value(interceptorsCtxKey{}).([]Interceptor)
+	return interceptors
+}
+
+// maxPeekSize 解析 errcode 时最多读取的响应长度，超过的响应（如素材下载）不解析
+const maxPeekSize = 1 << 20
+
+// PeekResponseErrCode 供拦截器读取 json 响应中的 errcode，读取的内容会放回 resp.Body
+func PeekResponseErrCode(resp *http.Response) int64 {
+	contentType := resp.Header.Get("Content-Type")
+	if resp.Body == nil || resp.ContentLength > maxPeekSize ||
+		!(strings.Contains(contentType, "json") || strings.HasPrefix(contentType, "text/plain")) {
+		return 0
+	}
+	body := resp.Body
+	data, err := io.ReadAll(io.LimitReader(body, maxPeekSize+1))
+	resp.Body = readCloser{Reader: io.MultiReader(bytes.NewReader(data), body), Closer: body}
+	if err != nil || len(data) > maxPeekSize {
+		return 0
+	}
+	return ResponseErrCode(data)
+}
+
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
@@ -0,0 +1,79 @@
+package util
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/silenceper/wechat/v2/cache"
+)
+
+// DefaultMaxClockSkew 回调时间戳与本地时间的默认最大偏差
+const DefaultMaxClockSkew = 5 * time.Minute
+
+var (
+	// ErrTimestampExpired 回调时间戳超出允许的偏差
+	ErrTimestampExpired = errors.New("callback timestamp expired")
+	// ErrNonceReplayed 回调的 timestamp 与 nonce 已使用过，可能是重放请求
+	ErrNonceReplayed = errors.New("callback nonce replayed")
+)
+
+// ReplayGuard 回调请求的防重放校验：时间戳与本地时间的偏差不超过 maxSkew，且有效期内 timestamp+nonce 只能使用一次
+//
+// 需在签名校验通过后调用；多实例部署时 cache 需为 Redis 等共享缓存，cache 为 nil 时只校验时间戳
+type ReplayGuard struct {
+	cache     cache.ContextCache
+	keyPrefix string
+	maxSkew   time.Duration
+	now       func() time.Time
+}
+
+// NewReplayGuard init，keyPrefix 用于区分不同的应用，maxSkew <= 0 时使用 DefaultMaxClockSkew
+func NewReplayGuard(c cache.Cache, keyPrefix string, maxSkew time.Duration) *ReplayGuard {
+	if maxSkew <= 0 {
+		maxSkew = DefaultMaxClockSkew
+	}
+	guard := &ReplayGuard{keyPrefix: keyPrefix, maxSkew: maxSkew, now: time.Now}
+	if c != nil {
+		guard.cache = cache.FromCache(c)
+	}
+	return guard
+}
+
+// Check 校验时间戳并记录 nonce
+func (guard *ReplayGuard) Check(ctx context.Context, timestamp, nonce string) error {
+	ts, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid callback timestamp %q: %w", timestamp, err)
+	}
+	skew := guard.now().Sub(time.Unix(ts, 0))
+	if skew > guard.maxSkew || skew < -guard.maxSkew {
+		return ErrTimestampExpired
+	}
+	if guard.cache == nil {
+		return nil
+	}
+	// 时间戳超出偏差后请求会被拒绝，nonce 只需保留 2*maxSkew
+	ok, err := guard.cache.SetNX(ctx, guard.key(timestamp, nonce), "1", 2*guard.maxSkew)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrNonceReplayed
+	}
+	return nil
+}
+
+// Release 删除 nonce 记录，请求处理失败时调用，使微信的重试推送可以通过校验
+func (guard *ReplayGuard) Release(ctx context.Context, timestamp, nonce string) error {
+	if guard.cache == nil {
+		return nil
+	}
+	return guard.cache.DeleteContext(ctx, guard.key(timestamp, nonce))
+}
+
+func (guard *ReplayGuard) key(timestamp, nonce string) string {
+	return fmt.Sprintf("%snonce_%s_%s", guard.keyPrefix, timestamp, nonce)
+}
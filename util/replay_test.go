@@ -0,0 +1,40 @@
+package util
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/silenceper/wechat/v2/cache"
+)
+
+func TestReplayGuard(t *testing.T) {
+	memory := cache.NewMemory()
+	defer memory.Close()
+	now := time.Unix(1700000000, 0)
+	guard := NewReplayGuard(memory, "test_", time.Minute)
+	guard.now = func() time.Time { return now }
+	ctx := context.Background()
+	ts := strconv.FormatInt(now.Unix(), 10)
+
+	assert.Nil(t, guard.Check(ctx, ts, "nonce"))
+	assert.ErrorIs(t, guard.Check(ctx, ts, "nonce"), ErrNonceReplayed)
+	assert.Nil(t, guard.Check(ctx, ts, "nonce2"))
+
+	// 处理失败后允许重试
+	assert.Nil(t, guard.Release(ctx, ts, "nonce"))
+	assert.Nil(t, guard.Check(ctx, ts, "nonce"))
+
+	assert.ErrorIs(t, guard.Check(ctx, strconv.FormatInt(now.Add(-2*time.Minute).Unix(), 10), "nonce3"), ErrTimestampExpired)
+	assert.ErrorIs(t, guard.Check(ctx, strconv.FormatInt(now.Add(2*time.Minute).Unix(), 10), "nonce3"), ErrTimestampExpired)
+	assert.Error(t, guard.Check(ctx, "abc", "nonce3"))
+
+	// 未设置缓存时只校验时间戳
+	guard = NewReplayGuard(nil, "test_", 0)
+	guard.now = func() time.Time { return now }
+	assert.Nil(t, guard.Check(ctx, ts, "nonce"))
+	assert.Nil(t, guard.Check(ctx, ts, "nonce"))
+}
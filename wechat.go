@@ -1,11 +1,8 @@
 package wechat
 
 import (
-	"os"
-
-	log "github.com/sirupsen/logrus"
-
 	"github.com/silenceper/wechat/v2/cache"
+	"github.com/silenceper/wechat/v2/logger"
 	"github.com/silenceper/wechat/v2/miniprogram"
 	miniConfig "github.com/silenceper/wechat/v2/miniprogram/config"
 	"github.com/silenceper/wechat/v2/officialaccount"
@@ -14,25 +11,20 @@ import (
 	openConfig "github.com/silenceper/wechat/v2/openplatform/config"
 	"github.com/silenceper/wechat/v2/pay"
 	payConfig "github.com/silenceper/wechat/v2/pay/config"
+	"github.com/silenceper/wechat/v2/ratelimit"
+	"github.com/silenceper/wechat/v2/util"
 	"github.com/silenceper/wechat/v2/work"
 	workConfig "github.com/silenceper/wechat/v2/work/config"
 )
 
-func init() {
-	// Log as JSON instead of the default ASCII formatter.
-	log.SetFormatter(&log.TextFormatter{})
-
-	// Output to stdout instead of the default stderr
-	// Can be any io.Writer, see below for File example
-	log.SetOutput(os.Stdout)
-
-	// Only log the warning severity or above.
-	log.SetLevel(log.DebugLevel)
-}
-
 // Wechat struct
 type Wechat struct {
-	cache cache.Cache
+	cache      cache.Cache
+	httpClient util.HTTPClient
+	logger     logger.Logger
+
+	interceptors []util.Interceptor
+	rateLimiter  ratelimit.Limiter
 }
 
 // NewWechat init
@@ -45,11 +37,43 @@ func (wc *Wechat) SetCache(cache cache.Cache) {
 	wc.cache = cache
 }
 
+// SetHTTPClient 设置http client，未单独配置 HTTPClient 的实例都会使用它
+func (wc *Wechat) SetHTTPClient(client util.HTTPClient) {
+	wc.httpClient = client
+}
+
+// SetLogger 设置日志，未单独配置 Logger 的实例都会使用它
+func (wc *Wechat) SetLogger(l logger.Logger) {
+	wc.logger = l
+}
+
+// Use 添加请求拦截器，未单独配置 Interceptors 的实例都会使用它们
+func (wc *Wechat) Use(interceptors ...util.Interceptor) {
+	wc.interceptors = append(wc.interceptors, interceptors...)
+}
+
+// SetRateLimiter 设置限流，未单独配置 RateLimiter 的公众号、小程序、开放平台及企业微信实例都会使用它
+func (wc *Wechat) SetRateLimiter(limiter ratelimit.Limiter) {
+	wc.rateLimiter = limiter
+}
+
 // GetOfficialAccount 获取微信公众号实例
 func (wc *Wechat) GetOfficialAccount(cfg *offConfig.Config) *officialaccount.OfficialAccount {
 	if cfg.Cache == nil {
 		cfg.Cache = wc.cache
 	}
+	if cfg.HTTPClient == nil {
+		cfg.HTTPClient = wc.httpClient
+	}
+	if cfg.Logger == nil {
+		cfg.Logger = wc.logger
+	}
+	if cfg.Interceptors == nil {
+		cfg.Interceptors = wc.interceptors
+	}
+	if cfg.RateLimiter == nil {
+		cfg.RateLimiter = wc.rateLimiter
+	}
 	return officialaccount.NewOfficialAccount(cfg)
 }
 
@@ -58,20 +82,65 @@ func (wc *Wechat) GetMiniProgram(cfg *miniConfig.Config) *miniprogram.MiniProgra
 	if cfg.Cache == nil {
 		cfg.Cache = wc.cache
 	}
+	if cfg.HTTPClient == nil {
+		cfg.HTTPClient = wc.httpClient
+	}
+	if cfg.Logger == nil {
+		cfg.Logger = wc.logger
+	}
+	if cfg.Interceptors == nil {
+		cfg.Interceptors = wc.interceptors
+	}
+	if cfg.RateLimiter == nil {
+		cfg.RateLimiter = wc.rateLimiter
+	}
 	return miniprogram.NewMiniProgram(cfg)
 }
 
 // GetPay 获取微信支付的实例
 func (wc *Wechat) GetPay(cfg *payConfig.Config) *pay.Pay {
+	if cfg.HTTPClient == nil {
+		cfg.HTTPClient = wc.httpClient
+	}
+	if cfg.Logger == nil {
+		cfg.Logger = wc.logger
+	}
+	if cfg.Interceptors == nil {
+		cfg.Interceptors = wc.interceptors
+	}
 	return pay.NewPay(cfg)
 }
 
 // GetOpenPlatform 获取微信开放平台的实例
 func (wc *Wechat) GetOpenPlatform(cfg *openConfig.Config) *openplatform.OpenPlatform {
+	if cfg.HTTPClient == nil {
+		cfg.HTTPClient = wc.httpClient
+	}
+	if cfg.Logger == nil {
+		cfg.Logger = wc.logger
+	}
+	if cfg.Interceptors == nil {
+		cfg.Interceptors = wc.interceptors
+	}
+	if cfg.RateLimiter == nil {
+		cfg.RateLimiter = wc.rateLimiter
+	}
 	return openplatform.NewOpenPlatform(cfg)
 }
 
 // GetWork 获取企业微信的实例
 func (wc *Wechat) GetWork(cfg *workConfig.Config) *work.Work {
+	if cfg.HTTPClient == nil {
+		cfg.HTTPClient = wc.httpClient
+	}
+	if cfg.Logger == nil {
+		cfg.Logger = wc.logger
+	}
+	if cfg.Interceptors == nil {
+		cfg.Interceptors = wc.interceptors
+	}
+	if cfg.RateLimiter == nil {
+		cfg.RateLimiter = wc.rateLimiter
+	}
 	return work.NewWork(cfg)
 }
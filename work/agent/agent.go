@@ -40,7 +40,7 @@ func (r *Agent) Get(agentId int32) (info RespGetAgent, err error) {
 	if err != nil {
 		return
 	}
-	data, err = util.HTTPGet(r.ctx.GetQYAPIDomain() + fmt.Sprintf(agentGetAddr, accessToken, agentId))
+	data, err = r.ctx.HTTPGet(r.ctx.GetQYAPIDomain() + fmt.Sprintf(agentGetAddr, accessToken, agentId))
 	if err != nil {
 		return
 	}
@@ -48,7 +48,7 @@ func (r *Agent) Get(agentId int32) (info RespGetAgent, err error) {
 		return
 	}
 	if info.ErrCode != 0 {
-		return info, xerror.NewSDKErr(info.ErrCode, info.ErrMsg)
+		return info, xerror.NewAPIErr("Get", info.ErrCode, info.ErrMsg)
 	}
 	return info, nil
 }
@@ -64,7 +64,7 @@ func (r *Agent) List() (info RespListAgent, err error) {
 	if err != nil {
 		return
 	}
-	data, err = util.HTTPGet(r.ctx.GetQYAPIDomain() + fmt.Sprintf(agentListAddr, accessToken))
+	data, err = r.ctx.HTTPGet(r.ctx.GetQYAPIDomain() + fmt.Sprintf(agentListAddr, accessToken))
 	if err != nil {
 		return
 	}
@@ -72,7 +72,7 @@ func (r *Agent) List() (info RespListAgent, err error) {
 		return
 	}
 	if info.ErrCode != 0 {
-		return info, xerror.NewSDKErr(info.ErrCode, info.ErrMsg)
+		return info, xerror.NewAPIErr("List", info.ErrCode, info.ErrMsg)
 	}
 	return info, nil
 }
@@ -88,7 +88,7 @@ func (r *Agent) Set(options ReqAgentSet) (info util.CommonError, err error) {
 	if err != nil {
 		return
 	}
-	data, err = util.PostJSON(r.ctx.GetQYAPIDomain()+fmt.Sprintf(agentSetAddr, accessToken), options)
+	data, err = r.ctx.PostJSON(r.ctx.GetQYAPIDomain()+fmt.Sprintf(agentSetAddr, accessToken), options)
 	if err != nil {
 		return
 	}
@@ -96,7 +96,7 @@ func (r *Agent) Set(options ReqAgentSet) (info util.CommonError, err error) {
 		return
 	}
 	if info.ErrCode != 0 {
-		return info, xerror.NewSDKErr(info.ErrCode, info.ErrMsg)
+		return info, xerror.NewAPIErr("Set", info.ErrCode, info.ErrMsg)
 	}
 	return info, nil
 }
@@ -122,7 +122,7 @@ func (r *Agent) MenuCreate(agentId int32, options ReqAgentMenuCreate) (info util
 	if err != nil {
 		return
 	}
-	data, err = util.PostJSON(r.ctx.GetQYAPIDomain()+fmt.Sprintf(agentMenuCreateAddr, accessToken, agentId), options)
+	data, err = r.ctx.PostJSON(r.ctx.GetQYAPIDomain()+fmt.Sprintf(agentMenuCreateAddr, accessToken, agentId), options)
 	if err != nil {
 		return
 	}
@@ -130,7 +130,7 @@ func (r *Agent) MenuCreate(agentId int32, options ReqAgentMenuCreate) (info util
 		return
 	}
 	if info.ErrCode != 0 {
-		return info, xerror.NewSDKErr(info.ErrCode, info.ErrMsg)
+		return info, xerror.NewAPIErr("MenuCreate", info.ErrCode, info.ErrMsg)
 	}
 	return info, nil
 }
@@ -146,7 +146,7 @@ func (r *Agent) MenuGet(agentId int32) (info RespAgentMenuGet, err error) {
 	if err != nil {
 		return
 	}
-	data, err = util.HTTPGet(r.ctx.GetQYAPIDomain() + fmt.Sprintf(agentMenuGetAddr, accessToken, agentId))
+	data, err = r.ctx.HTTPGet(r.ctx.GetQYAPIDomain() + fmt.Sprintf(agentMenuGetAddr, accessToken, agentId))
 	if err != nil {
 		return
 	}
@@ -154,7 +154,7 @@ func (r *Agent) MenuGet(agentId int32) (info RespAgentMenuGet, err error) {
 		return
 	}
 	if info.ErrCode != 0 {
-		return info, xerror.NewSDKErr(info.ErrCode, info.ErrMsg)
+		return info, xerror.NewAPIErr("MenuGet", info.ErrCode, info.ErrMsg)
 	}
 	return info, nil
 }
@@ -170,7 +170,7 @@ func (r *Agent) MenuDelete(agentId int32) (info util.CommonError, err error) {
 	if err != nil {
 		return
 	}
-	data, err = util.HTTPGet(r.ctx.GetQYAPIDomain() + fmt.Sprintf(agentMenuDeleteAddr, accessToken, agentId))
+	data, err = r.ctx.HTTPGet(r.ctx.GetQYAPIDomain() + fmt.Sprintf(agentMenuDeleteAddr, accessToken, agentId))
 	if err != nil {
 		return
 	}
@@ -178,7 +178,7 @@ func (r *Agent) MenuDelete(agentId int32) (info util.CommonError, err error) {
 		return
 	}
 	if info.ErrCode != 0 {
-		return info, xerror.NewSDKErr(info.ErrCode, info.ErrMsg)
+		return info, xerror.NewAPIErr("MenuDelete", info.ErrCode, info.ErrMsg)
 	}
 	return info, nil
 }
@@ -3,6 +3,9 @@ package config
 
 import (
 	"github.com/silenceper/wechat/v2/cache"
+	"github.com/silenceper/wechat/v2/logger"
+	"github.com/silenceper/wechat/v2/ratelimit"
+	"github.com/silenceper/wechat/v2/util"
 )
 
 // Config for 企业微信
@@ -11,7 +14,11 @@ type Config struct {
 	CorpSecret    string `json:"corp_secret"` // corp_secret,如果需要获取会话存档实例，当前参数请填写聊天内容存档的Secret，可以在企业微信管理端--管理工具--聊天内容存档查看
 	AgentID       int    `json:"agent_id"`    // agent_id
 	Cache         cache.Cache
-	RasPrivateKey string // 消息加密私钥，可以在企业微信管理端--管理工具--消息加密公钥查看对用公钥，私钥一般由自己保存
+	RasPrivateKey string             // 消息加密私钥，可以在企业微信管理端--管理工具--消息加密公钥查看对用公钥，私钥一般由自己保存
+	HTTPClient    util.HTTPClient    // 自定义http client，为空时使用 util.DefaultHTTPClient
+	Logger        logger.Logger      // 日志，为空时输出到 logrus 的 StandardLogger
+	Interceptors  []util.Interceptor // 请求拦截器，可用于统计接口耗时、错误码及链路追踪
+	RateLimiter   ratelimit.Limiter  // 限流，为空时不限流
 
 	Token           string `json:"token"`            // 微信客服回调配置，用于生成签名校验回调请求的合法性
 	EncodingAESKey  string `json:"encoding_aes_key"` // 微信客服回调p配置，用于解密回调消息内容对应的密文
@@ -46,7 +46,7 @@ func (r *Department) Create(options ReqDepartmentCreate) (info RespDepartmentCre
 	if err != nil {
 		return
 	}
-	data, err = util.PostJSON(r.ctx.GetQYAPIDomain()+fmt.Sprintf(departmentCreateAddr, accessToken), options)
+	data, err = r.ctx.PostJSON(r.ctx.GetQYAPIDomain()+fmt.Sprintf(departmentCreateAddr, accessToken), options)
 	if err != nil {
 		return
 	}
@@ -54,7 +54,7 @@ func (r *Department) Create(options ReqDepartmentCreate) (info RespDepartmentCre
 		return
 	}
 	if info.ErrCode != 0 {
-		return info, xerror.NewSDKErr(info.ErrCode, info.ErrMsg)
+		return info, xerror.NewAPIErr("Create", info.ErrCode, info.ErrMsg)
 	}
 	return info, nil
 }
@@ -70,7 +70,7 @@ func (r *Department) Update(options ReqDepartmentUpdate) (info util.CommonError,
 	if err != nil {
 		return
 	}
-	data, err = util.PostJSON(r.ctx.GetQYAPIDomain()+fmt.Sprintf(departmentUpdateAddr, accessToken), options)
+	data, err = r.ctx.PostJSON(r.ctx.GetQYAPIDomain()+fmt.Sprintf(departmentUpdateAddr, accessToken), options)
 	if err != nil {
 		return
 	}
@@ -78,7 +78,7 @@ func (r *Department) Update(options ReqDepartmentUpdate) (info util.CommonError,
 		return
 	}
 	if info.ErrCode != 0 {
-		return info, xerror.NewSDKErr(info.ErrCode, info.ErrMsg)
+		return info, xerror.NewAPIErr("Update", info.ErrCode, info.ErrMsg)
 	}
 	return info, nil
 }
@@ -94,7 +94,7 @@ func (r *Department) Delete(id int) (info util.CommonError, err error) {
 	if err != nil {
 		return
 	}
-	data, err = util.HTTPGet(r.ctx.GetQYAPIDomain() + fmt.Sprintf(departmentDeleteAddr, accessToken, id))
+	data, err = r.ctx.HTTPGet(r.ctx.GetQYAPIDomain() + fmt.Sprintf(departmentDeleteAddr, accessToken, id))
 	if err != nil {
 		return
 	}
@@ -102,7 +102,7 @@ func (r *Department) Delete(id int) (info util.CommonError, err error) {
 		return
 	}
 	if info.ErrCode != 0 {
-		return info, xerror.NewSDKErr(info.ErrCode, info.ErrMsg)
+		return info, xerror.NewAPIErr("Delete", info.ErrCode, info.ErrMsg)
 	}
 	return info, nil
 }
@@ -118,7 +118,7 @@ func (r *Department) List(id int) (info RespDepartmentList, err error) {
 	if err != nil {
 		return
 	}
-	data, err = util.HTTPGet(r.ctx.GetQYAPIDomain() + fmt.Sprintf(departmentListAddr, accessToken, id))
+	data, err = r.ctx.HTTPGet(r.ctx.GetQYAPIDomain() + fmt.Sprintf(departmentListAddr, accessToken, id))
 	if err != nil {
 		return
 	}
@@ -126,7 +126,7 @@ func (r *Department) List(id int) (info RespDepartmentList, err error) {
 		return
 	}
 	if info.ErrCode != 0 {
-		return info, xerror.NewSDKErr(info.ErrCode, info.ErrMsg)
+		return info, xerror.NewAPIErr("List", info.ErrCode, info.ErrMsg)
 	}
 	return info, nil
 }
@@ -142,7 +142,7 @@ func (r *Department) Get(id int) (info RespDepartmentGet, err error) {
 	if err != nil {
 		return
 	}
-	data, err = util.HTTPGet(r.ctx.GetQYAPIDomain() + fmt.Sprintf(departmentGetAddr, accessToken, id))
+	data, err = r.ctx.HTTPGet(r.ctx.GetQYAPIDomain() + fmt.Sprintf(departmentGetAddr, accessToken, id))
 	if err != nil {
 		return
 	}
@@ -150,7 +150,7 @@ func (r *Department) Get(id int) (info RespDepartmentGet, err error) {
 		return
 	}
 	if info.ErrCode != 0 {
-		return info, xerror.NewSDKErr(info.ErrCode, info.ErrMsg)
+		return info, xerror.NewAPIErr("Get", info.ErrCode, info.ErrMsg)
 	}
 	return info, nil
 }
@@ -166,7 +166,7 @@ func (r *Department) AsyncBatchReplaceDepartment(options BatchAsyncReplacePartyR
 	if err != nil {
 		return
 	}
-	data, err = util.PostJSON(r.ctx.GetQYAPIDomain()+fmt.Sprintf(departmentAsyncReplacePartyListAddr, accessToken), options)
+	data, err = r.ctx.PostJSON(r.ctx.GetQYAPIDomain()+fmt.Sprintf(departmentAsyncReplacePartyListAddr, accessToken), options)
 	if err != nil {
 		return
 	}
@@ -174,7 +174,7 @@ func (r *Department) AsyncBatchReplaceDepartment(options BatchAsyncReplacePartyR
 		return
 	}
 	if info.ErrCode != 0 {
-		return info, xerror.NewSDKErr(info.ErrCode, info.ErrMsg)
+		return info, xerror.NewAPIErr("AsyncBatchReplaceDepartment", info.ErrCode, info.ErrMsg)
 	}
 	return info, nil
 }
@@ -190,7 +190,7 @@ func (r *Department) BatchGetAsyncJobResult(jobId string) (info BatchGetAsyncJob
 	if err != nil {
 		return
 	}
-	data, err = util.HTTPGet(r.ctx.GetQYAPIDomain() + fmt.Sprintf(getAsyncJobResultAddr, accessToken, jobId))
+	data, err = r.ctx.HTTPGet(r.ctx.GetQYAPIDomain() + fmt.Sprintf(getAsyncJobResultAddr, accessToken, jobId))
 	if err != nil {
 		return
 	}
@@ -198,7 +198,7 @@ func (r *Department) BatchGetAsyncJobResult(jobId string) (info BatchGetAsyncJob
 		return
 	}
 	if info.ErrCode != 0 {
-		return info, xerror.NewSDKErr(info.ErrCode, info.ErrMsg)
+		return info, xerror.NewAPIErr("BatchGetAsyncJobResult", info.ErrCode, info.ErrMsg)
 	}
 	return info, nil
 }
@@ -218,7 +218,7 @@ func (r *Department) AsyncExportDepartment(options ReqAsyncExportDepartment) (in
 	if err != nil {
 		return
 	}
-	data, err = util.PostJSON(r.ctx.GetQYAPIDomain()+fmt.Sprintf(departmentAsyncExportAddr, accessToken), options)
+	data, err = r.ctx.PostJSON(r.ctx.GetQYAPIDomain()+fmt.Sprintf(departmentAsyncExportAddr, accessToken), options)
 	if err != nil {
 		return
 	}
@@ -226,7 +226,7 @@ func (r *Department) AsyncExportDepartment(options ReqAsyncExportDepartment) (in
 		return
 	}
 	if info.ErrCode != 0 {
-		return info, xerror.NewSDKErr(info.ErrCode, info.ErrMsg)
+		return info, xerror.NewAPIErr("AsyncExportDepartment", info.ErrCode, info.ErrMsg)
 	}
 	return info, nil
 }
@@ -242,7 +242,7 @@ func (r *Department) GetAsyncExportJobResult(jobId string) (info GetAsyncExportJ
 	if err != nil {
 		return
 	}
-	data, err = util.HTTPGet(r.ctx.GetQYAPIDomain() + fmt.Sprintf(getAsyncExportJobResultAddr, accessToken, jobId))
+	data, err = r.ctx.HTTPGet(r.ctx.GetQYAPIDomain() + fmt.Sprintf(getAsyncExportJobResultAddr, accessToken, jobId))
 	if err != nil {
 		return
 	}
@@ -250,7 +250,7 @@ func (r *Department) GetAsyncExportJobResult(jobId string) (info GetAsyncExportJ
 		return
 	}
 	if info.ErrCode != 0 {
-		return info, xerror.NewSDKErr(info.ErrCode, info.ErrMsg)
+		return info, xerror.NewAPIErr("GetAsyncExportJobResult", info.ErrCode, info.ErrMsg)
 	}
 	return info, nil
 }
@@ -270,7 +270,7 @@ func (r *Department) GetDepartmentSimpleList(depId int) (info GetDepartmentSimpl
 	if depId > 0 {
 		endPoint += fmt.Sprintf("&id=%d", depId)
 	}
-	data, err = util.HTTPGet(r.ctx.GetQYAPIDomain() + endPoint)
+	data, err = r.ctx.HTTPGet(r.ctx.GetQYAPIDomain() + endPoint)
 	if err != nil {
 		return
 	}
@@ -278,7 +278,7 @@ func (r *Department) GetDepartmentSimpleList(depId int) (info GetDepartmentSimpl
 		return
 	}
 	if info.ErrCode != 0 {
-		return info, xerror.NewSDKErr(info.ErrCode, info.ErrMsg)
+		return info, xerror.NewAPIErr("GetDepartmentSimpleList", info.ErrCode, info.ErrMsg)
 	}
 	return info, nil
 }
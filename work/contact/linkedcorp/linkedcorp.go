@@ -4,7 +4,6 @@ import (
 	"encoding/json"
 	"fmt"
 
-	"github.com/silenceper/wechat/v2/util"
 	"github.com/silenceper/wechat/v2/work/context"
 	"github.com/silenceper/wechat/v2/work/xerror"
 )
@@ -40,7 +39,7 @@ func (r *LinkedCorp) GetPermList() (info AgentGetPermListResp, err error) {
 	if err != nil {
 		return
 	}
-	data, err = util.PostJSON(r.ctx.GetQYAPIDomain()+fmt.Sprintf(linkedCorpAgentAddr, accessToken), nil)
+	data, err = r.ctx.PostJSON(r.ctx.GetQYAPIDomain()+fmt.Sprintf(linkedCorpAgentAddr, accessToken), nil)
 	if err != nil {
 		return
 	}
@@ -48,7 +47,7 @@ func (r *LinkedCorp) GetPermList() (info AgentGetPermListResp, err error) {
 		return
 	}
 	if info.ErrCode != 0 {
-		return info, xerror.NewSDKErr(info.ErrCode, info.ErrMsg)
+		return info, xerror.NewAPIErr("GetPermList", info.ErrCode, info.ErrMsg)
 	}
 	return info, nil
 }
@@ -64,7 +63,7 @@ func (r *LinkedCorp) GetLinkedCorpUserDetail(options GetUserDetailReq) (info Get
 	if err != nil {
 		return
 	}
-	data, err = util.PostJSON(r.ctx.GetQYAPIDomain()+fmt.Sprintf(linkedCorpUserDetailAddr, accessToken), options)
+	data, err = r.ctx.PostJSON(r.ctx.GetQYAPIDomain()+fmt.Sprintf(linkedCorpUserDetailAddr, accessToken), options)
 	if err != nil {
 		return
 	}
@@ -72,7 +71,7 @@ func (r *LinkedCorp) GetLinkedCorpUserDetail(options GetUserDetailReq) (info Get
 		return
 	}
 	if info.ErrCode != 0 {
-		return info, xerror.NewSDKErr(info.ErrCode, info.ErrMsg)
+		return info, xerror.NewAPIErr("GetLinkedCorpUserDetail", info.ErrCode, info.ErrMsg)
 	}
 	return info, nil
 }
@@ -88,7 +87,7 @@ func (r *LinkedCorp) GetLinkedCorpDepartmentUsers(options GetUserListReq) (info
 	if err != nil {
 		return
 	}
-	data, err = util.PostJSON(r.ctx.GetQYAPIDomain()+fmt.Sprintf(linkedCorpUserSimpleListAddr, accessToken), options)
+	data, err = r.ctx.PostJSON(r.ctx.GetQYAPIDomain()+fmt.Sprintf(linkedCorpUserSimpleListAddr, accessToken), options)
 	if err != nil {
 		return
 	}
@@ -96,7 +95,7 @@ func (r *LinkedCorp) GetLinkedCorpDepartmentUsers(options GetUserListReq) (info
 		return
 	}
 	if info.ErrCode != 0 {
-		return info, xerror.NewSDKErr(info.ErrCode, info.ErrMsg)
+		return info, xerror.NewAPIErr("GetLinkedCorpDepartmentUsers", info.ErrCode, info.ErrMsg)
 	}
 	return info, nil
 }
@@ -112,7 +111,7 @@ func (r *LinkedCorp) GetLinkedCorpDepartmentUserDetail(options GetUserListReq) (
 	if err != nil {
 		return
 	}
-	data, err = util.PostJSON(r.ctx.GetQYAPIDomain()+fmt.Sprintf(linkedCorpUserListAddr, accessToken), options)
+	data, err = r.ctx.PostJSON(r.ctx.GetQYAPIDomain()+fmt.Sprintf(linkedCorpUserListAddr, accessToken), options)
 	if err != nil {
 		return
 	}
@@ -120,7 +119,7 @@ func (r *LinkedCorp) GetLinkedCorpDepartmentUserDetail(options GetUserListReq) (
 		return
 	}
 	if info.ErrCode != 0 {
-		return info, xerror.NewSDKErr(info.ErrCode, info.ErrMsg)
+		return info, xerror.NewAPIErr("GetLinkedCorpDepartmentUserDetail", info.ErrCode, info.ErrMsg)
 	}
 	return info, nil
 }
@@ -136,7 +135,7 @@ func (r *LinkedCorp) GetDepartmentList(options GetDepartmentListReq) (info GetDe
 	if err != nil {
 		return
 	}
-	data, err = util.PostJSON(r.ctx.GetQYAPIDomain()+fmt.Sprintf(linkedCorpDepartmentListAddr, accessToken), options)
+	data, err = r.ctx.PostJSON(r.ctx.GetQYAPIDomain()+fmt.Sprintf(linkedCorpDepartmentListAddr, accessToken), options)
 	if err != nil {
 		return
 	}
@@ -144,7 +143,7 @@ func (r *LinkedCorp) GetDepartmentList(options GetDepartmentListReq) (info GetDe
 		return
 	}
 	if info.ErrCode != 0 {
-		return info, xerror.NewSDKErr(info.ErrCode, info.ErrMsg)
+		return info, xerror.NewAPIErr("GetDepartmentList", info.ErrCode, info.ErrMsg)
 	}
 	return info, nil
 }
@@ -58,7 +58,7 @@ func (r *Member) Create(options ReqMemberCreate) (info util.CommonError, err err
 	if err != nil {
 		return
 	}
-	data, err = util.PostJSON(r.ctx.GetQYAPIDomain()+fmt.Sprintf(userCreateAddr, accessToken), options)
+	data, err = r.ctx.PostJSON(r.ctx.GetQYAPIDomain()+fmt.Sprintf(userCreateAddr, accessToken), options)
 	if err != nil {
 		return
 	}
@@ -66,7 +66,7 @@ func (r *Member) Create(options ReqMemberCreate) (info util.CommonError, err err
 		return
 	}
 	if info.ErrCode != 0 {
-		return info, xerror.NewSDKErr(info.ErrCode, info.ErrMsg)
+		return info, xerror.NewAPIErr("Create", info.ErrCode, info.ErrMsg)
 	}
 	return info, nil
 }
@@ -82,7 +82,7 @@ func (r *Member) Read(userId string) (info RespMemberRead, err error) {
 	if err != nil {
 		return
 	}
-	data, err = util.HTTPGet(r.ctx.GetQYAPIDomain() + fmt.Sprintf(userReadAddr, accessToken, userId))
+	data, err = r.ctx.HTTPGet(r.ctx.GetQYAPIDomain() + fmt.Sprintf(userReadAddr, accessToken, userId))
 	if err != nil {
 		return
 	}
@@ -90,7 +90,7 @@ func (r *Member) Read(userId string) (info RespMemberRead, err error) {
 		return
 	}
 	if info.ErrCode != 0 {
-		return info, xerror.NewSDKErr(info.ErrCode, info.ErrMsg)
+		return info, xerror.NewAPIErr("Read", info.ErrCode, info.ErrMsg)
 	}
 	return info, nil
 }
@@ -106,7 +106,7 @@ func (r *Member) Update(options ReqMemberUpdate) (info util.CommonError, err err
 	if err != nil {
 		return
 	}
-	data, err = util.PostJSON(r.ctx.GetQYAPIDomain()+fmt.Sprintf(userUpdateAddr, accessToken), options)
+	data, err = r.ctx.PostJSON(r.ctx.GetQYAPIDomain()+fmt.Sprintf(userUpdateAddr, accessToken), options)
 	if err != nil {
 		return
 	}
@@ -114,7 +114,7 @@ func (r *Member) Update(options ReqMemberUpdate) (info util.CommonError, err err
 		return
 	}
 	if info.ErrCode != 0 {
-		return info, xerror.NewSDKErr(info.ErrCode, info.ErrMsg)
+		return info, xerror.NewAPIErr("Update", info.ErrCode, info.ErrMsg)
 	}
 	return info, nil
 }
@@ -130,7 +130,7 @@ func (r *Member) Delete(userId string) (info RespMemberRead, err error) {
 	if err != nil {
 		return
 	}
-	data, err = util.HTTPGet(r.ctx.GetQYAPIDomain() + fmt.Sprintf(userDeleteAddr, accessToken, userId))
+	data, err = r.ctx.HTTPGet(r.ctx.GetQYAPIDomain() + fmt.Sprintf(userDeleteAddr, accessToken, userId))
 	if err != nil {
 		return
 	}
@@ -138,7 +138,7 @@ func (r *Member) Delete(userId string) (info RespMemberRead, err error) {
 		return
 	}
 	if info.ErrCode != 0 {
-		return info, xerror.NewSDKErr(info.ErrCode, info.ErrMsg)
+		return info, xerror.NewAPIErr("Delete", info.ErrCode, info.ErrMsg)
 	}
 	return info, nil
 }
@@ -158,7 +158,7 @@ func (r *Member) DeleteBatchUserIds(options ReqMemberBatchDelete) (info util.Com
 	if err != nil {
 		return
 	}
-	data, err = util.PostJSON(r.ctx.GetQYAPIDomain()+fmt.Sprintf(userBatchDeleteAddr, accessToken), options)
+	data, err = r.ctx.PostJSON(r.ctx.GetQYAPIDomain()+fmt.Sprintf(userBatchDeleteAddr, accessToken), options)
 	if err != nil {
 		return
 	}
@@ -166,7 +166,7 @@ func (r *Member) DeleteBatchUserIds(options ReqMemberBatchDelete) (info util.Com
 		return
 	}
 	if info.ErrCode != 0 {
-		return info, xerror.NewSDKErr(info.ErrCode, info.ErrMsg)
+		return info, xerror.NewAPIErr("DeleteBatchUserIds", info.ErrCode, info.ErrMsg)
 	}
 	return info, nil
 }
@@ -182,7 +182,7 @@ func (r *Member) GetMemberSimpleList(departmentId, fetchChild string) (info Resp
 	if err != nil {
 		return
 	}
-	data, err = util.HTTPGet(r.ctx.GetQYAPIDomain() + fmt.Sprintf(userSimpleListAddr, accessToken, departmentId, fetchChild))
+	data, err = r.ctx.HTTPGet(r.ctx.GetQYAPIDomain() + fmt.Sprintf(userSimpleListAddr, accessToken, departmentId, fetchChild))
 	if err != nil {
 		return
 	}
@@ -190,7 +190,7 @@ func (r *Member) GetMemberSimpleList(departmentId, fetchChild string) (info Resp
 		return
 	}
 	if info.ErrCode != 0 {
-		return info, xerror.NewSDKErr(info.ErrCode, info.ErrMsg)
+		return info, xerror.NewAPIErr("GetMemberSimpleList", info.ErrCode, info.ErrMsg)
 	}
 	return info, nil
 }
@@ -206,7 +206,7 @@ func (r *Member) GetMemberList(departmentId, fetchChild string) (info RespMember
 	if err != nil {
 		return
 	}
-	data, err = util.HTTPGet(r.ctx.GetQYAPIDomain() + fmt.Sprintf(userListAddr, accessToken, departmentId, fetchChild))
+	data, err = r.ctx.HTTPGet(r.ctx.GetQYAPIDomain() + fmt.Sprintf(userListAddr, accessToken, departmentId, fetchChild))
 	if err != nil {
 		return
 	}
@@ -214,7 +214,7 @@ func (r *Member) GetMemberList(departmentId, fetchChild string) (info RespMember
 		return
 	}
 	if info.ErrCode != 0 {
-		return info, xerror.NewSDKErr(info.ErrCode, info.ErrMsg)
+		return info, xerror.NewAPIErr("GetMemberList", info.ErrCode, info.ErrMsg)
 	}
 	return info, nil
 }
@@ -230,7 +230,7 @@ func (r *Member) ConvertToOpenId(options ReqMemberConvertToOpenId) (info RespMem
 	if err != nil {
 		return
 	}
-	data, err = util.PostJSON(r.ctx.GetQYAPIDomain()+fmt.Sprintf(userConvertToOpenIdAdd, accessToken), options)
+	data, err = r.ctx.PostJSON(r.ctx.GetQYAPIDomain()+fmt.Sprintf(userConvertToOpenIdAdd, accessToken), options)
 	if err != nil {
 		return
 	}
@@ -238,7 +238,7 @@ func (r *Member) ConvertToOpenId(options ReqMemberConvertToOpenId) (info RespMem
 		return
 	}
 	if info.ErrCode != 0 {
-		return info, xerror.NewSDKErr(info.ErrCode, info.ErrMsg)
+		return info, xerror.NewAPIErr("ConvertToOpenId", info.ErrCode, info.ErrMsg)
 	}
 	return info, nil
 }
@@ -254,7 +254,7 @@ func (r *Member) AuthSuccess(userId string) (info RespMemberDepartmentMemberDeta
 	if err != nil {
 		return
 	}
-	data, err = util.HTTPGet(r.ctx.GetQYAPIDomain() + fmt.Sprintf(userAuthSuccessAddr, accessToken, userId))
+	data, err = r.ctx.HTTPGet(r.ctx.GetQYAPIDomain() + fmt.Sprintf(userAuthSuccessAddr, accessToken, userId))
 	if err != nil {
 		return
 	}
@@ -262,7 +262,7 @@ func (r *Member) AuthSuccess(userId string) (info RespMemberDepartmentMemberDeta
 		return
 	}
 	if info.ErrCode != 0 {
-		return info, xerror.NewSDKErr(info.ErrCode, info.ErrMsg)
+		return info, xerror.NewAPIErr("AuthSuccess", info.ErrCode, info.ErrMsg)
 	}
 	return info, nil
 }
@@ -286,7 +286,7 @@ func (r *Member) BatchInvite(options ReqMemberBatchInvite) (info RespMemberBatch
 	if err != nil {
 		return
 	}
-	data, err = util.PostJSON(r.ctx.GetQYAPIDomain()+fmt.Sprintf(userBatchInviteAddr, accessToken), options)
+	data, err = r.ctx.PostJSON(r.ctx.GetQYAPIDomain()+fmt.Sprintf(userBatchInviteAddr, accessToken), options)
 	if err != nil {
 		return
 	}
@@ -294,7 +294,7 @@ func (r *Member) BatchInvite(options ReqMemberBatchInvite) (info RespMemberBatch
 		return
 	}
 	if info.ErrCode != 0 {
-		return info, xerror.NewSDKErr(info.ErrCode, info.ErrMsg)
+		return info, xerror.NewAPIErr("BatchInvite", info.ErrCode, info.ErrMsg)
 	}
 	return info, nil
 }
@@ -310,7 +310,7 @@ func (r *Member) GetJoinQRCode(sizeType int) (info RespMemberGetJoinQRCode, err
 	if err != nil {
 		return
 	}
-	data, err = util.HTTPGet(r.ctx.GetQYAPIDomain() + fmt.Sprintf(userGetCorpJoinQRCodeAddr, accessToken, sizeType))
+	data, err = r.ctx.HTTPGet(r.ctx.GetQYAPIDomain() + fmt.Sprintf(userGetCorpJoinQRCodeAddr, accessToken, sizeType))
 	if err != nil {
 		return
 	}
@@ -318,7 +318,7 @@ func (r *Member) GetJoinQRCode(sizeType int) (info RespMemberGetJoinQRCode, err
 		return
 	}
 	if info.ErrCode != 0 {
-		return info, xerror.NewSDKErr(info.ErrCode, info.ErrMsg)
+		return info, xerror.NewAPIErr("GetJoinQRCode", info.ErrCode, info.ErrMsg)
 	}
 	return info, nil
 }
@@ -334,7 +334,7 @@ func (r *Member) GetActiveStat(options ReqMemberGetActiveStat) (info RespMemberG
 	if err != nil {
 		return
 	}
-	data, err = util.PostJSON(r.ctx.GetQYAPIDomain()+fmt.Sprintf(userGetActiveStatAddr, accessToken), options)
+	data, err = r.ctx.PostJSON(r.ctx.GetQYAPIDomain()+fmt.Sprintf(userGetActiveStatAddr, accessToken), options)
 	if err != nil {
 		return
 	}
@@ -342,7 +342,7 @@ func (r *Member) GetActiveStat(options ReqMemberGetActiveStat) (info RespMemberG
 		return
 	}
 	if info.ErrCode != 0 {
-		return info, xerror.NewSDKErr(info.ErrCode, info.ErrMsg)
+		return info, xerror.NewAPIErr("GetActiveStat", info.ErrCode, info.ErrMsg)
 	}
 	return info, nil
 }
@@ -358,7 +358,7 @@ func (r *Member) AsyncBatchSyncUpdateUser(options AsyncBatchSyncUserReq) (info A
 	if err != nil {
 		return
 	}
-	data, err = util.PostJSON(r.ctx.GetQYAPIDomain()+fmt.Sprintf(userAsyncBatchSyncUpdateUserAddr, accessToken), options)
+	data, err = r.ctx.PostJSON(r.ctx.GetQYAPIDomain()+fmt.Sprintf(userAsyncBatchSyncUpdateUserAddr, accessToken), options)
 	if err != nil {
 		return
 	}
@@ -366,7 +366,7 @@ func (r *Member) AsyncBatchSyncUpdateUser(options AsyncBatchSyncUserReq) (info A
 		return
 	}
 	if info.ErrCode != 0 {
-		return info, xerror.NewSDKErr(info.ErrCode, info.ErrMsg)
+		return info, xerror.NewAPIErr("AsyncBatchSyncUpdateUser", info.ErrCode, info.ErrMsg)
 	}
 	return info, nil
 }
@@ -382,7 +382,7 @@ func (r *Member) AsyncBatchSyncReplaceUser(options AsyncBatchSyncUserReq) (info
 	if err != nil {
 		return
 	}
-	data, err = util.PostJSON(r.ctx.GetQYAPIDomain()+fmt.Sprintf(userAsyncBatchSyncReplaceUserAddr, accessToken), options)
+	data, err = r.ctx.PostJSON(r.ctx.GetQYAPIDomain()+fmt.Sprintf(userAsyncBatchSyncReplaceUserAddr, accessToken), options)
 	if err != nil {
 		return
 	}
@@ -390,7 +390,7 @@ func (r *Member) AsyncBatchSyncReplaceUser(options AsyncBatchSyncUserReq) (info
 		return
 	}
 	if info.ErrCode != 0 {
-		return info, xerror.NewSDKErr(info.ErrCode, info.ErrMsg)
+		return info, xerror.NewAPIErr("AsyncBatchSyncReplaceUser", info.ErrCode, info.ErrMsg)
 	}
 	return info, nil
 }
@@ -406,7 +406,7 @@ func (r *Member) BatchGetAsyncJobResult(jobId string) (info BatchGetAsyncJobResu
 	if err != nil {
 		return
 	}
-	data, err = util.HTTPGet(r.ctx.GetQYAPIDomain() + fmt.Sprintf(getAsyncJobResultAddr, accessToken, jobId))
+	data, err = r.ctx.HTTPGet(r.ctx.GetQYAPIDomain() + fmt.Sprintf(getAsyncJobResultAddr, accessToken, jobId))
 	if err != nil {
 		return
 	}
@@ -414,7 +414,7 @@ func (r *Member) BatchGetAsyncJobResult(jobId string) (info BatchGetAsyncJobResu
 		return
 	}
 	if info.ErrCode != 0 {
-		return info, xerror.NewSDKErr(info.ErrCode, info.ErrMsg)
+		return info, xerror.NewAPIErr("BatchGetAsyncJobResult", info.ErrCode, info.ErrMsg)
 	}
 	return info, nil
 }
@@ -434,7 +434,7 @@ func (r *Member) AsyncExportSimpleUser(options ReqAsyncExportUser) (info AsyncJo
 	if err != nil {
 		return
 	}
-	data, err = util.PostJSON(r.ctx.GetQYAPIDomain()+fmt.Sprintf(userAsyncExportSimpleUserAddr, accessToken), options)
+	data, err = r.ctx.PostJSON(r.ctx.GetQYAPIDomain()+fmt.Sprintf(userAsyncExportSimpleUserAddr, accessToken), options)
 	if err != nil {
 		return
 	}
@@ -442,7 +442,7 @@ func (r *Member) AsyncExportSimpleUser(options ReqAsyncExportUser) (info AsyncJo
 		return
 	}
 	if info.ErrCode != 0 {
-		return info, xerror.NewSDKErr(info.ErrCode, info.ErrMsg)
+		return info, xerror.NewAPIErr("AsyncExportSimpleUser", info.ErrCode, info.ErrMsg)
 	}
 	return info, nil
 }
@@ -462,7 +462,7 @@ func (r *Member) AsyncExportUser(options ReqAsyncExportUser) (info AsyncJobResp,
 	if err != nil {
 		return
 	}
-	data, err = util.PostJSON(r.ctx.GetQYAPIDomain()+fmt.Sprintf(userAsyncExportUserAddr, accessToken), options)
+	data, err = r.ctx.PostJSON(r.ctx.GetQYAPIDomain()+fmt.Sprintf(userAsyncExportUserAddr, accessToken), options)
 	if err != nil {
 		return
 	}
@@ -470,7 +470,7 @@ func (r *Member) AsyncExportUser(options ReqAsyncExportUser) (info AsyncJobResp,
 		return
 	}
 	if info.ErrCode != 0 {
-		return info, xerror.NewSDKErr(info.ErrCode, info.ErrMsg)
+		return info, xerror.NewAPIErr("AsyncExportUser", info.ErrCode, info.ErrMsg)
 	}
 	return info, nil
 }
@@ -490,7 +490,7 @@ func (r *Member) AsyncExportTagUsers(options ReqAsyncExportUser) (info AsyncJobR
 	if err != nil {
 		return
 	}
-	data, err = util.PostJSON(r.ctx.GetQYAPIDomain()+fmt.Sprintf(userAsyncExportTagUsersAddr, accessToken), options)
+	data, err = r.ctx.PostJSON(r.ctx.GetQYAPIDomain()+fmt.Sprintf(userAsyncExportTagUsersAddr, accessToken), options)
 	if err != nil {
 		return
 	}
@@ -498,7 +498,7 @@ func (r *Member) AsyncExportTagUsers(options ReqAsyncExportUser) (info AsyncJobR
 		return
 	}
 	if info.ErrCode != 0 {
-		return info, xerror.NewSDKErr(info.ErrCode, info.ErrMsg)
+		return info, xerror.NewAPIErr("AsyncExportTagUsers", info.ErrCode, info.ErrMsg)
 	}
 	return info, nil
 }
@@ -514,7 +514,7 @@ func (r *Member) GetAsyncExportJobResult(jobId string) (info GetAsyncExportJobRe
 	if err != nil {
 		return
 	}
-	data, err = util.HTTPGet(r.ctx.GetQYAPIDomain() + fmt.Sprintf(getAsyncExportJobResultAddr, accessToken, jobId))
+	data, err = r.ctx.HTTPGet(r.ctx.GetQYAPIDomain() + fmt.Sprintf(getAsyncExportJobResultAddr, accessToken, jobId))
 	if err != nil {
 		return
 	}
@@ -522,7 +522,7 @@ func (r *Member) GetAsyncExportJobResult(jobId string) (info GetAsyncExportJobRe
 		return
 	}
 	if info.ErrCode != 0 {
-		return info, xerror.NewSDKErr(info.ErrCode, info.ErrMsg)
+		return info, xerror.NewAPIErr("GetAsyncExportJobResult", info.ErrCode, info.ErrMsg)
 	}
 	return info, nil
 }
@@ -542,7 +542,7 @@ func (r *Member) GetUserIds(options GetUserIdsReq) (info GetUserIdsResp, err err
 	if err != nil {
 		return
 	}
-	data, err = util.PostJSON(r.ctx.GetQYAPIDomain()+fmt.Sprintf(userGetListIdAddr, accessToken), options)
+	data, err = r.ctx.PostJSON(r.ctx.GetQYAPIDomain()+fmt.Sprintf(userGetListIdAddr, accessToken), options)
 	if err != nil {
 		return
 	}
@@ -550,7 +550,7 @@ func (r *Member) GetUserIds(options GetUserIdsReq) (info GetUserIdsResp, err err
 		return
 	}
 	if info.ErrCode != 0 {
-		return info, xerror.NewSDKErr(info.ErrCode, info.ErrMsg)
+		return info, xerror.NewAPIErr("GetUserIds", info.ErrCode, info.ErrMsg)
 	}
 	return info, nil
 }
@@ -566,7 +566,7 @@ func (r *Member) GetUserIdByPhone(options GetUserIdByPhoneReq) (info GetUserIdBy
 	if err != nil {
 		return
 	}
-	data, err = util.PostJSON(r.ctx.GetQYAPIDomain()+fmt.Sprintf(getUserIdByPhoneAddr, accessToken), options)
+	data, err = r.ctx.PostJSON(r.ctx.GetQYAPIDomain()+fmt.Sprintf(getUserIdByPhoneAddr, accessToken), options)
 	if err != nil {
 		return
 	}
@@ -574,7 +574,7 @@ func (r *Member) GetUserIdByPhone(options GetUserIdByPhoneReq) (info GetUserIdBy
 		return
 	}
 	if info.ErrCode != 0 {
-		return info, xerror.NewSDKErr(info.ErrCode, info.ErrMsg)
+		return info, xerror.NewAPIErr("GetUserIdByPhone", info.ErrCode, info.ErrMsg)
 	}
 	return info, nil
 }
@@ -590,7 +590,7 @@ func (r *Member) GetUserIdByEmail(options GetUserIdByEmailReq) (info GetUserIdBy
 	if err != nil {
 		return
 	}
-	data, err = util.PostJSON(r.ctx.GetQYAPIDomain()+fmt.Sprintf(getUserIdByEmailAddr, accessToken), options)
+	data, err = r.ctx.PostJSON(r.ctx.GetQYAPIDomain()+fmt.Sprintf(getUserIdByEmailAddr, accessToken), options)
 	if err != nil {
 		return
 	}
@@ -598,7 +598,7 @@ func (r *Member) GetUserIdByEmail(options GetUserIdByEmailReq) (info GetUserIdBy
 		return
 	}
 	if info.ErrCode != 0 {
-		return info, xerror.NewSDKErr(info.ErrCode, info.ErrMsg)
+		return info, xerror.NewAPIErr("GetUserIdByEmail", info.ErrCode, info.ErrMsg)
 	}
 	return info, nil
 }
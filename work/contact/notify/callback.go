@@ -1,6 +1,7 @@
 package notify
 
 import (
+	"context"
 	"encoding/xml"
 
 	"github.com/silenceper/wechat/v2/util"
@@ -22,6 +23,9 @@ func (r *Notify) VerifyURL(options SignatureOptions) (string, error) {
 	if options.Signature != util.Signature(r.ctx.Token, options.TimeStamp, options.Nonce, options.EchoStr) {
 		return "", xerror.NewSDKErr(40015)
 	}
+	if err := r.checkReplay(options); err != nil {
+		return "", err
+	}
 	_, bData, err := util.DecryptMsg(r.ctx.CorpID, options.EchoStr, r.ctx.EncodingAESKey)
 	if err != nil {
 		return "", xerror.NewSDKErr(40016)
@@ -59,3 +63,26 @@ func (r *Notify) GetCallbackMsg(encryptedRawMsg []byte) (plainTxtByte []byte, ms
 	}
 	return
 }
+
+// VerifyCallbackMsg 校验回调请求的签名（及防重放）并返回解密后的消息内容，options 为回调 url 中的参数
+func (r *Notify) VerifyCallbackMsg(options SignatureOptions, encryptedRawMsg []byte) (plainTxtByte []byte, msg MixedMsg, err error) {
+	var origin callbackOriginMessage
+	if err = xml.Unmarshal(encryptedRawMsg, &origin); err != nil {
+		return
+	}
+	if options.Signature != util.Signature(r.ctx.Token, options.TimeStamp, options.Nonce, origin.Encrypt) {
+		err = xerror.NewSDKErr(40015)
+		return
+	}
+	if err = r.checkReplay(options); err != nil {
+		return
+	}
+	return r.GetCallbackMsg(encryptedRawMsg)
+}
+
+func (r *Notify) checkReplay(options SignatureOptions) error {
+	if r.replayGuard == nil {
+		return nil
+	}
+	return r.replayGuard.Check(context.Background(), options.TimeStamp, options.Nonce)
+}
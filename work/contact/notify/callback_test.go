@@ -0,0 +1,100 @@
+package notify
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/silenceper/wechat/v2/cache"
+	"github.com/silenceper/wechat/v2/util"
+	"github.com/silenceper/wechat/v2/work/config"
+	"github.com/silenceper/wechat/v2/work/context"
+)
+
+const (
+	testCorpID = "corpid"
+	testToken  = "token"
+	testAESKey = "abcdefghijklmnopqrstuvwxyz0123456789ABCDEFG"
+)
+
+func newTestNotify() *Notify {
+	return NewNotify(&context.Context{Config: &config.Config{CorpID: testCorpID, Token: testToken, EncodingAESKey: testAESKey}})
+}
+
+// newTestOptions 加密 plain 并构造带签名的回调参数，返回参数及密文
+func newTestOptions(t *testing.T, plain, timestamp, nonce string) (SignatureOptions, string) {
+	encrypted, err := util.EncryptMsg([]byte("0123456789abcdef"), []byte(plain), testCorpID, testAESKey)
+	assert.NoError(t, err)
+	return SignatureOptions{
+		Signature: util.Signature(testToken, timestamp, nonce, string(encrypted)),
+		TimeStamp: timestamp,
+		Nonce:     nonce,
+		EchoStr:   string(encrypted),
+	}, string(encrypted)
+}
+
+func TestVerifyURL(t *testing.T) {
+	n := newTestNotify()
+	memory := cache.NewMemory()
+	defer memory.Close()
+	n.SetReplayGuard(util.NewReplayGuard(memory, "notify_", time.Minute))
+	now := strconv.FormatInt(time.Now().Unix(), 10)
+
+	options, _ := newTestOptions(t, "echo", now, "nonce")
+	echo, err := n.VerifyURL(options)
+	assert.NoError(t, err)
+	assert.Equal(t, "echo", echo)
+
+	// nonce 重放
+	_, err = n.VerifyURL(options)
+	assert.ErrorIs(t, err, util.ErrNonceReplayed)
+
+	// 签名不匹配
+	options, _ = newTestOptions(t, "echo", now, "nonce2")
+	options.Signature = "invalid"
+	_, err = n.VerifyURL(options)
+	assert.True(t, errors.Is(err, util.ErrCode(40015)))
+
+	// 时间戳过期
+	options, _ = newTestOptions(t, "echo", "1700000000", "nonce3")
+	_, err = n.VerifyURL(options)
+	assert.ErrorIs(t, err, util.ErrTimestampExpired)
+}
+
+func TestVerifyCallbackMsg(t *testing.T) {
+	n := newTestNotify()
+	memory := cache.NewMemory()
+	defer memory.Close()
+	n.SetReplayGuard(util.NewReplayGuard(memory, "notify_", time.Minute))
+	now := strconv.FormatInt(time.Now().Unix(), 10)
+
+	plain := `<xml><ToUserName>toUser</ToUserName><FromUserName>sys</FromUserName><CreateTime>1700000000</CreateTime><MsgType>event</MsgType><Event>change_contact</Event><ChangeType>create_user</ChangeType><UserID>zhangsan</UserID></xml>`
+	body := func(encrypted string) []byte {
+		return []byte(`<xml><ToUserName>` + testCorpID + `</ToUserName><AgentID>1</AgentID><Encrypt>` + encrypted + `</Encrypt></xml>`)
+	}
+
+	options, encrypted := newTestOptions(t, plain, now, "nonce")
+	raw, msg, err := n.VerifyCallbackMsg(options, body(encrypted))
+	assert.NoError(t, err)
+	assert.Equal(t, plain, string(raw))
+	assert.Equal(t, EventType("change_contact"), msg.EventType)
+	assert.Equal(t, ChangeType("create_user"), msg.ChangeType)
+
+	// nonce 重放
+	_, _, err = n.VerifyCallbackMsg(options, body(encrypted))
+	assert.ErrorIs(t, err, util.ErrNonceReplayed)
+
+	// 签名不匹配
+	options, encrypted = newTestOptions(t, plain, now, "nonce2")
+	options.Signature = "invalid"
+	_, _, err = n.VerifyCallbackMsg(options, body(encrypted))
+	assert.True(t, errors.Is(err, util.ErrCode(40015)))
+
+	// 时间戳过期
+	options, encrypted = newTestOptions(t, plain, "1700000000", "nonce3")
+	_, _, err = n.VerifyCallbackMsg(options, body(encrypted))
+	assert.ErrorIs(t, err, util.ErrTimestampExpired)
+}
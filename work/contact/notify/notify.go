@@ -1,12 +1,14 @@
 package notify
 
 import (
+	"github.com/silenceper/wechat/v2/util"
 	"github.com/silenceper/wechat/v2/work/context"
 )
 
 // Notify 微信客服实例
 type Notify struct {
-	ctx *context.Context
+	ctx         *context.Context
+	replayGuard *util.ReplayGuard
 }
 
 // NewNotify 初始化通讯录回调实例
@@ -16,3 +18,8 @@ func NewNotify(ctx *context.Context) (client *Notify) {
 	}
 	return client
 }
+
+// SetReplayGuard 设置防重放校验，签名校验通过后检查时间戳及 nonce，不通过时返回 util.ErrTimestampExpired 或 util.ErrNonceReplayed
+func (r *Notify) SetReplayGuard(guard *util.ReplayGuard) {
+	r.replayGuard = guard
+}
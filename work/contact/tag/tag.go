@@ -42,7 +42,7 @@ func (r *Tag) Create(options ObjTag) (info RespTag, err error) {
 	if err != nil {
 		return
 	}
-	data, err = util.PostJSON(r.ctx.GetQYAPIDomain()+fmt.Sprintf(tagCreateAddr, accessToken), options)
+	data, err = r.ctx.PostJSON(r.ctx.GetQYAPIDomain()+fmt.Sprintf(tagCreateAddr, accessToken), options)
 	if err != nil {
 		return
 	}
@@ -50,7 +50,7 @@ func (r *Tag) Create(options ObjTag) (info RespTag, err error) {
 		return
 	}
 	if info.ErrCode != 0 {
-		return info, xerror.NewSDKErr(info.ErrCode, info.ErrMsg)
+		return info, xerror.NewAPIErr("Create", info.ErrCode, info.ErrMsg)
 	}
 	return info, nil
 }
@@ -66,7 +66,7 @@ func (r *Tag) Update(options ObjTag) (info util.CommonError, err error) {
 	if err != nil {
 		return
 	}
-	data, err = util.PostJSON(r.ctx.GetQYAPIDomain()+fmt.Sprintf(tagUpdateAddr, accessToken), options)
+	data, err = r.ctx.PostJSON(r.ctx.GetQYAPIDomain()+fmt.Sprintf(tagUpdateAddr, accessToken), options)
 	if err != nil {
 		return
 	}
@@ -74,7 +74,7 @@ func (r *Tag) Update(options ObjTag) (info util.CommonError, err error) {
 		return
 	}
 	if info.ErrCode != 0 {
-		return info, xerror.NewSDKErr(info.ErrCode, info.ErrMsg)
+		return info, xerror.NewAPIErr("Update", info.ErrCode, info.ErrMsg)
 	}
 	return info, nil
 }
@@ -90,7 +90,7 @@ func (r *Tag) Delete(id int) (info util.CommonError, err error) {
 	if err != nil {
 		return
 	}
-	data, err = util.HTTPGet(r.ctx.GetQYAPIDomain() + fmt.Sprintf(tagDeleteAddr, accessToken, id))
+	data, err = r.ctx.HTTPGet(r.ctx.GetQYAPIDomain() + fmt.Sprintf(tagDeleteAddr, accessToken, id))
 	if err != nil {
 		return
 	}
@@ -98,7 +98,7 @@ func (r *Tag) Delete(id int) (info util.CommonError, err error) {
 		return
 	}
 	if info.ErrCode != 0 {
-		return info, xerror.NewSDKErr(info.ErrCode, info.ErrMsg)
+		return info, xerror.NewAPIErr("Delete", info.ErrCode, info.ErrMsg)
 	}
 	return info, nil
 }
@@ -114,7 +114,7 @@ func (r *Tag) List(id int) (info RespTagList, err error) {
 	if err != nil {
 		return
 	}
-	data, err = util.HTTPGet(r.ctx.GetQYAPIDomain() + fmt.Sprintf(tagListAddr, accessToken, id))
+	data, err = r.ctx.HTTPGet(r.ctx.GetQYAPIDomain() + fmt.Sprintf(tagListAddr, accessToken, id))
 	if err != nil {
 		return
 	}
@@ -122,7 +122,7 @@ func (r *Tag) List(id int) (info RespTagList, err error) {
 		return
 	}
 	if info.ErrCode != 0 {
-		return info, xerror.NewSDKErr(info.ErrCode, info.ErrMsg)
+		return info, xerror.NewAPIErr("List", info.ErrCode, info.ErrMsg)
 	}
 	return info, nil
 }
@@ -138,7 +138,7 @@ func (r *Tag) GetUsers(id int) (info RespTagGet, err error) {
 	if err != nil {
 		return
 	}
-	data, err = util.HTTPGet(r.ctx.GetQYAPIDomain() + fmt.Sprintf(tagGetAddr, accessToken, id))
+	data, err = r.ctx.HTTPGet(r.ctx.GetQYAPIDomain() + fmt.Sprintf(tagGetAddr, accessToken, id))
 	if err != nil {
 		return
 	}
@@ -146,7 +146,7 @@ func (r *Tag) GetUsers(id int) (info RespTagGet, err error) {
 		return
 	}
 	if info.ErrCode != 0 {
-		return info, xerror.NewSDKErr(info.ErrCode, info.ErrMsg)
+		return info, xerror.NewAPIErr("GetUsers", info.ErrCode, info.ErrMsg)
 	}
 	return info, nil
 }
@@ -162,7 +162,7 @@ func (r *Tag) AddUsers(options ReqTagUser) (info RespTagUser, err error) {
 	if err != nil {
 		return
 	}
-	data, err = util.PostJSON(r.ctx.GetQYAPIDomain()+fmt.Sprintf(tagAddUserAddr, accessToken), options)
+	data, err = r.ctx.PostJSON(r.ctx.GetQYAPIDomain()+fmt.Sprintf(tagAddUserAddr, accessToken), options)
 	if err != nil {
 		return
 	}
@@ -170,7 +170,7 @@ func (r *Tag) AddUsers(options ReqTagUser) (info RespTagUser, err error) {
 		return
 	}
 	if info.ErrCode != 0 {
-		return info, xerror.NewSDKErr(info.ErrCode, info.ErrMsg)
+		return info, xerror.NewAPIErr("AddUsers", info.ErrCode, info.ErrMsg)
 	}
 	return info, nil
 }
@@ -186,7 +186,7 @@ func (r *Tag) DeleteUsers(options ReqTagUser) (info RespTagUser, err error) {
 	if err != nil {
 		return
 	}
-	data, err = util.PostJSON(r.ctx.GetQYAPIDomain()+fmt.Sprintf(tagDeleteUserAddr, accessToken), options)
+	data, err = r.ctx.PostJSON(r.ctx.GetQYAPIDomain()+fmt.Sprintf(tagDeleteUserAddr, accessToken), options)
 	if err != nil {
 		return
 	}
@@ -194,7 +194,7 @@ func (r *Tag) DeleteUsers(options ReqTagUser) (info RespTagUser, err error) {
 		return
 	}
 	if info.ErrCode != 0 {
-		return info, xerror.NewSDKErr(info.ErrCode, info.ErrMsg)
+		return info, xerror.NewAPIErr("DeleteUsers", info.ErrCode, info.ErrMsg)
 	}
 	return info, nil
 }
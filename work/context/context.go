@@ -1,6 +1,8 @@
 package context
 
 import (
+	"context"
+
 	"github.com/silenceper/wechat/v2/credential"
 	"github.com/silenceper/wechat/v2/work/config"
 )
@@ -11,6 +13,19 @@ type Context struct {
 	credential.AccessTokenHandle
 }
 
+// GetAccessToken 获取access_token
+func (ctx *Context) GetAccessToken() (string, error) {
+	return ctx.GetAccessTokenContext(context.Background())
+}
+
+// GetAccessTokenContext 获取access_token，AccessTokenHandle 支持 context 时会使用配置的 http client 请求
+func (ctx *Context) GetAccessTokenContext(c context.Context) (string, error) {
+	if handle, ok := ctx.AccessTokenHandle.(credential.AccessTokenContextHandle); ok {
+		return handle.GetAccessTokenContext(ctx.withHTTPClient(c))
+	}
+	return ctx.AccessTokenHandle.GetAccessToken()
+}
+
 func (ctx *Context) SetQYAPIDomain(domain string) {
 	ctx.QYAPIDomain = domain
 }
@@ -56,14 +56,14 @@ func (auth *Auth) TransferSessionContext(ctx context2.Context, userId, sessionKe
 		UserId:     userId,
 		SessionKey: sessionKey,
 	}
-	if response, err = util.PostJSONContext(ctx, auth.GetQYAPIDomain()+fmt.Sprintf(transferSessionURL, accessToken), req); err != nil {
+	if response, err = auth.PostJSONContext(ctx, auth.GetQYAPIDomain()+fmt.Sprintf(transferSessionURL, accessToken), req); err != nil {
 		return
 	}
 	if err = json.Unmarshal(response, &result); err != nil {
 		return
 	}
 	if result.ErrCode != 0 {
-		err = xerror.NewSDKErr(result.ErrCode, result.ErrMsg)
+		err = xerror.NewAPIErr("TransferSession", result.ErrCode, result.ErrMsg)
 		return
 	}
 	return
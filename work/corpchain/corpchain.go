@@ -47,7 +47,7 @@ func (r *CorpChain) ListAppShareInfo(req *ReqListAppShareInfo) (resp RespListApp
 	if err != nil {
 		return
 	}
-	body, err = util.PostJSON(r.ctx.QYAPIDomain+fmt.Sprintf(listAppShareInfoURL, parentAccessToken), req)
+	body, err = r.ctx.PostJSON(r.ctx.QYAPIDomain+fmt.Sprintf(listAppShareInfoURL, parentAccessToken), req)
 	if err != nil {
 		return
 	}
@@ -67,7 +67,7 @@ func (r *CorpChain) GetChainList() (resp RespGetChainList, err error) {
 	if err != nil {
 		return
 	}
-	body, err = util.HTTPGet(r.ctx.QYAPIDomain + fmt.Sprintf(getChainListURL, parentAccessToken))
+	body, err = r.ctx.HTTPGet(r.ctx.QYAPIDomain + fmt.Sprintf(getChainListURL, parentAccessToken))
 	if err != nil {
 		return
 	}
@@ -93,7 +93,7 @@ func (r *CorpChain) UnionidToExternalUserId(unionId, openId, corpId string) (res
 	if len(corpId) > 0 {
 		req.CorpId = corpId
 	}
-	body, err = util.PostJSON(r.ctx.QYAPIDomain+fmt.Sprintf(unionidToExternalUseridURL, token), req)
+	body, err = r.ctx.PostJSON(r.ctx.QYAPIDomain+fmt.Sprintf(unionidToExternalUseridURL, token), req)
 	if err != nil {
 		return
 	}
@@ -113,7 +113,7 @@ func (r *CorpChain) RuleListIds(chainId string) (resp RespRuleListIds, err error
 	req := &ReqRuleListIds{
 		ChainId: chainId,
 	}
-	body, err = util.PostJSON(r.ctx.QYAPIDomain+fmt.Sprintf(ruleListIdsURL, token), req)
+	body, err = r.ctx.PostJSON(r.ctx.QYAPIDomain+fmt.Sprintf(ruleListIdsURL, token), req)
 	if err != nil {
 		return
 	}
@@ -134,7 +134,7 @@ func (r *CorpChain) RuleDeleteRule(chainId string, ruleId int) (resp util.Common
 		ChainId: chainId,
 		RuleId:  ruleId,
 	}
-	body, err = util.PostJSON(r.ctx.QYAPIDomain+fmt.Sprintf(ruleDeleteRuleURL, token), req)
+	body, err = r.ctx.PostJSON(r.ctx.QYAPIDomain+fmt.Sprintf(ruleDeleteRuleURL, token), req)
 	if err != nil {
 		return
 	}
@@ -155,7 +155,7 @@ func (r *CorpChain) RuleGetRuleInfo(chainId string, ruleId int) (resp RespRuleGe
 		ChainId: chainId,
 		RuleId:  ruleId,
 	}
-	body, err = util.PostJSON(r.ctx.QYAPIDomain+fmt.Sprintf(ruleGetRuleInfoURL, token), req)
+	body, err = r.ctx.PostJSON(r.ctx.QYAPIDomain+fmt.Sprintf(ruleGetRuleInfoURL, token), req)
 	if err != nil {
 		return
 	}
@@ -172,7 +172,7 @@ func (r *CorpChain) RuleAddRule(req *ReqRuleAddRule) (resp RespRuleAddRule, err
 	if err != nil {
 		return
 	}
-	body, err = util.PostJSON(r.ctx.QYAPIDomain+fmt.Sprintf(ruleAddRuleInfoURL, token), req)
+	body, err = r.ctx.PostJSON(r.ctx.QYAPIDomain+fmt.Sprintf(ruleAddRuleInfoURL, token), req)
 	if err != nil {
 		return
 	}
@@ -189,7 +189,7 @@ func (r *CorpChain) RuleModifyRule(req *ReqRuleModifyRule) (resp util.CommonErro
 	if err != nil {
 		return
 	}
-	body, err = util.PostJSON(r.ctx.QYAPIDomain+fmt.Sprintf(ruleModifyRuleInfoURL, token), req)
+	body, err = r.ctx.PostJSON(r.ctx.QYAPIDomain+fmt.Sprintf(ruleModifyRuleInfoURL, token), req)
 	if err != nil {
 		return
 	}
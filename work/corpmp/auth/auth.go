@@ -48,14 +48,14 @@ func (auth *Auth) Code2SessionContext(ctx context2.Context, jsCode string) (resu
 	if err != nil {
 		return
 	}
-	if response, err = util.HTTPGetContext(ctx, auth.GetQYAPIDomain()+fmt.Sprintf(code2SessionURL, accessToken, jsCode)); err != nil {
+	if response, err = auth.HTTPGetContext(ctx, auth.GetQYAPIDomain()+fmt.Sprintf(code2SessionURL, accessToken, jsCode)); err != nil {
 		return
 	}
 	if err = json.Unmarshal(response, &result); err != nil {
 		return
 	}
 	if result.ErrCode != 0 {
-		err = xerror.NewSDKErr(result.ErrCode, result.ErrMsg)
+		err = xerror.NewAPIErr("Code2Session", result.ErrCode, result.ErrMsg)
 		return
 	}
 	return
@@ -98,7 +98,7 @@ func (r *Client) AddContactWay(req *AddContactWayRequest) (*AddContactWayRespons
 		return nil, err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(AddContactWayURL, accessToken), req); err != nil {
+	if response, err = r.PostJSON(fmt.Sprintf(AddContactWayURL, accessToken), req); err != nil {
 		return nil, err
 	}
 	result := &AddContactWayResponse{}
@@ -149,7 +149,7 @@ func (r *Client) GetContactWay(req *GetContactWayRequest) (*GetContactWayRespons
 		return nil, err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(GetContactWayURL, accessToken), req); err != nil {
+	if response, err = r.PostJSON(fmt.Sprintf(GetContactWayURL, accessToken), req); err != nil {
 		return nil, err
 	}
 	result := &GetContactWayResponse{}
@@ -191,7 +191,7 @@ func (r *Client) UpdateContactWay(req *UpdateContactWayRequest) (*UpdateContactW
 		return nil, err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(UpdateContactWayURL, accessToken), req); err != nil {
+	if response, err = r.PostJSON(fmt.Sprintf(UpdateContactWayURL, accessToken), req); err != nil {
 		return nil, err
 	}
 	result := &UpdateContactWayResponse{}
@@ -232,7 +232,7 @@ func (r *Client) ListContactWay(req *ListContactWayRequest) (*ListContactWayResp
 		return nil, err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(ListContactWayURL, accessToken), req); err != nil {
+	if response, err = r.PostJSON(fmt.Sprintf(ListContactWayURL, accessToken), req); err != nil {
 		return nil, err
 	}
 	result := &ListContactWayResponse{}
@@ -264,7 +264,7 @@ func (r *Client) DelContactWay(req *DelContactWayRequest) (*DelContactWayRespons
 		return nil, err
 	}
 	var response []byte
-	if response, err = util.PostJSON(fmt.Sprintf(DelContactWayURL, accessToken), req); err != nil {
+	if response, err = r.PostJSON(fmt.Sprintf(DelContactWayURL, accessToken), req); err != nil {
 		return nil, err
 	}
 	result := &DelContactWayResponse{}
@@ -32,7 +32,7 @@ func (r *Client) GetExternalUserList(userID string) ([]string, error) {
 		return nil, err
 	}
 	var response []byte
-	response, err = util.HTTPGet(fmt.Sprintf("%s?access_token=%v&userid=%v", FetchExternalContactUserListURL, accessToken, userID))
+	response, err = r.HTTPGet(fmt.Sprintf("%s?access_token=%v&userid=%v", FetchExternalContactUserListURL, accessToken, userID))
 	if err != nil {
 		return nil, err
 	}
@@ -102,7 +102,7 @@ func (r *Client) GetExternalUserDetail(externalUserID string, nextCursor ...stri
 		return nil, err
 	}
 	var response []byte
-	response, err = util.HTTPGet(fmt.Sprintf("%s?access_token=%v&external_userid=%v&cursor=%v", FetchExternalContactUserDetailURL, accessToken, externalUserID, nextCursor))
+	response, err = r.HTTPGet(fmt.Sprintf("%s?access_token=%v&external_userid=%v&cursor=%v", FetchExternalContactUserDetailURL, accessToken, externalUserID, nextCursor))
 	if err != nil {
 		return nil, err
 	}
@@ -137,7 +137,7 @@ func (r *Client) BatchGetExternalUserDetails(request BatchGetExternalUserDetails
 	if err != nil {
 		return nil, err
 	}
-	response, err = util.HTTPPost(fmt.Sprintf("%s?access_token=%v", FetchBatchExternalContactUserDetailURL, accessToken), string(jsonData))
+	response, err = r.HTTPPost(fmt.Sprintf("%s?access_token=%v", FetchBatchExternalContactUserDetailURL, accessToken), string(jsonData))
 	if err != nil {
 		return nil, err
 	}
@@ -171,7 +171,7 @@ func (r *Client) UpdateUserRemark(request UpdateUserRemarkRequest) error {
 	if err != nil {
 		return err
 	}
-	response, err = util.HTTPPost(fmt.Sprintf("%s?access_token=%v", UpdateUserRemarkURL, accessToken), string(jsonData))
+	response, err = r.HTTPPost(fmt.Sprintf("%s?access_token=%v", UpdateUserRemarkURL, accessToken), string(jsonData))
 	if err != nil {
 		return err
 	}
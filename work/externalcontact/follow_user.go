@@ -25,7 +25,7 @@ func (r *Client) GetFollowUserList() ([]string, error) {
 		return nil, err
 	}
 	var response []byte
-	response, err = util.HTTPGet(fmt.Sprintf("%s?access_token=%s", FetchFollowUserListURL, accessToken))
+	response, err = r.HTTPGet(fmt.Sprintf("%s?access_token=%s", FetchFollowUserListURL, accessToken))
 	if err != nil {
 		return nil, err
 	}
@@ -41,7 +41,7 @@ func (r *Client) AccountAdd(options AccountAddOptions) (info AccountAddSchema, e
 	if accessToken, err = r.ctx.GetAccessToken(); err != nil {
 		return
 	}
-	data, err = util.PostJSON(r.ctx.GetQYAPIDomain()+fmt.Sprintf(accountAddAddr, accessToken), options)
+	data, err = r.ctx.PostJSON(r.ctx.GetQYAPIDomain()+fmt.Sprintf(accountAddAddr, accessToken), options)
 	if err != nil {
 		return
 	}
@@ -49,7 +49,7 @@ func (r *Client) AccountAdd(options AccountAddOptions) (info AccountAddSchema, e
 		return
 	}
 	if info.ErrCode != 0 {
-		return info, NewSDKErr(info.ErrCode, info.ErrMsg)
+		return info, NewAPIErr("AccountAdd", info.ErrCode, info.ErrMsg)
 	}
 	return info, nil
 }
@@ -68,7 +68,7 @@ func (r *Client) AccountDel(options AccountDelOptions) (info util.CommonError, e
 	if accessToken, err = r.ctx.GetAccessToken(); err != nil {
 		return
 	}
-	data, err = util.PostJSON(r.ctx.GetQYAPIDomain()+fmt.Sprintf(accountDelAddr, accessToken), options)
+	data, err = r.ctx.PostJSON(r.ctx.GetQYAPIDomain()+fmt.Sprintf(accountDelAddr, accessToken), options)
 	if err != nil {
 		return
 	}
@@ -76,7 +76,7 @@ func (r *Client) AccountDel(options AccountDelOptions) (info util.CommonError, e
 		return
 	}
 	if info.ErrCode != 0 {
-		return info, NewSDKErr(info.ErrCode, info.ErrMsg)
+		return info, NewAPIErr("AccountDel", info.ErrCode, info.ErrMsg)
 	}
 	return info, nil
 }
@@ -97,7 +97,7 @@ func (r *Client) AccountUpdate(options AccountUpdateOptions) (info util.CommonEr
 	if accessToken, err = r.ctx.GetAccessToken(); err != nil {
 		return
 	}
-	data, err = util.PostJSON(r.ctx.GetQYAPIDomain()+fmt.Sprintf(accountUpdateAddr, accessToken), options)
+	data, err = r.ctx.PostJSON(r.ctx.GetQYAPIDomain()+fmt.Sprintf(accountUpdateAddr, accessToken), options)
 	if err != nil {
 		return
 	}
@@ -105,7 +105,7 @@ func (r *Client) AccountUpdate(options AccountUpdateOptions) (info util.CommonEr
 		return
 	}
 	if info.ErrCode != 0 {
-		return info, NewSDKErr(info.ErrCode, info.ErrMsg)
+		return info, NewAPIErr("AccountUpdate", info.ErrCode, info.ErrMsg)
 	}
 	return info, nil
 }
@@ -132,7 +132,7 @@ func (r *Client) AccountList() (info AccountListSchema, err error) {
 	if accessToken, err = r.ctx.GetAccessToken(); err != nil {
 		return
 	}
-	data, err = util.HTTPGet(r.ctx.GetQYAPIDomain() + fmt.Sprintf(accountListAddr, accessToken))
+	data, err = r.ctx.HTTPGet(r.ctx.GetQYAPIDomain() + fmt.Sprintf(accountListAddr, accessToken))
 	if err != nil {
 		return
 	}
@@ -140,7 +140,7 @@ func (r *Client) AccountList() (info AccountListSchema, err error) {
 		return
 	}
 	if info.ErrCode != 0 {
-		return info, NewSDKErr(info.ErrCode, info.ErrMsg)
+		return info, NewAPIErr("AccountList", info.ErrCode, info.ErrMsg)
 	}
 	return info, nil
 }
@@ -170,7 +170,7 @@ func (r *Client) AddContactWay(options AddContactWayOptions) (info AddContactWay
 	if accessToken, err = r.ctx.GetAccessToken(); err != nil {
 		return
 	}
-	data, err = util.PostJSON(r.ctx.GetQYAPIDomain()+fmt.Sprintf(addContactWayAddr, accessToken), options)
+	data, err = r.ctx.PostJSON(r.ctx.GetQYAPIDomain()+fmt.Sprintf(addContactWayAddr, accessToken), options)
 	if err != nil {
 		return
 	}
@@ -178,7 +178,7 @@ func (r *Client) AddContactWay(options AddContactWayOptions) (info AddContactWay
 		return
 	}
 	if info.ErrCode != 0 {
-		return info, NewSDKErr(info.ErrCode, info.ErrMsg)
+		return info, NewAPIErr("AddContactWay", info.ErrCode, info.ErrMsg)
 	}
 	return info, nil
 }
@@ -1,6 +1,7 @@
 package kf
 
 import (
+	"context"
 	"encoding/xml"
 
 	"github.com/silenceper/wechat/v2/util"
@@ -35,6 +36,9 @@ func (r *Client) VerifyURL(options SignatureOptions) (string, error) {
 	if options.Signature != util.Signature(r.ctx.Token, options.TimeStamp, options.Nonce, options.EchoStr) {
 		return "", NewSDKErr(40015)
 	}
+	if err := r.checkReplay(options); err != nil {
+		return "", err
+	}
 	_, bData, err := util.DecryptMsg(r.ctx.CorpID, options.EchoStr, r.ctx.EncodingAESKey)
 	if err != nil {
 		return "", NewSDKErr(40016)
@@ -43,6 +47,18 @@ func (r *Client) VerifyURL(options SignatureOptions) (string, error) {
 	return string(bData), nil
 }
 
+// SetReplayGuard 设置防重放校验，签名校验通过后检查时间戳及 nonce，不通过时返回 util.ErrTimestampExpired 或 util.ErrNonceReplayed
+func (r *Client) SetReplayGuard(guard *util.ReplayGuard) {
+	r.replayGuard = guard
+}
+
+func (r *Client) checkReplay(options SignatureOptions) error {
+	if r.replayGuard == nil {
+		return nil
+	}
+	return r.replayGuard.Check(context.Background(), options.TimeStamp, options.Nonce)
+}
+
 // 原始回调消息内容
 type callbackOriginMessage struct {
 	ToUserName string // 企业微信的CorpID，当为第三方套件回调事件时，CorpID的内容为suiteid
@@ -96,3 +112,18 @@ func (r *Client) GetCallbackMessage(encryptedMsg []byte) (msg CallbackMessage, e
 	}
 	return msg, err
 }
+
+// VerifyCallbackMessage 校验回调请求的签名（及防重放）并返回解密后的消息内容，options 为回调 url 中的参数
+func (r *Client) VerifyCallbackMessage(options SignatureOptions, encryptedMsg []byte) (msg CallbackMessage, err error) {
+	var origin callbackOriginMessage
+	if err = xml.Unmarshal(encryptedMsg, &origin); err != nil {
+		return msg, err
+	}
+	if options.Signature != util.Signature(r.ctx.Token, options.TimeStamp, options.Nonce, origin.Encrypt) {
+		return msg, NewSDKErr(40015)
+	}
+	if err = r.checkReplay(options); err != nil {
+		return msg, err
+	}
+	return r.GetCallbackMessage(encryptedMsg)
+}
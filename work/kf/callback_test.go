@@ -0,0 +1,98 @@
+package kf
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/silenceper/wechat/v2/cache"
+	"github.com/silenceper/wechat/v2/util"
+	"github.com/silenceper/wechat/v2/work/config"
+	"github.com/silenceper/wechat/v2/work/context"
+)
+
+const (
+	testCorpID = "corpid"
+	testToken  = "token"
+	testAESKey = "abcdefghijklmnopqrstuvwxyz0123456789ABCDEFG"
+)
+
+func newTestClient() *Client {
+	return NewClient(&context.Context{Config: &config.Config{CorpID: testCorpID, Token: testToken, EncodingAESKey: testAESKey}})
+}
+
+// newTestOptions 加密 plain 并构造带签名的回调参数，返回参数及密文
+func newTestOptions(t *testing.T, plain, timestamp, nonce string) (SignatureOptions, string) {
+	encrypted, err := util.EncryptMsg([]byte("0123456789abcdef"), []byte(plain), testCorpID, testAESKey)
+	assert.NoError(t, err)
+	return SignatureOptions{
+		Signature: util.Signature(testToken, timestamp, nonce, string(encrypted)),
+		TimeStamp: timestamp,
+		Nonce:     nonce,
+		EchoStr:   string(encrypted),
+	}, string(encrypted)
+}
+
+func TestVerifyURL(t *testing.T) {
+	client := newTestClient()
+	memory := cache.NewMemory()
+	defer memory.Close()
+	client.SetReplayGuard(util.NewReplayGuard(memory, "kf_", time.Minute))
+	now := strconv.FormatInt(time.Now().Unix(), 10)
+
+	options, _ := newTestOptions(t, "echo", now, "nonce")
+	echo, err := client.VerifyURL(options)
+	assert.NoError(t, err)
+	assert.Equal(t, "echo", echo)
+
+	// nonce 重放
+	_, err = client.VerifyURL(options)
+	assert.ErrorIs(t, err, util.ErrNonceReplayed)
+
+	// 签名不匹配
+	options, _ = newTestOptions(t, "echo", now, "nonce2")
+	options.Signature = "invalid"
+	_, err = client.VerifyURL(options)
+	assert.True(t, err == SDKValidateSignatureFailed)
+
+	// 时间戳过期
+	options, _ = newTestOptions(t, "echo", "1700000000", "nonce3")
+	_, err = client.VerifyURL(options)
+	assert.ErrorIs(t, err, util.ErrTimestampExpired)
+}
+
+func TestVerifyCallbackMessage(t *testing.T) {
+	client := newTestClient()
+	memory := cache.NewMemory()
+	defer memory.Close()
+	client.SetReplayGuard(util.NewReplayGuard(memory, "kf_", time.Minute))
+	now := strconv.FormatInt(time.Now().Unix(), 10)
+
+	plain := `<xml><ToUserName>wk_1</ToUserName><CreateTime>1700000000</CreateTime><MsgType>event</MsgType><Event>kf_msg_or_event</Event><Token>sync_token</Token></xml>`
+	body := func(encrypted string) []byte {
+		return []byte(`<xml><ToUserName>` + testCorpID + `</ToUserName><Encrypt>` + encrypted + `</Encrypt></xml>`)
+	}
+
+	options, encrypted := newTestOptions(t, plain, now, "nonce")
+	msg, err := client.VerifyCallbackMessage(options, body(encrypted))
+	assert.NoError(t, err)
+	assert.Equal(t, "kf_msg_or_event", msg.Event)
+	assert.Equal(t, "sync_token", msg.Token)
+
+	// nonce 重放
+	_, err = client.VerifyCallbackMessage(options, body(encrypted))
+	assert.ErrorIs(t, err, util.ErrNonceReplayed)
+
+	// 签名不匹配
+	options, encrypted = newTestOptions(t, plain, now, "nonce2")
+	options.Signature = "invalid"
+	_, err = client.VerifyCallbackMessage(options, body(encrypted))
+	assert.True(t, err == SDKValidateSignatureFailed)
+
+	// 时间戳过期
+	options, encrypted = newTestOptions(t, plain, "1700000000", "nonce3")
+	_, err = client.VerifyCallbackMessage(options, body(encrypted))
+	assert.ErrorIs(t, err, util.ErrTimestampExpired)
+}
@@ -1,12 +1,14 @@
 package kf
 
 import (
+	"github.com/silenceper/wechat/v2/util"
 	"github.com/silenceper/wechat/v2/work/context"
 )
 
 // Client 微信客服实例
 type Client struct {
-	ctx *context.Context
+	ctx         *context.Context
+	replayGuard *util.ReplayGuard
 }
 
 // NewClient 初始化微信客服实例
@@ -41,7 +41,7 @@ func (r *Client) CustomerBatchGet(options CustomerBatchGetOptions) (info Custome
 	if accessToken, err = r.ctx.GetAccessToken(); err != nil {
 		return
 	}
-	data, err = util.PostJSON(r.ctx.GetQYAPIDomain()+fmt.Sprintf(customerBatchGetAddr, accessToken), options)
+	data, err = r.ctx.PostJSON(r.ctx.GetQYAPIDomain()+fmt.Sprintf(customerBatchGetAddr, accessToken), options)
 	if err != nil {
 		return
 	}
@@ -49,7 +49,7 @@ func (r *Client) CustomerBatchGet(options CustomerBatchGetOptions) (info Custome
 		return
 	}
 	if info.ErrCode != 0 {
-		return info, NewSDKErr(info.ErrCode, info.ErrMsg)
+		return info, NewAPIErr("CustomerBatchGet", info.ErrCode, info.ErrMsg)
 	}
 	return info, nil
 }
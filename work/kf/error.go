@@ -3,6 +3,8 @@ package kf
 import (
 	"reflect"
 	"strings"
+
+	"github.com/silenceper/wechat/v2/util"
 )
 
 // Error 错误
@@ -56,6 +58,12 @@ func (r Error) Error() string {
 	return reflect.ValueOf(r).String()
 }
 
+// Is 支持 errors.Is 与 util.ErrCode 及错误分类比较
+func (r Error) Is(target error) bool {
+	code, ok := errCodes[r]
+	return ok && util.ErrCode(code).Is(target)
+}
+
 var codeDic = map[int64]error{
 	50001: SDKInitFailed,
 	50002: SDKCacheUnavailable,
@@ -79,7 +87,17 @@ var codeDic = map[int64]error{
 	95017: SDKApiNotOpen,
 }
 
-// NewSDKErr 初始化SDK实例错误信息
+// errCodes Error 常量对应的错误码
+var errCodes = func() map[Error]int64 {
+	m := make(map[Error]int64, len(codeDic))
+	for code, err := range codeDic {
+		m[err.(Error)] = code
+	}
+	return m
+}()
+
+// NewSDKErr 初始化SDK实例错误信息，已知错误码直接返回对应的 Error 常量，可使用 == 比较；
+// 接口返回的错误使用 NewAPIErr，保留接口名称及错误码
 func NewSDKErr(code int64, msgList ...string) error {
 	if err := codeDic[code]; err != nil {
 		return err
@@ -91,3 +109,12 @@ func NewSDKErr(code int64, msgList ...string) error {
 	}
 	return SDKUnknownError
 }
+
+// NewAPIErr 新建接口返回的错误，已知错误码返回对应的 Error 常量，与 NewSDKErr 一致可使用 == 比较；
+// 未知错误码返回 *util.CommonError，保留接口名称及错误码
+func NewAPIErr(apiName string, code int64, msgList ...string) error {
+	if err := codeDic[code]; err != nil {
+		return err
+	}
+	return util.NewCommonError(apiName, code, strings.Join(msgList, ","))
+}
@@ -0,0 +1,35 @@
+package kf
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/silenceper/wechat/v2/util"
+)
+
+func TestNewSDKErr(t *testing.T) {
+	assert.True(t, NewSDKErr(50001) == SDKInitFailed)
+	assert.True(t, NewSDKErr(40015, "ignored") == SDKValidateSignatureFailed)
+	assert.Equal(t, Error("custom,error"), NewSDKErr(1, "custom", "error"))
+	assert.Equal(t, SDKUnknownError, NewSDKErr(1))
+}
+
+func TestNewAPIErr(t *testing.T) {
+	// 已知错误码返回 Error 常量，可使用 == 比较
+	err := NewAPIErr("AccountAdd", 95004, "open_kfid not exist")
+	assert.True(t, err == SDKOpenKFIDNotExist)
+	assert.False(t, errors.Is(err, SDKInvalidOpenKFID))
+	assert.True(t, errors.Is(err, util.ErrCode(95004)))
+	assert.True(t, errors.Is(NewAPIErr("AccountAdd", 40001), util.ErrAccessTokenInvalid))
+
+	// 未知错误码返回 *util.CommonError
+	err = NewAPIErr("AccountAdd", 1, "unknown")
+	assert.False(t, errors.Is(err, SDKUnknownError))
+	var commonErr *util.CommonError
+	if assert.True(t, errors.As(err, &commonErr)) {
+		assert.Equal(t, int64(1), commonErr.ErrCode)
+		assert.Equal(t, "AccountAdd", commonErr.APIName())
+	}
+}
@@ -34,7 +34,7 @@ func (r *Client) GetCorpQualification() (info CorpQualificationSchema, err error
 	if accessToken, err = r.ctx.GetAccessToken(); err != nil {
 		return
 	}
-	data, err = util.HTTPGet(r.ctx.GetQYAPIDomain() + fmt.Sprintf(corpQualification, accessToken))
+	data, err = r.ctx.HTTPGet(r.ctx.GetQYAPIDomain() + fmt.Sprintf(corpQualification, accessToken))
 	if err != nil {
 		return info, err
 	}
@@ -42,7 +42,7 @@ func (r *Client) GetCorpQualification() (info CorpQualificationSchema, err error
 		return
 	}
 	if info.ErrCode != 0 {
-		return info, NewSDKErr(info.ErrCode, info.ErrMsg)
+		return info, NewAPIErr("GetCorpQualification", info.ErrCode, info.ErrMsg)
 	}
 	return info, nil
 }
@@ -33,7 +33,7 @@ func (r *Client) SendMsg(options interface{}) (info SendMsgSchema, err error) {
 	if accessToken, err = r.ctx.GetAccessToken(); err != nil {
 		return
 	}
-	data, err = util.PostJSON(r.ctx.GetQYAPIDomain()+fmt.Sprintf(sendMsgAddr, accessToken), options)
+	data, err = r.ctx.PostJSON(r.ctx.GetQYAPIDomain()+fmt.Sprintf(sendMsgAddr, accessToken), options)
 	if err != nil {
 		return
 	}
@@ -41,7 +41,7 @@ func (r *Client) SendMsg(options interface{}) (info SendMsgSchema, err error) {
 		return
 	}
 	if info.ErrCode != 0 {
-		return info, NewSDKErr(info.ErrCode, info.ErrMsg)
+		return info, NewAPIErr("SendMsg", info.ErrCode, info.ErrMsg)
 	}
 	return info, nil
 }
@@ -41,7 +41,7 @@ func (r *Client) SendMsgOnEvent(options interface{}) (info SendMsgOnEventSchema,
 	if accessToken, err = r.ctx.GetAccessToken(); err != nil {
 		return
 	}
-	data, err = util.PostJSON(r.ctx.GetQYAPIDomain()+fmt.Sprintf(sendMsgOnEventAddr, accessToken), options)
+	data, err = r.ctx.PostJSON(r.ctx.GetQYAPIDomain()+fmt.Sprintf(sendMsgOnEventAddr, accessToken), options)
 	if err != nil {
 		return
 	}
@@ -49,7 +49,7 @@ func (r *Client) SendMsgOnEvent(options interface{}) (info SendMsgOnEventSchema,
 		return
 	}
 	if info.ErrCode != 0 {
-		return info, NewSDKErr(info.ErrCode, info.ErrMsg)
+		return info, NewAPIErr("SendMsgOnEvent", info.ErrCode, info.ErrMsg)
 	}
 	return info, nil
 }
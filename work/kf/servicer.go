@@ -40,7 +40,7 @@ func (r *Client) ReceptionistAdd(options ReceptionistOptions) (info Receptionist
 	if accessToken, err = r.ctx.GetAccessToken(); err != nil {
 		return
 	}
-	data, err = util.PostJSON(r.ctx.GetQYAPIDomain()+fmt.Sprintf(receptionistAddAddr, accessToken), options)
+	data, err = r.ctx.PostJSON(r.ctx.GetQYAPIDomain()+fmt.Sprintf(receptionistAddAddr, accessToken), options)
 	if err != nil {
 		return
 	}
@@ -48,7 +48,7 @@ func (r *Client) ReceptionistAdd(options ReceptionistOptions) (info Receptionist
 		return
 	}
 	if info.ErrCode != 0 {
-		return info, NewSDKErr(info.ErrCode, info.ErrMsg)
+		return info, NewAPIErr("ReceptionistAdd", info.ErrCode, info.ErrMsg)
 	}
 	return info, nil
 }
@@ -63,7 +63,7 @@ func (r *Client) ReceptionistDel(options ReceptionistOptions) (info Receptionist
 	if err != nil {
 		return
 	}
-	data, err = util.PostJSON(r.ctx.GetQYAPIDomain()+fmt.Sprintf(receptionistDelAddr, accessToken), options)
+	data, err = r.ctx.PostJSON(r.ctx.GetQYAPIDomain()+fmt.Sprintf(receptionistDelAddr, accessToken), options)
 	if err != nil {
 		return
 	}
@@ -71,7 +71,7 @@ func (r *Client) ReceptionistDel(options ReceptionistOptions) (info Receptionist
 		return
 	}
 	if info.ErrCode != 0 {
-		return info, NewSDKErr(info.ErrCode, info.ErrMsg)
+		return info, NewAPIErr("ReceptionistDel", info.ErrCode, info.ErrMsg)
 	}
 	return info, nil
 }
@@ -95,7 +95,7 @@ func (r *Client) ReceptionistList(kfID string) (info ReceptionistListSchema, err
 	if err != nil {
 		return
 	}
-	data, err = util.HTTPGet(r.ctx.GetQYAPIDomain() + fmt.Sprintf(receptionistListAddr, accessToken, kfID))
+	data, err = r.ctx.HTTPGet(r.ctx.GetQYAPIDomain() + fmt.Sprintf(receptionistListAddr, accessToken, kfID))
 	if err != nil {
 		return
 	}
@@ -103,7 +103,7 @@ func (r *Client) ReceptionistList(kfID string) (info ReceptionistListSchema, err
 		return
 	}
 	if info.ErrCode != 0 {
-		return info, NewSDKErr(info.ErrCode, info.ErrMsg)
+		return info, NewAPIErr("ReceptionistList", info.ErrCode, info.ErrMsg)
 	}
 	return info, nil
 }
@@ -42,7 +42,7 @@ func (r *Client) ServiceStateGet(options ServiceStateGetOptions) (info ServiceSt
 	if accessToken, err = r.ctx.GetAccessToken(); err != nil {
 		return
 	}
-	data, err = util.PostJSON(r.ctx.GetQYAPIDomain()+fmt.Sprintf(serviceStateGetAddr, accessToken), options)
+	data, err = r.ctx.PostJSON(r.ctx.GetQYAPIDomain()+fmt.Sprintf(serviceStateGetAddr, accessToken), options)
 	if err != nil {
 		return
 	}
@@ -50,7 +50,7 @@ func (r *Client) ServiceStateGet(options ServiceStateGetOptions) (info ServiceSt
 		return
 	}
 	if info.ErrCode != 0 {
-		return info, NewSDKErr(info.ErrCode, info.ErrMsg)
+		return info, NewAPIErr("ServiceStateGet", info.ErrCode, info.ErrMsg)
 	}
 	return info, nil
 }
@@ -78,7 +78,7 @@ func (r *Client) ServiceStateTrans(options ServiceStateTransOptions) (info Servi
 	if accessToken, err = r.ctx.GetAccessToken(); err != nil {
 		return
 	}
-	data, err = util.PostJSON(r.ctx.GetQYAPIDomain()+fmt.Sprintf(serviceStateTransAddr, accessToken), options)
+	data, err = r.ctx.PostJSON(r.ctx.GetQYAPIDomain()+fmt.Sprintf(serviceStateTransAddr, accessToken), options)
 	if err != nil {
 		return
 	}
@@ -86,7 +86,7 @@ func (r *Client) ServiceStateTrans(options ServiceStateTransOptions) (info Servi
 		return
 	}
 	if info.ErrCode != 0 {
-		return info, NewSDKErr(info.ErrCode, info.ErrMsg)
+		return info, NewAPIErr("ServiceStateTrans", info.ErrCode, info.ErrMsg)
 	}
 	return info, nil
 }
@@ -37,7 +37,7 @@ func (r *Client) UpgradeServiceConfig() (info UpgradeServiceConfigSchema, err er
 	if accessToken, err = r.ctx.GetAccessToken(); err != nil {
 		return
 	}
-	data, err = util.HTTPGet(r.ctx.GetQYAPIDomain() + fmt.Sprintf(upgradeServiceConfigAddr, accessToken))
+	data, err = r.ctx.HTTPGet(r.ctx.GetQYAPIDomain() + fmt.Sprintf(upgradeServiceConfigAddr, accessToken))
 	if err != nil {
 		return
 	}
@@ -45,7 +45,7 @@ func (r *Client) UpgradeServiceConfig() (info UpgradeServiceConfigSchema, err er
 		return
 	}
 	if info.ErrCode != 0 {
-		return info, NewSDKErr(info.ErrCode, info.ErrMsg)
+		return info, NewAPIErr("UpgradeServiceConfig", info.ErrCode, info.ErrMsg)
 	}
 	return info, nil
 }
@@ -74,7 +74,7 @@ func (r *Client) UpgradeService(options UpgradeServiceOptions) (info util.Common
 	if accessToken, err = r.ctx.GetAccessToken(); err != nil {
 		return
 	}
-	data, err = util.PostJSON(r.ctx.GetQYAPIDomain()+fmt.Sprintf(upgradeService, accessToken), options)
+	data, err = r.ctx.PostJSON(r.ctx.GetQYAPIDomain()+fmt.Sprintf(upgradeService, accessToken), options)
 	if err != nil {
 		return
 	}
@@ -82,7 +82,7 @@ func (r *Client) UpgradeService(options UpgradeServiceOptions) (info util.Common
 		return
 	}
 	if info.ErrCode != 0 {
-		return info, NewSDKErr(info.ErrCode, info.ErrMsg)
+		return info, NewAPIErr("UpgradeService", info.ErrCode, info.ErrMsg)
 	}
 	return info, nil
 }
@@ -107,7 +107,7 @@ func (r *Client) UpgradeMemberService(options UpgradeMemberServiceOptions) (info
 	if accessToken, err = r.ctx.GetAccessToken(); err != nil {
 		return
 	}
-	data, err = util.PostJSON(r.ctx.GetQYAPIDomain()+fmt.Sprintf(upgradeService, accessToken), options)
+	data, err = r.ctx.PostJSON(r.ctx.GetQYAPIDomain()+fmt.Sprintf(upgradeService, accessToken), options)
 	if err != nil {
 		return
 	}
@@ -115,7 +115,7 @@ func (r *Client) UpgradeMemberService(options UpgradeMemberServiceOptions) (info
 		return
 	}
 	if info.ErrCode != 0 {
-		return info, NewSDKErr(info.ErrCode, info.ErrMsg)
+		return info, NewAPIErr("UpgradeMemberService", info.ErrCode, info.ErrMsg)
 	}
 	return info, nil
 }
@@ -141,7 +141,7 @@ func (r *Client) UpgradeGroupChatService(options UpgradeServiceGroupChatOptions)
 	if err != nil {
 		return
 	}
-	data, err = util.PostJSON(r.ctx.GetQYAPIDomain()+fmt.Sprintf(upgradeService, accessToken), options)
+	data, err = r.ctx.PostJSON(r.ctx.GetQYAPIDomain()+fmt.Sprintf(upgradeService, accessToken), options)
 	if err != nil {
 		return
 	}
@@ -149,7 +149,7 @@ func (r *Client) UpgradeGroupChatService(options UpgradeServiceGroupChatOptions)
 		return
 	}
 	if info.ErrCode != 0 {
-		return info, NewSDKErr(info.ErrCode, info.ErrMsg)
+		return info, NewAPIErr("UpgradeGroupChatService", info.ErrCode, info.ErrMsg)
 	}
 	return info, nil
 }
@@ -169,7 +169,7 @@ func (r *Client) UpgradeServiceCancel(options UpgradeServiceCancelOptions) (info
 	if accessToken, err = r.ctx.GetAccessToken(); err != nil {
 		return
 	}
-	data, err = util.PostJSON(r.ctx.GetQYAPIDomain()+fmt.Sprintf(upgradeServiceCancel, accessToken), options)
+	data, err = r.ctx.PostJSON(r.ctx.GetQYAPIDomain()+fmt.Sprintf(upgradeServiceCancel, accessToken), options)
 	if err != nil {
 		return
 	}
@@ -177,7 +177,7 @@ func (r *Client) UpgradeServiceCancel(options UpgradeServiceCancelOptions) (info
 		return
 	}
 	if info.ErrCode != 0 {
-		return info, NewSDKErr(info.ErrCode, info.ErrMsg)
+		return info, NewAPIErr("UpgradeServiceCancel", info.ErrCode, info.ErrMsg)
 	}
 	return info, nil
 }
@@ -2,6 +2,8 @@ package msgaudit
 
 import (
 	"fmt"
+
+	"github.com/silenceper/wechat/v2/util"
 )
 
 // 返回码	错误说明
@@ -43,6 +45,30 @@ func (e Error) Error() string {
 	return fmt.Sprintf("%d:%s", e.ErrCode, e.ErrMsg)
 }
 
+// Is 支持 errors.Is 按错误码与 Error 比较，接口返回的错误码也可与 util.ErrCode 及错误分类比较
+func (e Error) Is(target error) bool {
+	if t, ok := target.(Error); ok {
+		return e.ErrCode == t.ErrCode
+	}
+	return util.ErrCode(e.ErrCode).Is(target)
+}
+
+// SDK 错误，可作为 errors.Is 的目标
+var (
+	ErrSDKParams         = NewSDKErr(10000)
+	ErrSDKNetwork        = NewSDKErr(10001)
+	ErrSDKParse          = NewSDKErr(10002)
+	ErrSDKSystem         = NewSDKErr(10003)
+	ErrSDKSecret         = NewSDKErr(10004)
+	ErrSDKFileID         = NewSDKErr(10005)
+	ErrSDKDecrypt        = NewSDKErr(10006)
+	ErrSDKSecretMiss     = NewSDKErr(10007)
+	ErrSDKEncryptKey     = NewSDKErr(10008)
+	ErrSDKIPNotWhiteList = NewSDKErr(10009)
+	ErrSDKDataExpired    = NewSDKErr(10010)
+	ErrSDKTokenExpired   = NewSDKErr(10011)
+)
+
 // NewSDKErr 初始化新的SDK错误
 func NewSDKErr(code int) Error {
 	msg := ""
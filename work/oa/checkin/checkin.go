@@ -4,7 +4,6 @@ import (
 	"encoding/json"
 	"fmt"
 
-	"github.com/silenceper/wechat/v2/util"
 	"github.com/silenceper/wechat/v2/work/context"
 	"github.com/silenceper/wechat/v2/work/xerror"
 )
@@ -35,7 +34,7 @@ func (r *OACheckIn) GetHardwareCheckinData(options GetHardwareCheckinDataReq) (i
 	if err != nil {
 		return
 	}
-	data, err = util.PostJSON(r.GetQYAPIDomain()+fmt.Sprintf(getHardwareCheckinData, accessToken), options)
+	data, err = r.PostJSON(r.GetQYAPIDomain()+fmt.Sprintf(getHardwareCheckinData, accessToken), options)
 	if err != nil {
 		return
 	}
@@ -43,7 +42,7 @@ func (r *OACheckIn) GetHardwareCheckinData(options GetHardwareCheckinDataReq) (i
 		return
 	}
 	if info.ErrCode != 0 {
-		return info, xerror.NewSDKErr(info.ErrCode, info.ErrMsg)
+		return info, xerror.NewAPIErr("GetHardwareCheckinData", info.ErrCode, info.ErrMsg)
 	}
 	return info, nil
 }
@@ -59,7 +58,7 @@ func (r *OACheckIn) GetCheckinData(options GetCheckinDataReq) (info GetCheckinDa
 	if err != nil {
 		return
 	}
-	data, err = util.PostJSON(r.GetQYAPIDomain()+fmt.Sprintf(getCheckinData, accessToken), options)
+	data, err = r.PostJSON(r.GetQYAPIDomain()+fmt.Sprintf(getCheckinData, accessToken), options)
 	if err != nil {
 		return
 	}
@@ -67,7 +66,7 @@ func (r *OACheckIn) GetCheckinData(options GetCheckinDataReq) (info GetCheckinDa
 		return
 	}
 	if info.ErrCode != 0 {
-		return info, xerror.NewSDKErr(info.ErrCode, info.ErrMsg)
+		return info, xerror.NewAPIErr("GetCheckinData", info.ErrCode, info.ErrMsg)
 	}
 	return info, nil
 }
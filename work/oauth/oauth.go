@@ -87,7 +87,7 @@ func (ctr *Oauth) UserFromCode(code string) (result ResUserInfo, err error) {
 		return
 	}
 	var response []byte
-	if response, err = util.HTTPGet(ctr.GetQYAPIDomain() + fmt.Sprintf(oauthUserInfoURL, accessToken, code)); err != nil {
+	if response, err = ctr.HTTPGet(ctr.GetQYAPIDomain() + fmt.Sprintf(oauthUserInfoURL, accessToken, code)); err != nil {
 		return
 	}
 	err = json.Unmarshal(response, &result)
@@ -115,7 +115,7 @@ func (ctr *Oauth) GetUserInfo(code string) (result GetUserInfoResp, err error) {
 		return
 	}
 	var response []byte
-	if response, err = util.HTTPGet(ctr.GetQYAPIDomain() + fmt.Sprintf(oauthGetUserInfoURL, accessToken, code)); err != nil {
+	if response, err = ctr.HTTPGet(ctr.GetQYAPIDomain() + fmt.Sprintf(oauthGetUserInfoURL, accessToken, code)); err != nil {
 		return
 	}
 	err = json.Unmarshal(response, &result)
@@ -155,7 +155,7 @@ func (ctr *Oauth) GetUserInfoDetail(userTicket string) (result GetUserInfoDetail
 		return
 	}
 	var response []byte
-	if response, err = util.PostJSON(ctr.GetQYAPIDomain()+fmt.Sprintf(oauthGetUserDetailInfoURL, accessToken), options); err != nil {
+	if response, err = ctr.PostJSON(ctr.GetQYAPIDomain()+fmt.Sprintf(oauthGetUserDetailInfoURL, accessToken), options); err != nil {
 		return
 	}
 	err = json.Unmarshal(response, &result)
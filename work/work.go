@@ -78,8 +78,12 @@ func (wk *Work) GetContact() *contact.Contact {
 func (wk *Work) GetCorpChainContact(chainCorpId string, agentId, bizType int) *contact.Contact {
 	defaultWorkCorpChainAkHandle := credential.NewWorkCorpChainAccessToken(wk.ctx.AccessTokenHandle, chainCorpId, agentId, credential.CacheKeyWorkPrefix, bizType, wk.ctx.Config.Cache)
 	cfg := &config.Config{
-		CorpID:  chainCorpId,
-		AgentID: agentId,
+		CorpID:       chainCorpId,
+		AgentID:      agentId,
+		HTTPClient:   wk.ctx.HTTPClient,
+		Logger:       wk.ctx.Logger,
+		Interceptors: wk.ctx.Interceptors,
+		RateLimiter:  wk.ctx.RateLimiter,
 	}
 	if len(cfg.QYAPIDomain) == 0 {
 		cfg.QYAPIDomain = workDefaultApiDomain
@@ -111,8 +115,12 @@ func (wk *Work) GetCorpMP() *corpmp.CorpMP {
 func (wk *Work) GetCorpChain(chainCorpId string, agentId, bizType int) *corpchain.CorpChain {
 	defaultWorkCorpChainAkHandle := credential.NewWorkCorpChainAccessToken(wk.ctx.AccessTokenHandle, chainCorpId, agentId, credential.CacheKeyWorkPrefix, bizType, wk.ctx.Config.Cache)
 	cfg := &config.Config{
-		CorpID:  chainCorpId,
-		AgentID: agentId,
+		CorpID:       chainCorpId,
+		AgentID:      agentId,
+		HTTPClient:   wk.ctx.HTTPClient,
+		Logger:       wk.ctx.Logger,
+		Interceptors: wk.ctx.Interceptors,
+		RateLimiter:  wk.ctx.RateLimiter,
 	}
 	if len(cfg.QYAPIDomain) == 0 {
 		cfg.QYAPIDomain = workDefaultApiDomain
@@ -4,6 +4,8 @@ import (
 	"strings"
 
 	"github.com/pkg/errors"
+
+	"github.com/silenceper/wechat/v2/util"
 )
 
 // go:generate stringer -type=Error -linecomment -output error_string.go
@@ -43,19 +45,31 @@ func (c codeError) Error() string {
 	return c.err
 }
 
-// NewSDKErr 新建业务错误，附带错误码
+// Code 错误码，供 util.ErrCodeOf 提取
+func (c codeError) Code() int64 {
+	return c.code
+}
+
+// Is 支持 errors.Is 与 util.ErrCode 及错误分类比较
+func (c codeError) Is(target error) bool {
+	return util.ErrCode(c.code).Is(target)
+}
+
+// NewSDKErr 新建业务错误，附带错误码，可通过 errors.Is 与 util.ErrCode 及错误分类比较
 func NewSDKErr(code int64, msgList ...string) error {
 	return codeError{code: code, err: strings.Join(msgList, ",")}
 }
 
-// Code 提取错误码，codeError 返回 code 和 true，其他返回 0 和 false
+// NewAPIErr 新建接口返回的业务错误，保留接口名称
+func NewAPIErr(apiName string, code int64, msgList ...string) error {
+	return util.NewCommonError(apiName, code, strings.Join(msgList, ","))
+}
+
+// Code 提取错误码，业务错误返回 code 和 true，其他返回 0 和 false
 func Code(err error) (int64, bool) {
 	err = errors.Cause(err)
 	if err == nil {
 		return 0, false
 	}
-	if ce, ok := err.(codeError); ok {
-		return ce.code, true
-	}
-	return 0, false
+	return util.ErrCodeOf(err)
 }
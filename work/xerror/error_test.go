@@ -0,0 +1,26 @@
+package xerror
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/silenceper/wechat/v2/util"
+)
+
+func TestNewSDKErr(t *testing.T) {
+	err := NewSDKErr(40015, "校验签名错误")
+	assert.Equal(t, "校验签名错误", err.Error())
+	assert.True(t, errors.Is(err, util.ErrCode(40015)))
+
+	code, ok := util.ErrCodeOf(Wrap(err, "verify"))
+	assert.True(t, ok)
+	assert.Equal(t, int64(40015), code)
+	code, ok = Code(Wrap(err, "verify"))
+	assert.True(t, ok)
+	assert.Equal(t, int64(40015), code)
+
+	_, ok = Code(errors.New("other"))
+	assert.False(t, ok)
+}